@@ -35,7 +35,7 @@ type _Imm_Messages []string
 // BookChatState holds the state for the BookChat component
 type BookChatState struct {
 	messageInput string
-	nameInput    string
+	memberInput  string
 	messages     *Messages
 	client       library.BookService_BookChatClient
 	err          string
@@ -61,15 +61,15 @@ func (g BookChatDef) Render() r.Element {
 					&r.DivProps{ClassName: "form-group"},
 					r.Label(&r.LabelProps{
 						ClassName: "sr-only",
-						For:       "nameText",
-					}, r.S("Name")),
+						For:       "memberText",
+					}, r.S("Member ID")),
 					r.Input(&r.InputProps{
 						Type:        "text",
 						ClassName:   "form-control",
-						ID:          "nameText",
-						Value:       st.nameInput,
-						OnChange:    nameInputChange{g},
-						Placeholder: "Your Name",
+						ID:          "memberText",
+						Value:       st.memberInput,
+						OnChange:    memberInputChange{g},
+						Placeholder: "Your Member ID",
 					}),
 					r.Button(&r.ButtonProps{
 						Type:      "submit",
@@ -154,7 +154,7 @@ func (g BookChatDef) Render() r.Element {
 
 type toggleconnect struct{ g BookChatDef }
 type messageInputChange struct{ g BookChatDef }
-type nameInputChange struct{ g BookChatDef }
+type memberInputChange struct{ g BookChatDef }
 type send struct{ g BookChatDef }
 
 func (n messageInputChange) OnChange(se *r.SyntheticEvent) {
@@ -165,11 +165,11 @@ func (n messageInputChange) OnChange(se *r.SyntheticEvent) {
 	n.g.SetState(newSt)
 }
 
-func (n nameInputChange) OnChange(se *r.SyntheticEvent) {
+func (n memberInputChange) OnChange(se *r.SyntheticEvent) {
 	target := se.Target().(*dom.HTMLInputElement)
 
 	newSt := n.g.State()
-	newSt.nameInput = target.Value
+	newSt.memberInput = target.Value
 	n.g.SetState(newSt)
 }
 
@@ -193,8 +193,8 @@ func (t toggleconnect) OnClick(se *r.SyntheticMouseEvent) {
 			return
 		}
 
-		if newSt.nameInput == "" {
-			newSt.err = "Name must not be empty"
+		if newSt.memberInput == "" {
+			newSt.err = "Member ID must not be empty"
 			return
 		}
 
@@ -207,7 +207,7 @@ func (t toggleconnect) OnClick(se *r.SyntheticMouseEvent) {
 			return
 		}
 
-		newSt.messages = NewMessages("Welcome to the BookChat, " + newSt.nameInput + "!")
+		newSt.messages = NewMessages("Welcome to the BookChat!")
 		newSt.connTimeout = timeout
 		// Start automatic disconnect countdown
 		go func() {
@@ -248,7 +248,7 @@ func (t toggleconnect) OnClick(se *r.SyntheticMouseEvent) {
 			}
 		}()
 
-		err = newSt.client.Send(&library.BookMessage{Content: &library.BookMessage_Name{Name: newSt.nameInput}})
+		err = newSt.client.Send(&library.BookMessage{Content: &library.BookMessage_MemberId{MemberId: newSt.memberInput}})
 		if err != nil {
 			newSt.err = err.Error()
 			newSt.client = nil
@@ -2,13 +2,14 @@ package book
 
 import (
 	"context"
-	"strconv"
 	"time"
 
 	"github.com/johanbrandhorst/protobuf/grpcweb/status"
+	"google.golang.org/grpc/codes"
 	"honnef.co/go/js/dom"
 	r "myitcv.io/react"
 
+	"github.com/johanbrandhorst/grpcweb-example/client/proto/field_mask"
 	"github.com/johanbrandhorst/grpcweb-example/client/proto/library"
 )
 
@@ -28,7 +29,15 @@ type GetBookProps struct {
 type GetBookState struct {
 	isbnInput string
 	book      *library.Book
-	err       string
+	// editions are the editions of the work
+	// the book is an edition of, if any.
+	editions    *library.ListEditionsResponse
+	titleInput  string
+	authorInput string
+	// conflict is set when the book was changed
+	// by someone else since it was loaded.
+	conflict bool
+	err      string
 }
 
 // GetBook returns a new GetBookElem
@@ -40,13 +49,13 @@ func GetBook(p GetBookProps) *GetBookElem {
 func (g GetBookDef) Render() r.Element {
 	st := g.State()
 	content := []r.Element{
-		r.P(nil, r.S("Search for book by ISBN (for example, 140008381).")),
+		r.P(nil, r.S("Search for book by ISBN (for example, 0-14-000838-1).")),
 		r.Form(&r.FormProps{ClassName: "form-inline"},
 			r.Div(
 				&r.DivProps{ClassName: "form-group"},
 				r.Label(&r.LabelProps{ClassName: "sr-only", For: "isnbText"}, r.S("ISBN")),
 				r.Input(&r.InputProps{
-					Type:      "number",
+					Type:      "text",
 					ClassName: "form-control",
 					ID:        "isnbText",
 					Value:     st.isbnInput,
@@ -63,6 +72,24 @@ func (g GetBookDef) Render() r.Element {
 
 	if st.book != nil {
 		content = append(content, renderBook(st.book))
+		if formats := g.renderFormats(); formats != nil {
+			content = append(content, formats)
+		}
+		content = append(content, g.renderEdit())
+	}
+
+	if st.conflict {
+		content = append(content,
+			r.Div(&r.DivProps{ClassName: "alert alert-warning"},
+				r.S("This book was changed by someone else after you loaded it. "+
+					"Reload it to see their changes, then make your edits again. "),
+				r.Button(&r.ButtonProps{
+					Type:      "button",
+					ClassName: "btn btn-default",
+					OnClick:   triggerReload{g},
+				}, r.S("Reload")),
+			),
+		)
 	}
 
 	if st.err != "" {
@@ -77,8 +104,70 @@ func (g GetBookDef) Render() r.Element {
 	return r.Div(nil, content...)
 }
 
+// renderFormats renders links to the other editions of the
+// work of the book, or nil if the book has no other editions.
+func (g GetBookDef) renderFormats() r.Element {
+	st := g.State()
+	var links []r.Element
+	for _, ed := range st.editions.GetBooks() {
+		if ed.GetIsbn() == st.book.GetIsbn() {
+			continue
+		}
+		links = append(links, r.Button(&r.ButtonProps{
+			Type:      "button",
+			ClassName: "btn btn-link",
+			OnClick:   triggerEdition{g: g, isbn: ed.GetIsbn()},
+		}, r.S(ed.GetBookType().String())))
+	}
+	if len(links) == 0 {
+		return nil
+	}
+	return r.Div(nil, append([]r.Element{r.S("Also available as: ")}, links...)...)
+}
+
+// renderEdit renders the form used to edit the title and author of the book.
+func (g GetBookDef) renderEdit() r.Element {
+	st := g.State()
+	return r.Form(&r.FormProps{ClassName: "form-inline"},
+		r.Div(
+			&r.DivProps{ClassName: "form-group"},
+			r.Label(&r.LabelProps{ClassName: "sr-only", For: "titleText"}, r.S("Title")),
+			r.Input(&r.InputProps{
+				Type:        "text",
+				ClassName:   "form-control",
+				ID:          "titleText",
+				Value:       st.titleInput,
+				OnChange:    editTitleChange{g},
+				Placeholder: "Title",
+			}),
+			r.Label(&r.LabelProps{ClassName: "sr-only", For: "authorText"}, r.S("Author")),
+			r.Input(&r.InputProps{
+				Type:        "text",
+				ClassName:   "form-control",
+				ID:          "authorText",
+				Value:       st.authorInput,
+				OnChange:    editAuthorChange{g},
+				Placeholder: "Author",
+			}),
+			r.Button(&r.ButtonProps{
+				Type:      "submit",
+				ClassName: "btn btn-default",
+				OnClick:   triggerSave{g},
+			}, r.S("Save")),
+		),
+	)
+}
+
 type isbnInputChange struct{ g GetBookDef }
 type triggerGet struct{ g GetBookDef }
+type editTitleChange struct{ g GetBookDef }
+type editAuthorChange struct{ g GetBookDef }
+type triggerSave struct{ g GetBookDef }
+type triggerReload struct{ g GetBookDef }
+type triggerEdition struct {
+	g    GetBookDef
+	isbn string
+}
 
 func (i isbnInputChange) OnChange(se *r.SyntheticEvent) {
 	target := se.Target().(*dom.HTMLInputElement)
@@ -98,9 +187,9 @@ func (t triggerGet) OnClick(se *r.SyntheticMouseEvent) {
 		}()
 		newSt.err = ""
 		newSt.book = nil
+		newSt.conflict = false
 
-		isbn, err := strconv.Atoi(newSt.isbnInput)
-		if err != nil {
+		if newSt.isbnInput == "" {
 			newSt.err = "ISBN must not be empty"
 			return
 		}
@@ -110,7 +199,128 @@ func (t triggerGet) OnClick(se *r.SyntheticMouseEvent) {
 		defer cancel()
 
 		bk, err := t.g.Props().Client.GetBook(ctx, &library.GetBookRequest{
-			Isbn: int64(isbn),
+			Isbn: newSt.isbnInput,
+		})
+		if err != nil {
+			sts := status.FromError(err)
+			newSt.err = sts.Message
+			return
+		}
+
+		newSt.setBook(bk)
+		newSt.editions = listEditions(ctx, t.g.Props().Client, bk)
+	}()
+
+	se.PreventDefault()
+}
+
+func (t triggerEdition) OnClick(se *r.SyntheticMouseEvent) {
+	// Wrapped in goroutine because GetBook is blocking
+	go func() {
+		newSt := t.g.State()
+		defer func() {
+			t.g.SetState(newSt)
+		}()
+		newSt.err = ""
+		newSt.conflict = false
+
+		// 1 second timeout
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		bk, err := t.g.Props().Client.GetBook(ctx, &library.GetBookRequest{
+			Isbn: t.isbn,
+		})
+		if err != nil {
+			sts := status.FromError(err)
+			newSt.err = sts.Message
+			return
+		}
+
+		newSt.isbnInput = bk.GetIsbn()
+		newSt.setBook(bk)
+		newSt.editions = listEditions(ctx, t.g.Props().Client, bk)
+	}()
+
+	se.PreventDefault()
+}
+
+func (t editTitleChange) OnChange(se *r.SyntheticEvent) {
+	target := se.Target().(*dom.HTMLInputElement)
+
+	newSt := t.g.State()
+	newSt.titleInput = target.Value
+
+	t.g.SetState(newSt)
+}
+
+func (a editAuthorChange) OnChange(se *r.SyntheticEvent) {
+	target := se.Target().(*dom.HTMLInputElement)
+
+	newSt := a.g.State()
+	newSt.authorInput = target.Value
+
+	a.g.SetState(newSt)
+}
+
+func (t triggerSave) OnClick(se *r.SyntheticMouseEvent) {
+	// Wrapped in goroutine because UpdateBook is blocking
+	go func() {
+		newSt := t.g.State()
+		defer func() {
+			t.g.SetState(newSt)
+		}()
+		newSt.err = ""
+		newSt.conflict = false
+
+		// 1 second timeout
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		bk, err := t.g.Props().Client.UpdateBook(ctx, &library.UpdateBookRequest{
+			Book: &library.Book{
+				Isbn:   newSt.book.GetIsbn(),
+				Title:  newSt.titleInput,
+				Author: newSt.authorInput,
+				// Only update the version that was loaded
+				Etag: newSt.book.GetEtag(),
+			},
+			UpdateMask: &field_mask.FieldMask{
+				Paths: []string{"title", "author"},
+			},
+		})
+		if err != nil {
+			sts := status.FromError(err)
+			switch sts.Code {
+			case codes.Aborted, codes.FailedPrecondition:
+				newSt.conflict = true
+			default:
+				newSt.err = sts.Message
+			}
+			return
+		}
+
+		newSt.setBook(bk)
+	}()
+
+	se.PreventDefault()
+}
+
+func (t triggerReload) OnClick(se *r.SyntheticMouseEvent) {
+	// Wrapped in goroutine because GetBook is blocking
+	go func() {
+		newSt := t.g.State()
+		defer func() {
+			t.g.SetState(newSt)
+		}()
+		newSt.err = ""
+
+		// 1 second timeout
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		bk, err := t.g.Props().Client.GetBook(ctx, &library.GetBookRequest{
+			Isbn: newSt.book.GetIsbn(),
 		})
 		if err != nil {
 			sts := status.FromError(err)
@@ -118,8 +328,32 @@ func (t triggerGet) OnClick(se *r.SyntheticMouseEvent) {
 			return
 		}
 
-		newSt.book = bk
+		newSt.conflict = false
+		newSt.setBook(bk)
 	}()
 
 	se.PreventDefault()
 }
+
+// listEditions returns the editions of the work of bk, or nil if
+// bk is not an edition of a work or the editions can't be listed.
+func listEditions(ctx context.Context, client library.BookServiceClient, bk *library.Book) *library.ListEditionsResponse {
+	if bk.GetWorkId() == "" {
+		return nil
+	}
+	editions, err := client.ListEditions(ctx, &library.ListEditionsRequest{
+		WorkId: bk.GetWorkId(),
+	})
+	if err != nil {
+		// The other formats are just not offered
+		return nil
+	}
+	return editions
+}
+
+// setBook shows bk, and resets the edit form to its values.
+func (st *GetBookState) setBook(bk *library.Book) {
+	st.book = bk
+	st.titleInput = bk.GetTitle()
+	st.authorInput = bk.GetAuthor()
+}
@@ -1,7 +1,6 @@
 package book
 
 import (
-	"strconv"
 	"time"
 
 	r "myitcv.io/react"
@@ -17,12 +16,17 @@ func renderBook(bk *library.Book) r.Element {
 	case *library.Book_SelfPublished:
 		publisher = "Self-published"
 	}
+	// The server picks the title in the language of the browser
+	title := bk.GetTitle()
+	if bk.GetLocalized().GetTitle() != "" {
+		title = bk.GetLocalized().GetTitle()
+	}
 	return r.Div(nil,
 		r.Hr(nil),
 		r.Div(nil,
 			r.S("Title: "),
 			r.Code(nil,
-				r.S(bk.GetTitle()),
+				r.S(title),
 			),
 		),
 		r.Div(nil,
@@ -55,7 +59,7 @@ func renderBook(bk *library.Book) r.Element {
 		r.Div(nil,
 			r.S("ISBN: "),
 			r.Code(nil,
-				r.S(strconv.Itoa(int(bk.GetIsbn()))),
+				r.S(bk.GetIsbn()),
 			),
 		),
 	)
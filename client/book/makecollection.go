@@ -2,9 +2,11 @@ package book
 
 import (
 	"context"
+	"net/url"
 	"strconv"
 
 	"github.com/johanbrandhorst/protobuf/grpcweb/status"
+	"google.golang.org/grpc/metadata"
 	"honnef.co/go/js/dom"
 	r "myitcv.io/react"
 
@@ -25,6 +27,7 @@ type MakeCollectionProps struct {
 
 // MakeCollectionState holds the state for the MakeCollection component
 type MakeCollectionState struct {
+	nameInput  string
 	isbnInput  string
 	numAdded   int
 	collection *library.Collection
@@ -45,9 +48,18 @@ func (g MakeCollectionDef) Render() r.Element {
 		r.Form(&r.FormProps{ClassName: "form-inline"},
 			r.Div(
 				&r.DivProps{ClassName: "form-group"},
+				r.Label(&r.LabelProps{ClassName: "sr-only", For: "collectionNameText"}, r.S("Name")),
+				r.Input(&r.InputProps{
+					Type:        "text",
+					ClassName:   "form-control",
+					ID:          "collectionNameText",
+					Value:       st.nameInput,
+					OnChange:    collectionNameChange{g},
+					Placeholder: "Collection name",
+				}),
 				r.Label(&r.LabelProps{ClassName: "sr-only", For: "isnbText"}, r.S("ISBN")),
 				r.Input(&r.InputProps{
-					Type:        "number",
+					Type:        "text",
 					ClassName:   "form-control",
 					ID:          "isnbText",
 					Value:       st.isbnInput,
@@ -79,6 +91,15 @@ func (g MakeCollectionDef) Render() r.Element {
 	))
 
 	if st.collection != nil {
+		content = append(content,
+			r.Div(nil,
+				r.Hr(nil),
+				r.S("Collection: "),
+				r.Code(nil,
+					r.S(st.collection.GetName()+" ("+st.collection.GetId()+")"),
+				),
+			),
+		)
 		for _, bk := range st.collection.GetBooks() {
 			content = append(content,
 				r.Div(nil,
@@ -86,7 +107,7 @@ func (g MakeCollectionDef) Render() r.Element {
 					r.Div(nil,
 						r.S("ISBN: "),
 						r.Code(nil,
-							r.S(strconv.Itoa(int(bk.GetIsbn()))),
+							r.S(bk.GetIsbn()),
 						),
 					),
 				),
@@ -94,6 +115,22 @@ func (g MakeCollectionDef) Render() r.Element {
 		}
 	}
 
+	if len(st.collection.GetBooks()) > 0 {
+		content = append(content,
+			r.Div(nil,
+				r.Hr(nil),
+				r.S("Download as: "),
+				exportLink(st.collection, "csv", "CSV"),
+				r.S(" "),
+				exportLink(st.collection, "json", "JSON"),
+				r.S(" "),
+				exportLink(st.collection, "bibtex", "BibTeX"),
+				r.S(" "),
+				exportLink(st.collection, "ris", "RIS"),
+			),
+		)
+	}
+
 	if st.err != "" {
 		content = append(content,
 			r.Div(nil,
@@ -106,10 +143,20 @@ func (g MakeCollectionDef) Render() r.Element {
 	return r.Div(nil, content...)
 }
 
+type collectionNameChange struct{ g MakeCollectionDef }
 type isbnInputChange2 struct{ g MakeCollectionDef }
 type triggerAdd struct{ g MakeCollectionDef }
 type triggerCollect struct{ g MakeCollectionDef }
 
+func (n collectionNameChange) OnChange(se *r.SyntheticEvent) {
+	target := se.Target().(*dom.HTMLInputElement)
+
+	newSt := n.g.State()
+	newSt.nameInput = target.Value
+
+	n.g.SetState(newSt)
+}
+
 func (i isbnInputChange2) OnChange(se *r.SyntheticEvent) {
 	target := se.Target().(*dom.HTMLInputElement)
 
@@ -129,14 +176,18 @@ func (t triggerAdd) OnClick(se *r.SyntheticMouseEvent) {
 		newSt.err = ""
 		newSt.collection = nil
 
-		isbn, err := strconv.Atoi(newSt.isbnInput)
-		if err != nil {
+		if newSt.isbnInput == "" {
 			newSt.err = "ISBN must not be empty"
 			return
 		}
 
+		var err error
 		if newSt.client == nil {
-			newSt.client, err = t.g.Props().Client.MakeCollection(context.Background())
+			ctx := context.Background()
+			if newSt.nameInput != "" {
+				ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs("collection-name", newSt.nameInput))
+			}
+			newSt.client, err = t.g.Props().Client.MakeCollection(ctx)
 			if err != nil {
 				sts := status.FromError(err)
 				newSt.err = sts.Error()
@@ -145,7 +196,7 @@ func (t triggerAdd) OnClick(se *r.SyntheticMouseEvent) {
 		}
 
 		err = newSt.client.Send(&library.Book{
-			Isbn: int64(isbn),
+			Isbn: newSt.isbnInput,
 		})
 		newSt.isbnInput = ""
 		if err != nil {
@@ -190,3 +241,16 @@ func (t triggerCollect) OnClick(se *r.SyntheticMouseEvent) {
 
 	se.PreventDefault()
 }
+
+// exportLink returns a link downloading the
+// collection in the export format provided.
+func exportLink(collection *library.Collection, format, name string) r.Element {
+	params := url.Values{
+		"format":        {format},
+		"collection_id": {collection.GetId()},
+	}
+	return r.A(&r.AProps{
+		ClassName: "btn btn-default",
+		Href:      "/export?" + params.Encode(),
+	}, r.S(name))
+}
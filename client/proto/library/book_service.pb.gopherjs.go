@@ -12,17 +12,114 @@
 
 	It has these top-level messages:
 		Publisher
+		Author
+		Work
+		Series
+		Subject
+		TagCount
+		Localization
 		Book
 		GetBookRequest
 		QueryBooksRequest
+		ListBooksRequest
+		ListBooksResponse
+		SearchBooksRequest
+		SearchBooksResponse
+		SearchResult
+		Highlight
+		TextRange
+		RecommendBooksRequest
+		RecommendBooksResponse
+		Recommendation
+		CreateBookRequest
+		UpdateBookRequest
+		CreateAuthorRequest
+		GetAuthorRequest
+		UpdateAuthorRequest
+		UploadCoverRequest
+		CreateWorkRequest
+		GetWorkRequest
+		UpdateWorkRequest
+		ListEditionsRequest
+		ListEditionsResponse
+		CreateSeriesRequest
+		GetSeriesRequest
+		ListSeriesWorksRequest
+		ListSeriesWorksResponse
+		CreateSubjectRequest
+		GetSubjectRequest
+		UpdateSubjectRequest
+		DeleteSubjectRequest
+		BrowseSubjectsRequest
+		BrowseSubjectsResponse
+		SubjectCount
+		TagBookRequest
+		UntagBookRequest
+		ListTagsRequest
+		ListTagsResponse
+		ListAuthorBooksRequest
+		ListAuthorBooksResponse
+		DeleteBookRequest
+		RestoreBookRequest
+		BookRevision
+		ListBookRevisionsRequest
+		ListBookRevisionsResponse
 		Collection
+		GetCollectionRequest
+		ListCollectionsRequest
+		ListCollectionsResponse
+		UpdateCollectionRequest
+		DeleteCollectionRequest
+		ExportCollectionRequest
+		ExportChunk
+		WatchBooksRequest
+		BookEvent
+		Loan
+		CheckoutRequest
+		ReturnRequest
+		RenewRequest
+		ListLoansRequest
+		ListLoansResponse
+		Hold
+		PlaceHoldRequest
+		CancelHoldRequest
+		ListHoldsRequest
+		ListHoldsResponse
+		WatchHoldsRequest
+		Branch
+		Copy
+		CreateBranchRequest
+		GetBranchRequest
+		ListBranchesRequest
+		ListBranchesResponse
+		AddCopyRequest
+		GetCopyRequest
+		GetAvailabilityRequest
+		Availability
+		BranchAvailability
+		TransferCopyRequest
+		ReceiveCopyRequest
 		BookMessage
 		BookResponse
+		Review
+		CreateReviewRequest
+		ListReviewsRequest
+		ListReviewsResponse
+		DeleteReviewRequest
+		Member
+		RegisterMemberRequest
+		GetMemberRequest
+		UpdateMemberRequest
+		SuspendMemberRequest
+		ReinstateMemberRequest
+		DeleteMemberRequest
 */
 package library
 
 import jspb "github.com/johanbrandhorst/protobuf/jspb"
-import google_protobuf "github.com/johanbrandhorst/protobuf/ptypes/timestamp"
+import google_protobuf "github.com/johanbrandhorst/grpcweb-example/client/proto/field_mask"
+import google_protobuf1 "github.com/johanbrandhorst/protobuf/ptypes/timestamp"
+import google_protobuf2 "github.com/johanbrandhorst/protobuf/ptypes/wrappers"
 
 import (
 	context "context"
@@ -62,6 +159,226 @@ func (x BookType) String() string {
 	return BookType_name[int(x)]
 }
 
+// ExportFormat is a file format Books can be exported in.
+type ExportFormat int
+
+const (
+	// CSV is comma separated values with a header row,
+	// which can be imported again.
+	ExportFormat_CSV ExportFormat = 0
+	// JSON is a JSON array of Books in the proto3 JSON mapping,
+	// which can be imported again.
+	ExportFormat_JSON ExportFormat = 1
+	// BIBTEX is a BibTeX bibliography with a @book entry per Book.
+	ExportFormat_BIBTEX ExportFormat = 2
+	// RIS is a RIS citation file with a record per Book.
+	ExportFormat_RIS ExportFormat = 3
+)
+
+var ExportFormat_name = map[int]string{
+	0: "CSV",
+	1: "JSON",
+	2: "BIBTEX",
+	3: "RIS",
+}
+var ExportFormat_value = map[string]int{
+	"CSV":    0,
+	"JSON":   1,
+	"BIBTEX": 2,
+	"RIS":    3,
+}
+
+func (x ExportFormat) String() string {
+	return ExportFormat_name[int(x)]
+}
+
+// Reason is why a book is recommended.
+type Recommendation_Reason int
+
+const (
+	// READ_TOGETHER books are often in the same collections,
+	// or borrowed by the same members, as the seed books.
+	Recommendation_READ_TOGETHER Recommendation_Reason = 0
+	// SAME_AUTHOR books are by the author of a seed book.
+	Recommendation_SAME_AUTHOR Recommendation_Reason = 1
+	// SAME_BOOK_TYPE books are of the type of a seed book.
+	Recommendation_SAME_BOOK_TYPE Recommendation_Reason = 2
+)
+
+var Recommendation_Reason_name = map[int]string{
+	0: "READ_TOGETHER",
+	1: "SAME_AUTHOR",
+	2: "SAME_BOOK_TYPE",
+}
+var Recommendation_Reason_value = map[string]int{
+	"READ_TOGETHER":  0,
+	"SAME_AUTHOR":    1,
+	"SAME_BOOK_TYPE": 2,
+}
+
+func (x Recommendation_Reason) String() string {
+	return Recommendation_Reason_name[int(x)]
+}
+
+// ChangeType is the kind of change that created a revision.
+type BookRevision_ChangeType int
+
+const (
+	// CREATED revisions add the Book to the library.
+	BookRevision_CREATED BookRevision_ChangeType = 0
+	// UPDATED revisions change the Book.
+	BookRevision_UPDATED BookRevision_ChangeType = 1
+	// DELETED revisions delete the Book.
+	BookRevision_DELETED BookRevision_ChangeType = 2
+	// RESTORED revisions restore the deleted Book.
+	BookRevision_RESTORED BookRevision_ChangeType = 3
+)
+
+var BookRevision_ChangeType_name = map[int]string{
+	0: "CREATED",
+	1: "UPDATED",
+	2: "DELETED",
+	3: "RESTORED",
+}
+var BookRevision_ChangeType_value = map[string]int{
+	"CREATED":  0,
+	"UPDATED":  1,
+	"DELETED":  2,
+	"RESTORED": 3,
+}
+
+func (x BookRevision_ChangeType) String() string {
+	return BookRevision_ChangeType_name[int(x)]
+}
+
+// Type is the kind of change made.
+type BookEvent_Type int
+
+const (
+	// BOOKMARK events carry no Book. One is sent when a watch
+	// is started without a resume token, to report the revision
+	// the watch starts at. They are also sent periodically when
+	// changes not matching the filter have moved the revision on,
+	// so the resume token of the last event received stays current.
+	BookEvent_BOOKMARK BookEvent_Type = 0
+	// ADDED events are sent when a Book is added,
+	// or is changed to match the filter.
+	BookEvent_ADDED BookEvent_Type = 1
+	// UPDATED events are sent when a Book matching the filter is changed.
+	BookEvent_UPDATED BookEvent_Type = 2
+	// DELETED events are sent when a Book is deleted,
+	// or is changed to no longer match the filter.
+	BookEvent_DELETED BookEvent_Type = 3
+)
+
+var BookEvent_Type_name = map[int]string{
+	0: "BOOKMARK",
+	1: "ADDED",
+	2: "UPDATED",
+	3: "DELETED",
+}
+var BookEvent_Type_value = map[string]int{
+	"BOOKMARK": 0,
+	"ADDED":    1,
+	"UPDATED":  2,
+	"DELETED":  3,
+}
+
+func (x BookEvent_Type) String() string {
+	return BookEvent_Type_name[int(x)]
+}
+
+// State is the state of a hold.
+type Hold_State int
+
+const (
+	// WAITING holds are queued for a copy.
+	Hold_WAITING Hold_State = 0
+	// READY holds have a copy set aside until the expire time.
+	Hold_READY Hold_State = 1
+	// FULFILLED holds have been checked out by the member.
+	Hold_FULFILLED Hold_State = 2
+	// CANCELLED holds were cancelled by the member.
+	Hold_CANCELLED Hold_State = 3
+	// EXPIRED holds were not checked out before the expire time.
+	Hold_EXPIRED Hold_State = 4
+)
+
+var Hold_State_name = map[int]string{
+	0: "WAITING",
+	1: "READY",
+	2: "FULFILLED",
+	3: "CANCELLED",
+	4: "EXPIRED",
+}
+var Hold_State_value = map[string]int{
+	"WAITING":   0,
+	"READY":     1,
+	"FULFILLED": 2,
+	"CANCELLED": 3,
+	"EXPIRED":   4,
+}
+
+func (x Hold_State) String() string {
+	return Hold_State_name[int(x)]
+}
+
+// State is the state of a copy.
+type Copy_State int
+
+const (
+	// AVAILABLE copies are at their branch, and may be lent.
+	Copy_AVAILABLE Copy_State = 0
+	// ON_LOAN copies have been checked out by barcode.
+	Copy_ON_LOAN Copy_State = 1
+	// IN_TRANSIT copies are being transferred to another branch.
+	Copy_IN_TRANSIT Copy_State = 2
+	// RESERVED copies are at their branch, set aside for
+	// the ready hold with the barcode of the copy.
+	Copy_RESERVED Copy_State = 3
+)
+
+var Copy_State_name = map[int]string{
+	0: "AVAILABLE",
+	1: "ON_LOAN",
+	2: "IN_TRANSIT",
+	3: "RESERVED",
+}
+var Copy_State_value = map[string]int{
+	"AVAILABLE":  0,
+	"ON_LOAN":    1,
+	"IN_TRANSIT": 2,
+	"RESERVED":   3,
+}
+
+func (x Copy_State) String() string {
+	return Copy_State_name[int(x)]
+}
+
+// State is the state of a membership.
+type Member_State int
+
+const (
+	// ACTIVE members may borrow books.
+	Member_ACTIVE Member_State = 0
+	// SUSPENDED members may not borrow books, place holds
+	// or join the chat until they are reinstated.
+	Member_SUSPENDED Member_State = 1
+)
+
+var Member_State_name = map[int]string{
+	0: "ACTIVE",
+	1: "SUSPENDED",
+}
+var Member_State_value = map[string]int{
+	"ACTIVE":    0,
+	"SUSPENDED": 1,
+}
+
+func (x Member_State) String() string {
+	return Member_State_name[int(x)]
+}
+
 // Publisher describes a Book Publisher.
 type Publisher struct {
 	// Name is the name of the Publisher.
@@ -127,192 +444,118 @@ func (m *Publisher) Unmarshal(rawBytes []byte) (*Publisher, error) {
 	return m, nil
 }
 
-// Book represents a book in the library.
-type Book struct {
-	// Isbn is the ISBN number of the book.
-	Isbn int64
-	// Title is the title of the book.
-	Title string
-	// Author is the author of the book.
-	Author string
-	// BookType is the type of the book.
-	BookType BookType
-	// PublishingMethod is the publishing method
-	// used for this Book.
-	//
-	// Types that are valid to be assigned to PublishingMethod:
-	//	*Book_SelfPublished
-	//	*Book_Publisher
-	PublishingMethod isBook_PublishingMethod
-	// PublicationDate is the time of publication of the book.
-	PublicationDate *google_protobuf.Timestamp
-}
-
-// isBook_PublishingMethod is used to distinguish types assignable to PublishingMethod
-type isBook_PublishingMethod interface{ isBook_PublishingMethod() }
-
-// Book_SelfPublished is assignable to PublishingMethod
-type Book_SelfPublished struct {
-	// SelfPublished means this book was
-	// self published.
-	SelfPublished bool
-}
-
-// Book_Publisher is assignable to PublishingMethod
-type Book_Publisher struct {
-	// Publisher means this book was published
-	// through a Publisher.
-	Publisher *Publisher
-}
-
-func (*Book_SelfPublished) isBook_PublishingMethod() {}
-func (*Book_Publisher) isBook_PublishingMethod()     {}
-
-// GetPublishingMethod gets the PublishingMethod of the Book.
-func (m *Book) GetPublishingMethod() (x isBook_PublishingMethod) {
-	if m == nil {
-		return x
-	}
-	return m.PublishingMethod
+// Author is an author of Books in the library.
+type Author struct {
+	// Id identifies the author. It is set by the server.
+	Id string
+	// Name is the name of the author, as printed on their books.
+	Name string
+	// SortName is the name of the author as it is ordered in
+	// a catalog, for example "Orwell, George". If empty, it is
+	// set from the name by the server.
+	SortName string
+	// BirthYear is the year the author was born, or 0 if unknown.
+	// Years before the common era are negative.
+	BirthYear int32
+	// DeathYear is the year the author died, or 0
+	// if the author is alive or it is unknown.
+	DeathYear int32
 }
 
-// GetIsbn gets the Isbn of the Book.
-func (m *Book) GetIsbn() (x int64) {
+// GetId gets the Id of the Author.
+func (m *Author) GetId() (x string) {
 	if m == nil {
 		return x
 	}
-	return m.Isbn
+	return m.Id
 }
 
-// GetTitle gets the Title of the Book.
-func (m *Book) GetTitle() (x string) {
+// GetName gets the Name of the Author.
+func (m *Author) GetName() (x string) {
 	if m == nil {
 		return x
 	}
-	return m.Title
+	return m.Name
 }
 
-// GetAuthor gets the Author of the Book.
-func (m *Book) GetAuthor() (x string) {
+// GetSortName gets the SortName of the Author.
+func (m *Author) GetSortName() (x string) {
 	if m == nil {
 		return x
 	}
-	return m.Author
+	return m.SortName
 }
 
-// GetBookType gets the BookType of the Book.
-func (m *Book) GetBookType() (x BookType) {
+// GetBirthYear gets the BirthYear of the Author.
+func (m *Author) GetBirthYear() (x int32) {
 	if m == nil {
 		return x
 	}
-	return m.BookType
-}
-
-// GetSelfPublished gets the SelfPublished of the Book.
-func (m *Book) GetSelfPublished() (x bool) {
-	if v, ok := m.GetPublishingMethod().(*Book_SelfPublished); ok {
-		return v.SelfPublished
-	}
-	return x
-}
-
-// GetPublisher gets the Publisher of the Book.
-func (m *Book) GetPublisher() (x *Publisher) {
-	if v, ok := m.GetPublishingMethod().(*Book_Publisher); ok {
-		return v.Publisher
-	}
-	return x
+	return m.BirthYear
 }
 
-// GetPublicationDate gets the PublicationDate of the Book.
-func (m *Book) GetPublicationDate() (x *google_protobuf.Timestamp) {
+// GetDeathYear gets the DeathYear of the Author.
+func (m *Author) GetDeathYear() (x int32) {
 	if m == nil {
 		return x
 	}
-	return m.PublicationDate
+	return m.DeathYear
 }
 
-// MarshalToWriter marshals Book to the provided writer.
-func (m *Book) MarshalToWriter(writer jspb.Writer) {
+// MarshalToWriter marshals Author to the provided writer.
+func (m *Author) MarshalToWriter(writer jspb.Writer) {
 	if m == nil {
 		return
 	}
 
-	switch t := m.PublishingMethod.(type) {
-	case *Book_SelfPublished:
-		if t.SelfPublished {
-			writer.WriteBool(5, t.SelfPublished)
-		}
-	case *Book_Publisher:
-		if t.Publisher != nil {
-			writer.WriteMessage(6, func() {
-				t.Publisher.MarshalToWriter(writer)
-			})
-		}
-	}
-
-	if m.Isbn != 0 {
-		writer.WriteInt64(1, m.Isbn)
+	if len(m.Id) > 0 {
+		writer.WriteString(1, m.Id)
 	}
 
-	if len(m.Title) > 0 {
-		writer.WriteString(2, m.Title)
+	if len(m.Name) > 0 {
+		writer.WriteString(2, m.Name)
 	}
 
-	if len(m.Author) > 0 {
-		writer.WriteString(3, m.Author)
+	if len(m.SortName) > 0 {
+		writer.WriteString(3, m.SortName)
 	}
 
-	if int(m.BookType) != 0 {
-		writer.WriteEnum(4, int(m.BookType))
+	if m.BirthYear != 0 {
+		writer.WriteInt32(4, m.BirthYear)
 	}
 
-	if m.PublicationDate != nil {
-		writer.WriteMessage(7, func() {
-			m.PublicationDate.MarshalToWriter(writer)
-		})
+	if m.DeathYear != 0 {
+		writer.WriteInt32(5, m.DeathYear)
 	}
 
 	return
 }
 
-// Marshal marshals Book to a slice of bytes.
-func (m *Book) Marshal() []byte {
+// Marshal marshals Author to a slice of bytes.
+func (m *Author) Marshal() []byte {
 	writer := jspb.NewWriter()
 	m.MarshalToWriter(writer)
 	return writer.GetResult()
 }
 
-// UnmarshalFromReader unmarshals a Book from the provided reader.
-func (m *Book) UnmarshalFromReader(reader jspb.Reader) *Book {
+// UnmarshalFromReader unmarshals a Author from the provided reader.
+func (m *Author) UnmarshalFromReader(reader jspb.Reader) *Author {
 	for reader.Next() {
 		if m == nil {
-			m = &Book{}
+			m = &Author{}
 		}
 
 		switch reader.GetFieldNumber() {
 		case 1:
-			m.Isbn = reader.ReadInt64()
+			m.Id = reader.ReadString()
 		case 2:
-			m.Title = reader.ReadString()
+			m.Name = reader.ReadString()
 		case 3:
-			m.Author = reader.ReadString()
+			m.SortName = reader.ReadString()
 		case 4:
-			m.BookType = BookType(reader.ReadEnum())
+			m.BirthYear = reader.ReadInt32()
 		case 5:
-			m.PublishingMethod = &Book_SelfPublished{
-				SelfPublished: reader.ReadBool(),
-			}
-		case 6:
-			reader.ReadMessage(func() {
-				m.PublishingMethod = &Book_Publisher{
-					Publisher: new(Publisher).UnmarshalFromReader(reader),
-				}
-			})
-		case 7:
-			reader.ReadMessage(func() {
-				m.PublicationDate = m.PublicationDate.UnmarshalFromReader(reader)
-			})
+			m.DeathYear = reader.ReadInt32()
 		default:
 			reader.SkipField()
 		}
@@ -321,8 +564,8 @@ func (m *Book) UnmarshalFromReader(reader jspb.Reader) *Book {
 	return m
 }
 
-// Unmarshal unmarshals a Book from a slice of bytes.
-func (m *Book) Unmarshal(rawBytes []byte) (*Book, error) {
+// Unmarshal unmarshals a Author from a slice of bytes.
+func (m *Author) Unmarshal(rawBytes []byte) (*Author, error) {
 	reader := jspb.NewReader(rawBytes)
 
 	m = m.UnmarshalFromReader(reader)
@@ -334,51 +577,118 @@ func (m *Book) Unmarshal(rawBytes []byte) (*Book, error) {
 	return m, nil
 }
 
-// GetBookRequest is the input to the GetBook method.
-type GetBookRequest struct {
-	// Isbn is the ISBN with which
-	// to match against the ISBN of a book in the library.
-	Isbn int64
+// Work is a text published in one or more editions,
+// such as the hardcover and paperback of a novel.
+type Work struct {
+	// Id identifies the work. It is set by the server.
+	Id string
+	// Title is the title of the work.
+	Title string
+	// AuthorIds are the IDs of the authors of the work.
+	// The authors must exist.
+	AuthorIds []string
+	// SeriesId is the ID of the series the work is part of, if any.
+	SeriesId string
+	// SeriesNumber is the position of the work in its series,
+	// starting at 1. It must be set if the series is, and no
+	// two works in a series may have the same number.
+	SeriesNumber int32
 }
 
-// GetIsbn gets the Isbn of the GetBookRequest.
-func (m *GetBookRequest) GetIsbn() (x int64) {
+// GetId gets the Id of the Work.
+func (m *Work) GetId() (x string) {
 	if m == nil {
 		return x
 	}
-	return m.Isbn
+	return m.Id
 }
 
-// MarshalToWriter marshals GetBookRequest to the provided writer.
-func (m *GetBookRequest) MarshalToWriter(writer jspb.Writer) {
+// GetTitle gets the Title of the Work.
+func (m *Work) GetTitle() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Title
+}
+
+// GetAuthorIds gets the AuthorIds of the Work.
+func (m *Work) GetAuthorIds() (x []string) {
+	if m == nil {
+		return x
+	}
+	return m.AuthorIds
+}
+
+// GetSeriesId gets the SeriesId of the Work.
+func (m *Work) GetSeriesId() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.SeriesId
+}
+
+// GetSeriesNumber gets the SeriesNumber of the Work.
+func (m *Work) GetSeriesNumber() (x int32) {
+	if m == nil {
+		return x
+	}
+	return m.SeriesNumber
+}
+
+// MarshalToWriter marshals Work to the provided writer.
+func (m *Work) MarshalToWriter(writer jspb.Writer) {
 	if m == nil {
 		return
 	}
 
-	if m.Isbn != 0 {
-		writer.WriteInt64(1, m.Isbn)
+	if len(m.Id) > 0 {
+		writer.WriteString(1, m.Id)
+	}
+
+	if len(m.Title) > 0 {
+		writer.WriteString(2, m.Title)
+	}
+
+	for _, val := range m.AuthorIds {
+		writer.WriteString(3, val)
+	}
+
+	if len(m.SeriesId) > 0 {
+		writer.WriteString(4, m.SeriesId)
+	}
+
+	if m.SeriesNumber != 0 {
+		writer.WriteInt32(5, m.SeriesNumber)
 	}
 
 	return
 }
 
-// Marshal marshals GetBookRequest to a slice of bytes.
-func (m *GetBookRequest) Marshal() []byte {
+// Marshal marshals Work to a slice of bytes.
+func (m *Work) Marshal() []byte {
 	writer := jspb.NewWriter()
 	m.MarshalToWriter(writer)
 	return writer.GetResult()
 }
 
-// UnmarshalFromReader unmarshals a GetBookRequest from the provided reader.
-func (m *GetBookRequest) UnmarshalFromReader(reader jspb.Reader) *GetBookRequest {
+// UnmarshalFromReader unmarshals a Work from the provided reader.
+func (m *Work) UnmarshalFromReader(reader jspb.Reader) *Work {
 	for reader.Next() {
 		if m == nil {
-			m = &GetBookRequest{}
+			m = &Work{}
 		}
 
 		switch reader.GetFieldNumber() {
 		case 1:
-			m.Isbn = reader.ReadInt64()
+			m.Id = reader.ReadString()
+		case 2:
+			m.Title = reader.ReadString()
+		case 3:
+			m.AuthorIds = append(m.AuthorIds, reader.ReadString())
+		case 4:
+			m.SeriesId = reader.ReadString()
+		case 5:
+			m.SeriesNumber = reader.ReadInt32()
 		default:
 			reader.SkipField()
 		}
@@ -387,8 +697,8 @@ func (m *GetBookRequest) UnmarshalFromReader(reader jspb.Reader) *GetBookRequest
 	return m
 }
 
-// Unmarshal unmarshals a GetBookRequest from a slice of bytes.
-func (m *GetBookRequest) Unmarshal(rawBytes []byte) (*GetBookRequest, error) {
+// Unmarshal unmarshals a Work from a slice of bytes.
+func (m *Work) Unmarshal(rawBytes []byte) (*Work, error) {
 	reader := jspb.NewReader(rawBytes)
 
 	m = m.UnmarshalFromReader(reader)
@@ -400,51 +710,66 @@ func (m *GetBookRequest) Unmarshal(rawBytes []byte) (*GetBookRequest, error) {
 	return m, nil
 }
 
-// QueryBooksRequest is the input to the QueryBooks method.
-type QueryBooksRequest struct {
-	// AuthorPrefix is the prefix with which
-	// to match against the author of a book in the library.
-	AuthorPrefix string
+// Series is a sequence of works, such as the novels of a trilogy.
+type Series struct {
+	// Id identifies the series. It is set by the server.
+	Id string
+	// Name is the name of the series.
+	Name string
 }
 
-// GetAuthorPrefix gets the AuthorPrefix of the QueryBooksRequest.
-func (m *QueryBooksRequest) GetAuthorPrefix() (x string) {
+// GetId gets the Id of the Series.
+func (m *Series) GetId() (x string) {
 	if m == nil {
 		return x
 	}
-	return m.AuthorPrefix
+	return m.Id
 }
 
-// MarshalToWriter marshals QueryBooksRequest to the provided writer.
-func (m *QueryBooksRequest) MarshalToWriter(writer jspb.Writer) {
+// GetName gets the Name of the Series.
+func (m *Series) GetName() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Name
+}
+
+// MarshalToWriter marshals Series to the provided writer.
+func (m *Series) MarshalToWriter(writer jspb.Writer) {
 	if m == nil {
 		return
 	}
 
-	if len(m.AuthorPrefix) > 0 {
-		writer.WriteString(1, m.AuthorPrefix)
+	if len(m.Id) > 0 {
+		writer.WriteString(1, m.Id)
+	}
+
+	if len(m.Name) > 0 {
+		writer.WriteString(2, m.Name)
 	}
 
 	return
 }
 
-// Marshal marshals QueryBooksRequest to a slice of bytes.
-func (m *QueryBooksRequest) Marshal() []byte {
+// Marshal marshals Series to a slice of bytes.
+func (m *Series) Marshal() []byte {
 	writer := jspb.NewWriter()
 	m.MarshalToWriter(writer)
 	return writer.GetResult()
 }
 
-// UnmarshalFromReader unmarshals a QueryBooksRequest from the provided reader.
-func (m *QueryBooksRequest) UnmarshalFromReader(reader jspb.Reader) *QueryBooksRequest {
+// UnmarshalFromReader unmarshals a Series from the provided reader.
+func (m *Series) UnmarshalFromReader(reader jspb.Reader) *Series {
 	for reader.Next() {
 		if m == nil {
-			m = &QueryBooksRequest{}
+			m = &Series{}
 		}
 
 		switch reader.GetFieldNumber() {
 		case 1:
-			m.AuthorPrefix = reader.ReadString()
+			m.Id = reader.ReadString()
+		case 2:
+			m.Name = reader.ReadString()
 		default:
 			reader.SkipField()
 		}
@@ -453,8 +778,8 @@ func (m *QueryBooksRequest) UnmarshalFromReader(reader jspb.Reader) *QueryBooksR
 	return m
 }
 
-// Unmarshal unmarshals a QueryBooksRequest from a slice of bytes.
-func (m *QueryBooksRequest) Unmarshal(rawBytes []byte) (*QueryBooksRequest, error) {
+// Unmarshal unmarshals a Series from a slice of bytes.
+func (m *Series) Unmarshal(rawBytes []byte) (*Series, error) {
 	reader := jspb.NewReader(rawBytes)
 
 	m = m.UnmarshalFromReader(reader)
@@ -466,54 +791,86 @@ func (m *QueryBooksRequest) Unmarshal(rawBytes []byte) (*QueryBooksRequest, erro
 	return m, nil
 }
 
-// Collection is a collection of books
-type Collection struct {
-	// Books is a list of books
-	Books []*Book
+// Subject is a subject in the subject taxonomy of the library,
+// such as FIC009000 for Fiction / Fantasy / General. Subjects
+// form a tree, each subject under its parent, if it has one.
+type Subject struct {
+	// Code identifies the subject. It may only contain
+	// uppercase letters and digits, as in BISAC subject codes.
+	Code string
+	// Name is the name of the subject.
+	Name string
+	// ParentCode is the code of the subject this subject is under,
+	// or empty for top level subjects. The parent must exist.
+	ParentCode string
 }
 
-// GetBooks gets the Books of the Collection.
-func (m *Collection) GetBooks() (x []*Book) {
+// GetCode gets the Code of the Subject.
+func (m *Subject) GetCode() (x string) {
 	if m == nil {
 		return x
 	}
-	return m.Books
+	return m.Code
 }
 
-// MarshalToWriter marshals Collection to the provided writer.
-func (m *Collection) MarshalToWriter(writer jspb.Writer) {
+// GetName gets the Name of the Subject.
+func (m *Subject) GetName() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Name
+}
+
+// GetParentCode gets the ParentCode of the Subject.
+func (m *Subject) GetParentCode() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.ParentCode
+}
+
+// MarshalToWriter marshals Subject to the provided writer.
+func (m *Subject) MarshalToWriter(writer jspb.Writer) {
 	if m == nil {
 		return
 	}
 
-	for _, msg := range m.Books {
-		writer.WriteMessage(1, func() {
-			msg.MarshalToWriter(writer)
-		})
+	if len(m.Code) > 0 {
+		writer.WriteString(1, m.Code)
+	}
+
+	if len(m.Name) > 0 {
+		writer.WriteString(2, m.Name)
+	}
+
+	if len(m.ParentCode) > 0 {
+		writer.WriteString(3, m.ParentCode)
 	}
 
 	return
 }
 
-// Marshal marshals Collection to a slice of bytes.
-func (m *Collection) Marshal() []byte {
+// Marshal marshals Subject to a slice of bytes.
+func (m *Subject) Marshal() []byte {
 	writer := jspb.NewWriter()
 	m.MarshalToWriter(writer)
 	return writer.GetResult()
 }
 
-// UnmarshalFromReader unmarshals a Collection from the provided reader.
-func (m *Collection) UnmarshalFromReader(reader jspb.Reader) *Collection {
+// UnmarshalFromReader unmarshals a Subject from the provided reader.
+func (m *Subject) UnmarshalFromReader(reader jspb.Reader) *Subject {
 	for reader.Next() {
 		if m == nil {
-			m = &Collection{}
+			m = &Subject{}
 		}
 
 		switch reader.GetFieldNumber() {
 		case 1:
-			reader.ReadMessage(func() {
-				m.Books = append(m.Books, new(Book).UnmarshalFromReader(reader))
-			})
+			m.Code = reader.ReadString()
+		case 2:
+			m.Name = reader.ReadString()
+		case 3:
+			m.ParentCode = reader.ReadString()
 		default:
 			reader.SkipField()
 		}
@@ -522,8 +879,8 @@ func (m *Collection) UnmarshalFromReader(reader jspb.Reader) *Collection {
 	return m
 }
 
-// Unmarshal unmarshals a Collection from a slice of bytes.
-func (m *Collection) Unmarshal(rawBytes []byte) (*Collection, error) {
+// Unmarshal unmarshals a Subject from a slice of bytes.
+func (m *Subject) Unmarshal(rawBytes []byte) (*Subject, error) {
 	reader := jspb.NewReader(rawBytes)
 
 	m = m.UnmarshalFromReader(reader)
@@ -535,100 +892,67 @@ func (m *Collection) Unmarshal(rawBytes []byte) (*Collection, error) {
 	return m, nil
 }
 
-// BookMessage is used to discuss books
-type BookMessage struct {
-	// Types that are valid to be assigned to Content:
-	//	*BookMessage_Name
-	//	*BookMessage_Message
-	Content isBookMessage_Content
-}
-
-// isBookMessage_Content is used to distinguish types assignable to Content
-type isBookMessage_Content interface{ isBookMessage_Content() }
-
-// BookMessage_Name is assignable to Content
-type BookMessage_Name struct {
-	// Name is the name of the person who is sending this message.
-	// It should be sent as the first message on the stream.
-	Name string
-}
-
-// BookMessage_Message is assignable to Content
-type BookMessage_Message struct {
-	// Message is any message the user wishes to send.
-	Message string
+// TagCount is the number of times a tag has been applied.
+type TagCount struct {
+	// Tag is the tag.
+	Tag string
+	// Count is the number of members who have applied the tag
+	// to a book, or the number of books tagged with it.
+	Count int32
 }
 
-func (*BookMessage_Name) isBookMessage_Content()    {}
-func (*BookMessage_Message) isBookMessage_Content() {}
-
-// GetContent gets the Content of the BookMessage.
-func (m *BookMessage) GetContent() (x isBookMessage_Content) {
+// GetTag gets the Tag of the TagCount.
+func (m *TagCount) GetTag() (x string) {
 	if m == nil {
 		return x
 	}
-	return m.Content
-}
-
-// GetName gets the Name of the BookMessage.
-func (m *BookMessage) GetName() (x string) {
-	if v, ok := m.GetContent().(*BookMessage_Name); ok {
-		return v.Name
-	}
-	return x
+	return m.Tag
 }
 
-// GetMessage gets the Message of the BookMessage.
-func (m *BookMessage) GetMessage() (x string) {
-	if v, ok := m.GetContent().(*BookMessage_Message); ok {
-		return v.Message
+// GetCount gets the Count of the TagCount.
+func (m *TagCount) GetCount() (x int32) {
+	if m == nil {
+		return x
 	}
-	return x
+	return m.Count
 }
 
-// MarshalToWriter marshals BookMessage to the provided writer.
-func (m *BookMessage) MarshalToWriter(writer jspb.Writer) {
+// MarshalToWriter marshals TagCount to the provided writer.
+func (m *TagCount) MarshalToWriter(writer jspb.Writer) {
 	if m == nil {
 		return
 	}
 
-	switch t := m.Content.(type) {
-	case *BookMessage_Name:
-		if len(t.Name) > 0 {
-			writer.WriteString(1, t.Name)
-		}
-	case *BookMessage_Message:
-		if len(t.Message) > 0 {
-			writer.WriteString(2, t.Message)
-		}
+	if len(m.Tag) > 0 {
+		writer.WriteString(1, m.Tag)
+	}
+
+	if m.Count != 0 {
+		writer.WriteInt32(2, m.Count)
 	}
 
 	return
 }
 
-// Marshal marshals BookMessage to a slice of bytes.
-func (m *BookMessage) Marshal() []byte {
+// Marshal marshals TagCount to a slice of bytes.
+func (m *TagCount) Marshal() []byte {
 	writer := jspb.NewWriter()
 	m.MarshalToWriter(writer)
 	return writer.GetResult()
 }
 
-// UnmarshalFromReader unmarshals a BookMessage from the provided reader.
-func (m *BookMessage) UnmarshalFromReader(reader jspb.Reader) *BookMessage {
+// UnmarshalFromReader unmarshals a TagCount from the provided reader.
+func (m *TagCount) UnmarshalFromReader(reader jspb.Reader) *TagCount {
 	for reader.Next() {
 		if m == nil {
-			m = &BookMessage{}
+			m = &TagCount{}
 		}
 
 		switch reader.GetFieldNumber() {
 		case 1:
-			m.Content = &BookMessage_Name{
-				Name: reader.ReadString(),
-			}
+			m.Tag = reader.ReadString()
 		case 2:
-			m.Content = &BookMessage_Message{
-				Message: reader.ReadString(),
-			}
+			m.Count = reader.ReadInt32()
 		default:
 			reader.SkipField()
 		}
@@ -637,8 +961,8 @@ func (m *BookMessage) UnmarshalFromReader(reader jspb.Reader) *BookMessage {
 	return m
 }
 
-// Unmarshal unmarshals a BookMessage from a slice of bytes.
-func (m *BookMessage) Unmarshal(rawBytes []byte) (*BookMessage, error) {
+// Unmarshal unmarshals a TagCount from a slice of bytes.
+func (m *TagCount) Unmarshal(rawBytes []byte) (*TagCount, error) {
 	reader := jspb.NewReader(rawBytes)
 
 	m = m.UnmarshalFromReader(reader)
@@ -650,50 +974,82 @@ func (m *BookMessage) Unmarshal(rawBytes []byte) (*BookMessage, error) {
 	return m, nil
 }
 
-// BookResponse is used to discuss books
-type BookResponse struct {
-	// Message is a message from a user.
-	Message string
+// Localization is the title and description of a Book in a language.
+type Localization struct {
+	// LanguageCode is the BCP 47 code of the language, such as fr or pt-BR.
+	LanguageCode string
+	// Title is the title of the book in the language.
+	Title string
+	// Description is the description of the book in the language.
+	Description string
 }
 
-// GetMessage gets the Message of the BookResponse.
-func (m *BookResponse) GetMessage() (x string) {
+// GetLanguageCode gets the LanguageCode of the Localization.
+func (m *Localization) GetLanguageCode() (x string) {
 	if m == nil {
 		return x
 	}
-	return m.Message
+	return m.LanguageCode
 }
 
-// MarshalToWriter marshals BookResponse to the provided writer.
-func (m *BookResponse) MarshalToWriter(writer jspb.Writer) {
+// GetTitle gets the Title of the Localization.
+func (m *Localization) GetTitle() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Title
+}
+
+// GetDescription gets the Description of the Localization.
+func (m *Localization) GetDescription() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Description
+}
+
+// MarshalToWriter marshals Localization to the provided writer.
+func (m *Localization) MarshalToWriter(writer jspb.Writer) {
 	if m == nil {
 		return
 	}
 
-	if len(m.Message) > 0 {
-		writer.WriteString(2, m.Message)
+	if len(m.LanguageCode) > 0 {
+		writer.WriteString(1, m.LanguageCode)
+	}
+
+	if len(m.Title) > 0 {
+		writer.WriteString(2, m.Title)
+	}
+
+	if len(m.Description) > 0 {
+		writer.WriteString(3, m.Description)
 	}
 
 	return
 }
 
-// Marshal marshals BookResponse to a slice of bytes.
-func (m *BookResponse) Marshal() []byte {
+// Marshal marshals Localization to a slice of bytes.
+func (m *Localization) Marshal() []byte {
 	writer := jspb.NewWriter()
 	m.MarshalToWriter(writer)
 	return writer.GetResult()
 }
 
-// UnmarshalFromReader unmarshals a BookResponse from the provided reader.
-func (m *BookResponse) UnmarshalFromReader(reader jspb.Reader) *BookResponse {
+// UnmarshalFromReader unmarshals a Localization from the provided reader.
+func (m *Localization) UnmarshalFromReader(reader jspb.Reader) *Localization {
 	for reader.Next() {
 		if m == nil {
-			m = &BookResponse{}
+			m = &Localization{}
 		}
 
 		switch reader.GetFieldNumber() {
+		case 1:
+			m.LanguageCode = reader.ReadString()
 		case 2:
-			m.Message = reader.ReadString()
+			m.Title = reader.ReadString()
+		case 3:
+			m.Description = reader.ReadString()
 		default:
 			reader.SkipField()
 		}
@@ -702,8 +1058,8 @@ func (m *BookResponse) UnmarshalFromReader(reader jspb.Reader) *BookResponse {
 	return m
 }
 
-// Unmarshal unmarshals a BookResponse from a slice of bytes.
-func (m *BookResponse) Unmarshal(rawBytes []byte) (*BookResponse, error) {
+// Unmarshal unmarshals a Localization from a slice of bytes.
+func (m *Localization) Unmarshal(rawBytes []byte) (*Localization, error) {
 	reader := jspb.NewReader(rawBytes)
 
 	m = m.UnmarshalFromReader(reader)
@@ -715,65 +1071,9511 @@ func (m *BookResponse) Unmarshal(rawBytes []byte) (*BookResponse, error) {
 	return m, nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpcweb.Client
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpcweb package it is being compiled against.
-const _ = grpcweb.GrpcWebPackageIsVersion3
+// Book represents a book in the library.
+type Book struct {
+	// LegacyIsbn is the ISBN of the book as a number.
+	// Leading zeros are lost in this form, so it is
+	// deprecated in favour of Isbn. It is still set on
+	// all books returned, and accepted in place of Isbn.
+	LegacyIsbn int64
+	// Title is the title of the book.
+	Title string
+	// Author is the names of the authors of the book, separated
+	// by "; ". It is deprecated in favour of AuthorIds, and is set
+	// by the server on all books returned. It is still accepted in
+	// place of AuthorIds, in which case the authors are looked up
+	// by name and added to the library if they don't exist.
+	Author string
+	// BookType is the type of the book.
+	BookType BookType
+	// PublishingMethod is the publishing method
+	// used for this Book.
+	//
+	// Types that are valid to be assigned to PublishingMethod:
+	//	*Book_SelfPublished
+	//	*Book_Publisher
+	PublishingMethod isBook_PublishingMethod
+	// PublicationDate is the time of publication of the book.
+	PublicationDate *google_protobuf1.Timestamp
+	// Isbn is the ISBN-13 of the book, without hyphens.
+	// An ISBN-10 or ISBN-13, optionally with hyphens, is
+	// accepted when adding a book to the library.
+	Isbn string
+	// Isbn10 is the ISBN-10 of the book, if it has one.
+	// It is set by the server.
+	Isbn10 string
+	// Etag identifies the version of the book. It is set by the
+	// server and changes every time the book is written, except when
+	// only its copies, available copies, average rating and review count
+	// change. Pass it to UpdateBook or DeleteBook to only change the
+	// version read.
+	Etag string
+	// DeleteTime is when the book was deleted.
+	// It is only set on deleted books.
+	DeleteTime *google_protobuf1.Timestamp
+	// Copies is the number of copies of the book
+	// the library has for lending. Adding a Copy
+	// to a branch with AddCopy increases it.
+	Copies int32
+	// AvailableCopies is the number of copies that are not on loan,
+	// in transit or set aside for a hold. It is set by the server.
+	AvailableCopies int32
+	// AverageRating is the average rating of the reviews
+	// of the book, from 1 to 5, or 0 if it has no reviews.
+	// It is set by the server.
+	AverageRating float64
+	// ReviewCount is the number of reviews of the book.
+	// It is set by the server.
+	ReviewCount int32
+	// AuthorIds are the IDs of the authors of the book, in
+	// the order they are credited. The authors must exist.
+	AuthorIds []string
+	// WorkId is the ID of the work the book is an edition of, if any.
+	// The work must exist.
+	WorkId string
+	// CoverUrl is the path of the cover image of the book on the
+	// server, if it has one. Thumbnails are served with a width
+	// parameter, for example `?width=128`. It is set by the server,
+	// and changes when a new cover is uploaded with UploadCover.
+	CoverUrl string
+	// SubjectCodes are the codes of the subjects of the book.
+	// The subjects must exist.
+	SubjectCodes []string
+	// Tags are the tags members have applied to the book with TagBook,
+	// most applied first. It is set by the server.
+	Tags []*TagCount
+	// LanguageCode is the BCP 47 code of the language the book
+	// is written in, such as en or pt-BR. The title and description
+	// are in this language.
+	LanguageCode string
+	// OriginalIsbn is the ISBN-13 of the book this book is a
+	// translation of, in its original language, if any.
+	// The original book must exist, and can't be deleted
+	// while its translations are in the library.
+	OriginalIsbn string
+	// Description is a description of the book.
+	Description string
+	// Localizations are the title and description of the book
+	// translated into other languages, one per language.
+	Localizations []*Localization
+	// Localized is the title and description to show the user, from the
+	// localization best matching the languages in the accept-language
+	// request metadata, which grpc-web sets from the Accept-Language
+	// header. If none match, it is the title and description of the
+	// book. It is set by the server on the books it returns.
+	Localized *Localization
+}
 
-// Client API for BookService service
+// isBook_PublishingMethod is used to distinguish types assignable to PublishingMethod
+type isBook_PublishingMethod interface{ isBook_PublishingMethod() }
 
-// BookService exposes GetBook and QueryBooks,
-// which allow querying of the library.
-type BookServiceClient interface {
-	// GetBook returns a Book from the library
-	// that matches the ISBN provided, if found.
-	// Otherwise it returns a NotFound error.
-	GetBook(ctx context.Context, in *GetBookRequest, opts ...grpcweb.CallOption) (*Book, error)
-	// QueryBooks returns all Books whos author
-	// matches the author prefix provided, as a stream
-	// of Books.
-	QueryBooks(ctx context.Context, in *QueryBooksRequest, opts ...grpcweb.CallOption) (BookService_QueryBooksClient, error)
-	// MakeCollection takes a stream of books and returns a Book collection.
-	MakeCollection(ctx context.Context, opts ...grpcweb.CallOption) (BookService_MakeCollectionClient, error)
-	// BookChat allows discussion about books
-	BookChat(ctx context.Context, opts ...grpcweb.CallOption) (BookService_BookChatClient, error)
+// Book_SelfPublished is assignable to PublishingMethod
+type Book_SelfPublished struct {
+	// SelfPublished means this book was
+	// self published.
+	SelfPublished bool
 }
 
-type bookServiceClient struct {
-	client *grpcweb.Client
+// Book_Publisher is assignable to PublishingMethod
+type Book_Publisher struct {
+	// Publisher means this book was published
+	// through a Publisher.
+	Publisher *Publisher
 }
 
-// NewBookServiceClient creates a new gRPC-Web client.
-func NewBookServiceClient(hostname string, opts ...grpcweb.DialOption) BookServiceClient {
-	return &bookServiceClient{
-		client: grpcweb.NewClient(hostname, "library.BookService", opts...),
+func (*Book_SelfPublished) isBook_PublishingMethod() {}
+func (*Book_Publisher) isBook_PublishingMethod()     {}
+
+// GetPublishingMethod gets the PublishingMethod of the Book.
+func (m *Book) GetPublishingMethod() (x isBook_PublishingMethod) {
+	if m == nil {
+		return x
 	}
+	return m.PublishingMethod
 }
 
-func (c *bookServiceClient) GetBook(ctx context.Context, in *GetBookRequest, opts ...grpcweb.CallOption) (*Book, error) {
-	resp, err := c.client.RPCCall(ctx, "GetBook", in.Marshal(), opts...)
-	if err != nil {
-		return nil, err
+// GetLegacyIsbn gets the LegacyIsbn of the Book.
+func (m *Book) GetLegacyIsbn() (x int64) {
+	if m == nil {
+		return x
 	}
-
-	return new(Book).Unmarshal(resp)
+	return m.LegacyIsbn
 }
 
-func (c *bookServiceClient) QueryBooks(ctx context.Context, in *QueryBooksRequest, opts ...grpcweb.CallOption) (BookService_QueryBooksClient, error) {
-	srv, err := c.client.NewClientStream(ctx, false, true, "QueryBooks", opts...)
-	if err != nil {
-		return nil, err
+// GetTitle gets the Title of the Book.
+func (m *Book) GetTitle() (x string) {
+	if m == nil {
+		return x
 	}
+	return m.Title
+}
 
-	err = srv.SendMsg(in.Marshal())
-	if err != nil {
-		return nil, err
+// GetAuthor gets the Author of the Book.
+func (m *Book) GetAuthor() (x string) {
+	if m == nil {
+		return x
 	}
+	return m.Author
+}
 
-	return &bookServiceQueryBooksClient{srv}, nil
+// GetBookType gets the BookType of the Book.
+func (m *Book) GetBookType() (x BookType) {
+	if m == nil {
+		return x
+	}
+	return m.BookType
+}
+
+// GetSelfPublished gets the SelfPublished of the Book.
+func (m *Book) GetSelfPublished() (x bool) {
+	if v, ok := m.GetPublishingMethod().(*Book_SelfPublished); ok {
+		return v.SelfPublished
+	}
+	return x
+}
+
+// GetPublisher gets the Publisher of the Book.
+func (m *Book) GetPublisher() (x *Publisher) {
+	if v, ok := m.GetPublishingMethod().(*Book_Publisher); ok {
+		return v.Publisher
+	}
+	return x
+}
+
+// GetPublicationDate gets the PublicationDate of the Book.
+func (m *Book) GetPublicationDate() (x *google_protobuf1.Timestamp) {
+	if m == nil {
+		return x
+	}
+	return m.PublicationDate
+}
+
+// GetIsbn gets the Isbn of the Book.
+func (m *Book) GetIsbn() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Isbn
+}
+
+// GetIsbn10 gets the Isbn10 of the Book.
+func (m *Book) GetIsbn10() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Isbn10
+}
+
+// GetEtag gets the Etag of the Book.
+func (m *Book) GetEtag() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Etag
+}
+
+// GetDeleteTime gets the DeleteTime of the Book.
+func (m *Book) GetDeleteTime() (x *google_protobuf1.Timestamp) {
+	if m == nil {
+		return x
+	}
+	return m.DeleteTime
+}
+
+// GetCopies gets the Copies of the Book.
+func (m *Book) GetCopies() (x int32) {
+	if m == nil {
+		return x
+	}
+	return m.Copies
+}
+
+// GetAvailableCopies gets the AvailableCopies of the Book.
+func (m *Book) GetAvailableCopies() (x int32) {
+	if m == nil {
+		return x
+	}
+	return m.AvailableCopies
+}
+
+// GetAverageRating gets the AverageRating of the Book.
+func (m *Book) GetAverageRating() (x float64) {
+	if m == nil {
+		return x
+	}
+	return m.AverageRating
+}
+
+// GetReviewCount gets the ReviewCount of the Book.
+func (m *Book) GetReviewCount() (x int32) {
+	if m == nil {
+		return x
+	}
+	return m.ReviewCount
+}
+
+// GetAuthorIds gets the AuthorIds of the Book.
+func (m *Book) GetAuthorIds() (x []string) {
+	if m == nil {
+		return x
+	}
+	return m.AuthorIds
+}
+
+// GetWorkId gets the WorkId of the Book.
+func (m *Book) GetWorkId() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.WorkId
+}
+
+// GetCoverUrl gets the CoverUrl of the Book.
+func (m *Book) GetCoverUrl() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.CoverUrl
+}
+
+// GetSubjectCodes gets the SubjectCodes of the Book.
+func (m *Book) GetSubjectCodes() (x []string) {
+	if m == nil {
+		return x
+	}
+	return m.SubjectCodes
+}
+
+// GetTags gets the Tags of the Book.
+func (m *Book) GetTags() (x []*TagCount) {
+	if m == nil {
+		return x
+	}
+	return m.Tags
+}
+
+// GetLanguageCode gets the LanguageCode of the Book.
+func (m *Book) GetLanguageCode() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.LanguageCode
+}
+
+// GetOriginalIsbn gets the OriginalIsbn of the Book.
+func (m *Book) GetOriginalIsbn() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.OriginalIsbn
+}
+
+// GetDescription gets the Description of the Book.
+func (m *Book) GetDescription() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Description
+}
+
+// GetLocalizations gets the Localizations of the Book.
+func (m *Book) GetLocalizations() (x []*Localization) {
+	if m == nil {
+		return x
+	}
+	return m.Localizations
+}
+
+// GetLocalized gets the Localized of the Book.
+func (m *Book) GetLocalized() (x *Localization) {
+	if m == nil {
+		return x
+	}
+	return m.Localized
+}
+
+// MarshalToWriter marshals Book to the provided writer.
+func (m *Book) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	switch t := m.PublishingMethod.(type) {
+	case *Book_SelfPublished:
+		if t.SelfPublished {
+			writer.WriteBool(5, t.SelfPublished)
+		}
+	case *Book_Publisher:
+		if t.Publisher != nil {
+			writer.WriteMessage(6, func() {
+				t.Publisher.MarshalToWriter(writer)
+			})
+		}
+	}
+
+	if m.LegacyIsbn != 0 {
+		writer.WriteInt64(1, m.LegacyIsbn)
+	}
+
+	if len(m.Title) > 0 {
+		writer.WriteString(2, m.Title)
+	}
+
+	if len(m.Author) > 0 {
+		writer.WriteString(3, m.Author)
+	}
+
+	if int(m.BookType) != 0 {
+		writer.WriteEnum(4, int(m.BookType))
+	}
+
+	if m.PublicationDate != nil {
+		writer.WriteMessage(7, func() {
+			m.PublicationDate.MarshalToWriter(writer)
+		})
+	}
+
+	if len(m.Isbn) > 0 {
+		writer.WriteString(8, m.Isbn)
+	}
+
+	if len(m.Isbn10) > 0 {
+		writer.WriteString(9, m.Isbn10)
+	}
+
+	if len(m.Etag) > 0 {
+		writer.WriteString(10, m.Etag)
+	}
+
+	if m.DeleteTime != nil {
+		writer.WriteMessage(11, func() {
+			m.DeleteTime.MarshalToWriter(writer)
+		})
+	}
+
+	if m.Copies != 0 {
+		writer.WriteInt32(12, m.Copies)
+	}
+
+	if m.AvailableCopies != 0 {
+		writer.WriteInt32(13, m.AvailableCopies)
+	}
+
+	if m.AverageRating != 0 {
+		writer.WriteFloat64(14, m.AverageRating)
+	}
+
+	if m.ReviewCount != 0 {
+		writer.WriteInt32(15, m.ReviewCount)
+	}
+
+	for _, val := range m.AuthorIds {
+		writer.WriteString(16, val)
+	}
+
+	if len(m.WorkId) > 0 {
+		writer.WriteString(17, m.WorkId)
+	}
+
+	if len(m.CoverUrl) > 0 {
+		writer.WriteString(18, m.CoverUrl)
+	}
+
+	for _, val := range m.SubjectCodes {
+		writer.WriteString(19, val)
+	}
+
+	for _, msg := range m.Tags {
+		writer.WriteMessage(20, func() {
+			msg.MarshalToWriter(writer)
+		})
+	}
+
+	if len(m.LanguageCode) > 0 {
+		writer.WriteString(21, m.LanguageCode)
+	}
+
+	if len(m.OriginalIsbn) > 0 {
+		writer.WriteString(22, m.OriginalIsbn)
+	}
+
+	if len(m.Description) > 0 {
+		writer.WriteString(23, m.Description)
+	}
+
+	for _, msg := range m.Localizations {
+		writer.WriteMessage(24, func() {
+			msg.MarshalToWriter(writer)
+		})
+	}
+
+	if m.Localized != nil {
+		writer.WriteMessage(25, func() {
+			m.Localized.MarshalToWriter(writer)
+		})
+	}
+
+	return
+}
+
+// Marshal marshals Book to a slice of bytes.
+func (m *Book) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a Book from the provided reader.
+func (m *Book) UnmarshalFromReader(reader jspb.Reader) *Book {
+	for reader.Next() {
+		if m == nil {
+			m = &Book{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.LegacyIsbn = reader.ReadInt64()
+		case 2:
+			m.Title = reader.ReadString()
+		case 3:
+			m.Author = reader.ReadString()
+		case 4:
+			m.BookType = BookType(reader.ReadEnum())
+		case 5:
+			m.PublishingMethod = &Book_SelfPublished{
+				SelfPublished: reader.ReadBool(),
+			}
+		case 6:
+			reader.ReadMessage(func() {
+				m.PublishingMethod = &Book_Publisher{
+					Publisher: new(Publisher).UnmarshalFromReader(reader),
+				}
+			})
+		case 7:
+			reader.ReadMessage(func() {
+				m.PublicationDate = m.PublicationDate.UnmarshalFromReader(reader)
+			})
+		case 8:
+			m.Isbn = reader.ReadString()
+		case 9:
+			m.Isbn10 = reader.ReadString()
+		case 10:
+			m.Etag = reader.ReadString()
+		case 11:
+			reader.ReadMessage(func() {
+				m.DeleteTime = m.DeleteTime.UnmarshalFromReader(reader)
+			})
+		case 12:
+			m.Copies = reader.ReadInt32()
+		case 13:
+			m.AvailableCopies = reader.ReadInt32()
+		case 14:
+			m.AverageRating = reader.ReadFloat64()
+		case 15:
+			m.ReviewCount = reader.ReadInt32()
+		case 16:
+			m.AuthorIds = append(m.AuthorIds, reader.ReadString())
+		case 17:
+			m.WorkId = reader.ReadString()
+		case 18:
+			m.CoverUrl = reader.ReadString()
+		case 19:
+			m.SubjectCodes = append(m.SubjectCodes, reader.ReadString())
+		case 20:
+			reader.ReadMessage(func() {
+				m.Tags = append(m.Tags, new(TagCount).UnmarshalFromReader(reader))
+			})
+		case 21:
+			m.LanguageCode = reader.ReadString()
+		case 22:
+			m.OriginalIsbn = reader.ReadString()
+		case 23:
+			m.Description = reader.ReadString()
+		case 24:
+			reader.ReadMessage(func() {
+				m.Localizations = append(m.Localizations, new(Localization).UnmarshalFromReader(reader))
+			})
+		case 25:
+			reader.ReadMessage(func() {
+				m.Localized = m.Localized.UnmarshalFromReader(reader)
+			})
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a Book from a slice of bytes.
+func (m *Book) Unmarshal(rawBytes []byte) (*Book, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// GetBookRequest is the input to the GetBook method.
+type GetBookRequest struct {
+	// LegacyIsbn is the ISBN with which to match against
+	// the ISBN of a book in the library, as a number.
+	// It is deprecated in favour of Isbn.
+	LegacyIsbn int64
+	// Isbn is the ISBN-10 or ISBN-13, optionally with hyphens,
+	// with which to match against the ISBN of a book in the library.
+	Isbn string
+	// AsOf returns the book as it was at this time, if set.
+	AsOf *google_protobuf1.Timestamp
+}
+
+// GetLegacyIsbn gets the LegacyIsbn of the GetBookRequest.
+func (m *GetBookRequest) GetLegacyIsbn() (x int64) {
+	if m == nil {
+		return x
+	}
+	return m.LegacyIsbn
+}
+
+// GetIsbn gets the Isbn of the GetBookRequest.
+func (m *GetBookRequest) GetIsbn() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Isbn
+}
+
+// GetAsOf gets the AsOf of the GetBookRequest.
+func (m *GetBookRequest) GetAsOf() (x *google_protobuf1.Timestamp) {
+	if m == nil {
+		return x
+	}
+	return m.AsOf
+}
+
+// MarshalToWriter marshals GetBookRequest to the provided writer.
+func (m *GetBookRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if m.LegacyIsbn != 0 {
+		writer.WriteInt64(1, m.LegacyIsbn)
+	}
+
+	if len(m.Isbn) > 0 {
+		writer.WriteString(2, m.Isbn)
+	}
+
+	if m.AsOf != nil {
+		writer.WriteMessage(3, func() {
+			m.AsOf.MarshalToWriter(writer)
+		})
+	}
+
+	return
+}
+
+// Marshal marshals GetBookRequest to a slice of bytes.
+func (m *GetBookRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a GetBookRequest from the provided reader.
+func (m *GetBookRequest) UnmarshalFromReader(reader jspb.Reader) *GetBookRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &GetBookRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.LegacyIsbn = reader.ReadInt64()
+		case 2:
+			m.Isbn = reader.ReadString()
+		case 3:
+			reader.ReadMessage(func() {
+				m.AsOf = m.AsOf.UnmarshalFromReader(reader)
+			})
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a GetBookRequest from a slice of bytes.
+func (m *GetBookRequest) Unmarshal(rawBytes []byte) (*GetBookRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// QueryBooksRequest is the input to the QueryBooks method.
+// Books must match all the filters set.
+type QueryBooksRequest struct {
+	// AuthorPrefix is the prefix with which
+	// to match against the author of a book in the library.
+	AuthorPrefix string
+	// BookTypes is the set of book types to match against
+	// the type of a book in the library. If empty,
+	// books of all types match.
+	BookTypes []BookType
+	// PublishedAfter matches books published at or after this time.
+	PublishedAfter *google_protobuf1.Timestamp
+	// PublishedBefore matches books published before this time.
+	// It must be after PublishedAfter, if both are set.
+	PublishedBefore *google_protobuf1.Timestamp
+	// Publisher is the name with which to match against
+	// the name of the publisher of a book in the library,
+	// ignoring case.
+	Publisher string
+	// SelfPublished, if set, matches books that
+	// are self published if true, and books published
+	// through a publisher if false.
+	SelfPublished *google_protobuf2.BoolValue
+	// OrderBy is the field to order the books by, optionally
+	// followed by asc or desc, for example `author desc`.
+	// Valid fields are isbn, title, author and publication_date.
+	// Titles and authors are ordered according to the collation
+	// rules of the server locale. Authors are ordered by the sort
+	// names of the linked authors, first author first, or else by
+	// the author field. If empty, books are returned in the order
+	// they were added to the library.
+	OrderBy string
+	// AsOf queries the books in the library as they were
+	// at this time, if set.
+	AsOf *google_protobuf1.Timestamp
+	// CollapseEditions only returns the first matching edition of
+	// each work, in the order requested. Books that are not
+	// editions of a work are always returned.
+	CollapseEditions bool
+	// SubjectCodes matches books with any of these subjects,
+	// or any subject under them. The subjects must exist.
+	SubjectCodes []string
+	// Tags matches books tagged with all of these tags.
+	Tags []string
+}
+
+// GetAuthorPrefix gets the AuthorPrefix of the QueryBooksRequest.
+func (m *QueryBooksRequest) GetAuthorPrefix() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.AuthorPrefix
+}
+
+// GetBookTypes gets the BookTypes of the QueryBooksRequest.
+func (m *QueryBooksRequest) GetBookTypes() (x []BookType) {
+	if m == nil {
+		return x
+	}
+	return m.BookTypes
+}
+
+// GetPublishedAfter gets the PublishedAfter of the QueryBooksRequest.
+func (m *QueryBooksRequest) GetPublishedAfter() (x *google_protobuf1.Timestamp) {
+	if m == nil {
+		return x
+	}
+	return m.PublishedAfter
+}
+
+// GetPublishedBefore gets the PublishedBefore of the QueryBooksRequest.
+func (m *QueryBooksRequest) GetPublishedBefore() (x *google_protobuf1.Timestamp) {
+	if m == nil {
+		return x
+	}
+	return m.PublishedBefore
+}
+
+// GetPublisher gets the Publisher of the QueryBooksRequest.
+func (m *QueryBooksRequest) GetPublisher() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Publisher
+}
+
+// GetSelfPublished gets the SelfPublished of the QueryBooksRequest.
+func (m *QueryBooksRequest) GetSelfPublished() (x *google_protobuf2.BoolValue) {
+	if m == nil {
+		return x
+	}
+	return m.SelfPublished
+}
+
+// GetOrderBy gets the OrderBy of the QueryBooksRequest.
+func (m *QueryBooksRequest) GetOrderBy() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.OrderBy
+}
+
+// GetAsOf gets the AsOf of the QueryBooksRequest.
+func (m *QueryBooksRequest) GetAsOf() (x *google_protobuf1.Timestamp) {
+	if m == nil {
+		return x
+	}
+	return m.AsOf
+}
+
+// GetCollapseEditions gets the CollapseEditions of the QueryBooksRequest.
+func (m *QueryBooksRequest) GetCollapseEditions() (x bool) {
+	if m == nil {
+		return x
+	}
+	return m.CollapseEditions
+}
+
+// GetSubjectCodes gets the SubjectCodes of the QueryBooksRequest.
+func (m *QueryBooksRequest) GetSubjectCodes() (x []string) {
+	if m == nil {
+		return x
+	}
+	return m.SubjectCodes
+}
+
+// GetTags gets the Tags of the QueryBooksRequest.
+func (m *QueryBooksRequest) GetTags() (x []string) {
+	if m == nil {
+		return x
+	}
+	return m.Tags
+}
+
+// MarshalToWriter marshals QueryBooksRequest to the provided writer.
+func (m *QueryBooksRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.AuthorPrefix) > 0 {
+		writer.WriteString(1, m.AuthorPrefix)
+	}
+
+	if len(m.BookTypes) > 0 {
+		var ints []int
+		for _, enum := range m.BookTypes {
+			ints = append(ints, int(enum))
+		}
+		writer.WriteEnumSlice(2, ints)
+	}
+
+	if m.PublishedAfter != nil {
+		writer.WriteMessage(3, func() {
+			m.PublishedAfter.MarshalToWriter(writer)
+		})
+	}
+
+	if m.PublishedBefore != nil {
+		writer.WriteMessage(4, func() {
+			m.PublishedBefore.MarshalToWriter(writer)
+		})
+	}
+
+	if len(m.Publisher) > 0 {
+		writer.WriteString(5, m.Publisher)
+	}
+
+	if m.SelfPublished != nil {
+		writer.WriteMessage(6, func() {
+			m.SelfPublished.MarshalToWriter(writer)
+		})
+	}
+
+	if len(m.OrderBy) > 0 {
+		writer.WriteString(7, m.OrderBy)
+	}
+
+	if m.AsOf != nil {
+		writer.WriteMessage(8, func() {
+			m.AsOf.MarshalToWriter(writer)
+		})
+	}
+
+	if m.CollapseEditions {
+		writer.WriteBool(9, m.CollapseEditions)
+	}
+
+	for _, val := range m.SubjectCodes {
+		writer.WriteString(10, val)
+	}
+
+	for _, val := range m.Tags {
+		writer.WriteString(11, val)
+	}
+
+	return
+}
+
+// Marshal marshals QueryBooksRequest to a slice of bytes.
+func (m *QueryBooksRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a QueryBooksRequest from the provided reader.
+func (m *QueryBooksRequest) UnmarshalFromReader(reader jspb.Reader) *QueryBooksRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &QueryBooksRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.AuthorPrefix = reader.ReadString()
+		case 2:
+			values := reader.ReadEnumSlice()
+			for _, enum := range values {
+				m.BookTypes = append(m.BookTypes, BookType(enum))
+			}
+		case 3:
+			reader.ReadMessage(func() {
+				m.PublishedAfter = m.PublishedAfter.UnmarshalFromReader(reader)
+			})
+		case 4:
+			reader.ReadMessage(func() {
+				m.PublishedBefore = m.PublishedBefore.UnmarshalFromReader(reader)
+			})
+		case 5:
+			m.Publisher = reader.ReadString()
+		case 6:
+			reader.ReadMessage(func() {
+				m.SelfPublished = m.SelfPublished.UnmarshalFromReader(reader)
+			})
+		case 7:
+			m.OrderBy = reader.ReadString()
+		case 8:
+			reader.ReadMessage(func() {
+				m.AsOf = m.AsOf.UnmarshalFromReader(reader)
+			})
+		case 9:
+			m.CollapseEditions = reader.ReadBool()
+		case 10:
+			m.SubjectCodes = append(m.SubjectCodes, reader.ReadString())
+		case 11:
+			m.Tags = append(m.Tags, reader.ReadString())
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a QueryBooksRequest from a slice of bytes.
+func (m *QueryBooksRequest) Unmarshal(rawBytes []byte) (*QueryBooksRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// ListBooksRequest is the input to the ListBooks method.
+type ListBooksRequest struct {
+	// PageSize is the maximum number of books to return.
+	// If zero, at most 10 books are returned. Values above 100
+	// are treated as 100.
+	PageSize int32
+	// PageToken is the next_page_token returned by a previous call
+	// to ListBooks. If empty, the first page is returned.
+	// The filter and order_by must be the same for all pages.
+	PageToken string
+	// Filter restricts the books returned. It is a list of
+	// restrictions of the form `field op value` joined by AND,
+	// for example `author = "George Orwell" AND book_type = PAPERBACK`.
+	// Valid fields are isbn, title, author, publisher, book_type
+	// and self_published. ISBNs are compared in their canonical
+	// ISBN-13 form. The = and != operators compare exactly,
+	// : matches text fields containing the value, ignoring case.
+	Filter string
+	// OrderBy is the field to order the books by, optionally
+	// followed by asc or desc, for example `title desc`.
+	// Valid fields are isbn, title, author and publication_date.
+	// Titles and authors are ordered according to the collation
+	// rules of the server locale. Authors are ordered by the sort
+	// names of the linked authors, first author first, or else by
+	// the author field. If empty, books are ordered by ISBN.
+	OrderBy string
+}
+
+// GetPageSize gets the PageSize of the ListBooksRequest.
+func (m *ListBooksRequest) GetPageSize() (x int32) {
+	if m == nil {
+		return x
+	}
+	return m.PageSize
+}
+
+// GetPageToken gets the PageToken of the ListBooksRequest.
+func (m *ListBooksRequest) GetPageToken() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.PageToken
+}
+
+// GetFilter gets the Filter of the ListBooksRequest.
+func (m *ListBooksRequest) GetFilter() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Filter
+}
+
+// GetOrderBy gets the OrderBy of the ListBooksRequest.
+func (m *ListBooksRequest) GetOrderBy() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.OrderBy
+}
+
+// MarshalToWriter marshals ListBooksRequest to the provided writer.
+func (m *ListBooksRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if m.PageSize != 0 {
+		writer.WriteInt32(1, m.PageSize)
+	}
+
+	if len(m.PageToken) > 0 {
+		writer.WriteString(2, m.PageToken)
+	}
+
+	if len(m.Filter) > 0 {
+		writer.WriteString(3, m.Filter)
+	}
+
+	if len(m.OrderBy) > 0 {
+		writer.WriteString(4, m.OrderBy)
+	}
+
+	return
+}
+
+// Marshal marshals ListBooksRequest to a slice of bytes.
+func (m *ListBooksRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a ListBooksRequest from the provided reader.
+func (m *ListBooksRequest) UnmarshalFromReader(reader jspb.Reader) *ListBooksRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &ListBooksRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.PageSize = reader.ReadInt32()
+		case 2:
+			m.PageToken = reader.ReadString()
+		case 3:
+			m.Filter = reader.ReadString()
+		case 4:
+			m.OrderBy = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a ListBooksRequest from a slice of bytes.
+func (m *ListBooksRequest) Unmarshal(rawBytes []byte) (*ListBooksRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// ListBooksResponse is the output of the ListBooks method.
+type ListBooksResponse struct {
+	// Books is a page of books matching the request.
+	Books []*Book
+	// NextPageToken can be used to retrieve the next page of books.
+	// It is empty if there are no more books.
+	NextPageToken string
+}
+
+// GetBooks gets the Books of the ListBooksResponse.
+func (m *ListBooksResponse) GetBooks() (x []*Book) {
+	if m == nil {
+		return x
+	}
+	return m.Books
+}
+
+// GetNextPageToken gets the NextPageToken of the ListBooksResponse.
+func (m *ListBooksResponse) GetNextPageToken() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.NextPageToken
+}
+
+// MarshalToWriter marshals ListBooksResponse to the provided writer.
+func (m *ListBooksResponse) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	for _, msg := range m.Books {
+		writer.WriteMessage(1, func() {
+			msg.MarshalToWriter(writer)
+		})
+	}
+
+	if len(m.NextPageToken) > 0 {
+		writer.WriteString(2, m.NextPageToken)
+	}
+
+	return
+}
+
+// Marshal marshals ListBooksResponse to a slice of bytes.
+func (m *ListBooksResponse) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a ListBooksResponse from the provided reader.
+func (m *ListBooksResponse) UnmarshalFromReader(reader jspb.Reader) *ListBooksResponse {
+	for reader.Next() {
+		if m == nil {
+			m = &ListBooksResponse{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			reader.ReadMessage(func() {
+				m.Books = append(m.Books, new(Book).UnmarshalFromReader(reader))
+			})
+		case 2:
+			m.NextPageToken = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a ListBooksResponse from a slice of bytes.
+func (m *ListBooksResponse) Unmarshal(rawBytes []byte) (*ListBooksResponse, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// SearchBooksRequest is the input to the SearchBooks method.
+type SearchBooksRequest struct {
+	// Query is the text to search for in the title,
+	// author and publisher of books.
+	Query string
+	// Limit is the maximum number of results to return.
+	// If zero, at most 10 results are returned. Values above
+	// 100 are treated as 100.
+	Limit int32
+}
+
+// GetQuery gets the Query of the SearchBooksRequest.
+func (m *SearchBooksRequest) GetQuery() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Query
+}
+
+// GetLimit gets the Limit of the SearchBooksRequest.
+func (m *SearchBooksRequest) GetLimit() (x int32) {
+	if m == nil {
+		return x
+	}
+	return m.Limit
+}
+
+// MarshalToWriter marshals SearchBooksRequest to the provided writer.
+func (m *SearchBooksRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Query) > 0 {
+		writer.WriteString(1, m.Query)
+	}
+
+	if m.Limit != 0 {
+		writer.WriteInt32(2, m.Limit)
+	}
+
+	return
+}
+
+// Marshal marshals SearchBooksRequest to a slice of bytes.
+func (m *SearchBooksRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a SearchBooksRequest from the provided reader.
+func (m *SearchBooksRequest) UnmarshalFromReader(reader jspb.Reader) *SearchBooksRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &SearchBooksRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Query = reader.ReadString()
+		case 2:
+			m.Limit = reader.ReadInt32()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a SearchBooksRequest from a slice of bytes.
+func (m *SearchBooksRequest) Unmarshal(rawBytes []byte) (*SearchBooksRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// SearchBooksResponse is the output of the SearchBooks method.
+type SearchBooksResponse struct {
+	// Results are the books matching the query,
+	// most relevant first.
+	Results []*SearchResult
+}
+
+// GetResults gets the Results of the SearchBooksResponse.
+func (m *SearchBooksResponse) GetResults() (x []*SearchResult) {
+	if m == nil {
+		return x
+	}
+	return m.Results
+}
+
+// MarshalToWriter marshals SearchBooksResponse to the provided writer.
+func (m *SearchBooksResponse) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	for _, msg := range m.Results {
+		writer.WriteMessage(1, func() {
+			msg.MarshalToWriter(writer)
+		})
+	}
+
+	return
+}
+
+// Marshal marshals SearchBooksResponse to a slice of bytes.
+func (m *SearchBooksResponse) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a SearchBooksResponse from the provided reader.
+func (m *SearchBooksResponse) UnmarshalFromReader(reader jspb.Reader) *SearchBooksResponse {
+	for reader.Next() {
+		if m == nil {
+			m = &SearchBooksResponse{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			reader.ReadMessage(func() {
+				m.Results = append(m.Results, new(SearchResult).UnmarshalFromReader(reader))
+			})
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a SearchBooksResponse from a slice of bytes.
+func (m *SearchBooksResponse) Unmarshal(rawBytes []byte) (*SearchBooksResponse, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// SearchResult is a book matching a search query.
+type SearchResult struct {
+	// Book is the matching book.
+	Book *Book
+	// Score is the relevance of the book to the query.
+	// Higher scores are more relevant.
+	Score float64
+	// Highlights are the fields of the book that matched the query.
+	Highlights []*Highlight
+}
+
+// GetBook gets the Book of the SearchResult.
+func (m *SearchResult) GetBook() (x *Book) {
+	if m == nil {
+		return x
+	}
+	return m.Book
+}
+
+// GetScore gets the Score of the SearchResult.
+func (m *SearchResult) GetScore() (x float64) {
+	if m == nil {
+		return x
+	}
+	return m.Score
+}
+
+// GetHighlights gets the Highlights of the SearchResult.
+func (m *SearchResult) GetHighlights() (x []*Highlight) {
+	if m == nil {
+		return x
+	}
+	return m.Highlights
+}
+
+// MarshalToWriter marshals SearchResult to the provided writer.
+func (m *SearchResult) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if m.Book != nil {
+		writer.WriteMessage(1, func() {
+			m.Book.MarshalToWriter(writer)
+		})
+	}
+
+	if m.Score != 0 {
+		writer.WriteFloat64(2, m.Score)
+	}
+
+	for _, msg := range m.Highlights {
+		writer.WriteMessage(3, func() {
+			msg.MarshalToWriter(writer)
+		})
+	}
+
+	return
+}
+
+// Marshal marshals SearchResult to a slice of bytes.
+func (m *SearchResult) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a SearchResult from the provided reader.
+func (m *SearchResult) UnmarshalFromReader(reader jspb.Reader) *SearchResult {
+	for reader.Next() {
+		if m == nil {
+			m = &SearchResult{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			reader.ReadMessage(func() {
+				m.Book = m.Book.UnmarshalFromReader(reader)
+			})
+		case 2:
+			m.Score = reader.ReadFloat64()
+		case 3:
+			reader.ReadMessage(func() {
+				m.Highlights = append(m.Highlights, new(Highlight).UnmarshalFromReader(reader))
+			})
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a SearchResult from a slice of bytes.
+func (m *SearchResult) Unmarshal(rawBytes []byte) (*SearchResult, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Highlight is an excerpt of a field matching a search query.
+type Highlight struct {
+	// Field is the name of the field, one of
+	// title, author or publisher.
+	Field string
+	// Snippet is the excerpt of the field.
+	Snippet string
+	// Matches are the parts of the snippet that matched the query.
+	Matches []*TextRange
+}
+
+// GetField gets the Field of the Highlight.
+func (m *Highlight) GetField() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Field
+}
+
+// GetSnippet gets the Snippet of the Highlight.
+func (m *Highlight) GetSnippet() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Snippet
+}
+
+// GetMatches gets the Matches of the Highlight.
+func (m *Highlight) GetMatches() (x []*TextRange) {
+	if m == nil {
+		return x
+	}
+	return m.Matches
+}
+
+// MarshalToWriter marshals Highlight to the provided writer.
+func (m *Highlight) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Field) > 0 {
+		writer.WriteString(1, m.Field)
+	}
+
+	if len(m.Snippet) > 0 {
+		writer.WriteString(2, m.Snippet)
+	}
+
+	for _, msg := range m.Matches {
+		writer.WriteMessage(3, func() {
+			msg.MarshalToWriter(writer)
+		})
+	}
+
+	return
+}
+
+// Marshal marshals Highlight to a slice of bytes.
+func (m *Highlight) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a Highlight from the provided reader.
+func (m *Highlight) UnmarshalFromReader(reader jspb.Reader) *Highlight {
+	for reader.Next() {
+		if m == nil {
+			m = &Highlight{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Field = reader.ReadString()
+		case 2:
+			m.Snippet = reader.ReadString()
+		case 3:
+			reader.ReadMessage(func() {
+				m.Matches = append(m.Matches, new(TextRange).UnmarshalFromReader(reader))
+			})
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a Highlight from a slice of bytes.
+func (m *Highlight) Unmarshal(rawBytes []byte) (*Highlight, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// TextRange is a range of characters (Unicode code points)
+// in a text.
+type TextRange struct {
+	// Start is the offset of the first character in the range.
+	Start int32
+	// End is the offset of the character after the range.
+	End int32
+}
+
+// GetStart gets the Start of the TextRange.
+func (m *TextRange) GetStart() (x int32) {
+	if m == nil {
+		return x
+	}
+	return m.Start
+}
+
+// GetEnd gets the End of the TextRange.
+func (m *TextRange) GetEnd() (x int32) {
+	if m == nil {
+		return x
+	}
+	return m.End
+}
+
+// MarshalToWriter marshals TextRange to the provided writer.
+func (m *TextRange) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if m.Start != 0 {
+		writer.WriteInt32(1, m.Start)
+	}
+
+	if m.End != 0 {
+		writer.WriteInt32(2, m.End)
+	}
+
+	return
+}
+
+// Marshal marshals TextRange to a slice of bytes.
+func (m *TextRange) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a TextRange from the provided reader.
+func (m *TextRange) UnmarshalFromReader(reader jspb.Reader) *TextRange {
+	for reader.Next() {
+		if m == nil {
+			m = &TextRange{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Start = reader.ReadInt32()
+		case 2:
+			m.End = reader.ReadInt32()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a TextRange from a slice of bytes.
+func (m *TextRange) Unmarshal(rawBytes []byte) (*TextRange, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// RecommendBooksRequest is the input to the RecommendBooks method.
+type RecommendBooksRequest struct {
+	// Seed selects what the recommendations are for.
+	//
+	// Types that are valid to be assigned to Seed:
+	//	*RecommendBooksRequest_Isbn
+	//	*RecommendBooksRequest_MemberId
+	Seed isRecommendBooksRequest_Seed
+	// Limit is the maximum number of recommendations to return.
+	// If zero, at most 10 recommendations are returned. Values above
+	// 100 are treated as 100.
+	Limit int32
+}
+
+// isRecommendBooksRequest_Seed is used to distinguish types assignable to Seed
+type isRecommendBooksRequest_Seed interface{ isRecommendBooksRequest_Seed() }
+
+// RecommendBooksRequest_Isbn is assignable to Seed
+type RecommendBooksRequest_Isbn struct {
+	// Isbn recommends books read together with the Book with this ISBN.
+	Isbn string
+}
+
+// RecommendBooksRequest_MemberId is assignable to Seed
+type RecommendBooksRequest_MemberId struct {
+	// MemberId recommends books read together with the Books
+	// the member with this ID has borrowed or collected.
+	MemberId string
+}
+
+func (*RecommendBooksRequest_Isbn) isRecommendBooksRequest_Seed()     {}
+func (*RecommendBooksRequest_MemberId) isRecommendBooksRequest_Seed() {}
+
+// GetSeed gets the Seed of the RecommendBooksRequest.
+func (m *RecommendBooksRequest) GetSeed() (x isRecommendBooksRequest_Seed) {
+	if m == nil {
+		return x
+	}
+	return m.Seed
+}
+
+// GetIsbn gets the Isbn of the RecommendBooksRequest.
+func (m *RecommendBooksRequest) GetIsbn() (x string) {
+	if v, ok := m.GetSeed().(*RecommendBooksRequest_Isbn); ok {
+		return v.Isbn
+	}
+	return x
+}
+
+// GetMemberId gets the MemberId of the RecommendBooksRequest.
+func (m *RecommendBooksRequest) GetMemberId() (x string) {
+	if v, ok := m.GetSeed().(*RecommendBooksRequest_MemberId); ok {
+		return v.MemberId
+	}
+	return x
+}
+
+// GetLimit gets the Limit of the RecommendBooksRequest.
+func (m *RecommendBooksRequest) GetLimit() (x int32) {
+	if m == nil {
+		return x
+	}
+	return m.Limit
+}
+
+// MarshalToWriter marshals RecommendBooksRequest to the provided writer.
+func (m *RecommendBooksRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	switch t := m.Seed.(type) {
+	case *RecommendBooksRequest_Isbn:
+		if len(t.Isbn) > 0 {
+			writer.WriteString(1, t.Isbn)
+		}
+	case *RecommendBooksRequest_MemberId:
+		if len(t.MemberId) > 0 {
+			writer.WriteString(2, t.MemberId)
+		}
+	}
+
+	if m.Limit != 0 {
+		writer.WriteInt32(3, m.Limit)
+	}
+
+	return
+}
+
+// Marshal marshals RecommendBooksRequest to a slice of bytes.
+func (m *RecommendBooksRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a RecommendBooksRequest from the provided reader.
+func (m *RecommendBooksRequest) UnmarshalFromReader(reader jspb.Reader) *RecommendBooksRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &RecommendBooksRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Seed = &RecommendBooksRequest_Isbn{
+				Isbn: reader.ReadString(),
+			}
+		case 2:
+			m.Seed = &RecommendBooksRequest_MemberId{
+				MemberId: reader.ReadString(),
+			}
+		case 3:
+			m.Limit = reader.ReadInt32()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a RecommendBooksRequest from a slice of bytes.
+func (m *RecommendBooksRequest) Unmarshal(rawBytes []byte) (*RecommendBooksRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// RecommendBooksResponse is the output of the RecommendBooks method.
+type RecommendBooksResponse struct {
+	// Recommendations are the recommended books, best first.
+	Recommendations []*Recommendation
+}
+
+// GetRecommendations gets the Recommendations of the RecommendBooksResponse.
+func (m *RecommendBooksResponse) GetRecommendations() (x []*Recommendation) {
+	if m == nil {
+		return x
+	}
+	return m.Recommendations
+}
+
+// MarshalToWriter marshals RecommendBooksResponse to the provided writer.
+func (m *RecommendBooksResponse) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	for _, msg := range m.Recommendations {
+		writer.WriteMessage(1, func() {
+			msg.MarshalToWriter(writer)
+		})
+	}
+
+	return
+}
+
+// Marshal marshals RecommendBooksResponse to a slice of bytes.
+func (m *RecommendBooksResponse) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a RecommendBooksResponse from the provided reader.
+func (m *RecommendBooksResponse) UnmarshalFromReader(reader jspb.Reader) *RecommendBooksResponse {
+	for reader.Next() {
+		if m == nil {
+			m = &RecommendBooksResponse{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			reader.ReadMessage(func() {
+				m.Recommendations = append(m.Recommendations, new(Recommendation).UnmarshalFromReader(reader))
+			})
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a RecommendBooksResponse from a slice of bytes.
+func (m *RecommendBooksResponse) Unmarshal(rawBytes []byte) (*RecommendBooksResponse, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Recommendation is a book recommended by RecommendBooks.
+type Recommendation struct {
+	// Book is the recommended book.
+	Book *Book
+	// Reason is why the book is recommended.
+	Reason Recommendation_Reason
+	// Score is the similarity, from 0 to 1, of the book to the seed
+	// books. It is only set for READ_TOGETHER recommendations.
+	Score float64
+}
+
+// GetBook gets the Book of the Recommendation.
+func (m *Recommendation) GetBook() (x *Book) {
+	if m == nil {
+		return x
+	}
+	return m.Book
+}
+
+// GetReason gets the Reason of the Recommendation.
+func (m *Recommendation) GetReason() (x Recommendation_Reason) {
+	if m == nil {
+		return x
+	}
+	return m.Reason
+}
+
+// GetScore gets the Score of the Recommendation.
+func (m *Recommendation) GetScore() (x float64) {
+	if m == nil {
+		return x
+	}
+	return m.Score
+}
+
+// MarshalToWriter marshals Recommendation to the provided writer.
+func (m *Recommendation) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if m.Book != nil {
+		writer.WriteMessage(1, func() {
+			m.Book.MarshalToWriter(writer)
+		})
+	}
+
+	if int(m.Reason) != 0 {
+		writer.WriteEnum(2, int(m.Reason))
+	}
+
+	if m.Score != 0 {
+		writer.WriteFloat64(3, m.Score)
+	}
+
+	return
+}
+
+// Marshal marshals Recommendation to a slice of bytes.
+func (m *Recommendation) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a Recommendation from the provided reader.
+func (m *Recommendation) UnmarshalFromReader(reader jspb.Reader) *Recommendation {
+	for reader.Next() {
+		if m == nil {
+			m = &Recommendation{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			reader.ReadMessage(func() {
+				m.Book = m.Book.UnmarshalFromReader(reader)
+			})
+		case 2:
+			m.Reason = Recommendation_Reason(reader.ReadEnum())
+		case 3:
+			m.Score = reader.ReadFloat64()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a Recommendation from a slice of bytes.
+func (m *Recommendation) Unmarshal(rawBytes []byte) (*Recommendation, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// CreateBookRequest is the input to the CreateBook method.
+type CreateBookRequest struct {
+	// Book is the book to add to the library.
+	// The ISBN and title must be set.
+	Book *Book
+}
+
+// GetBook gets the Book of the CreateBookRequest.
+func (m *CreateBookRequest) GetBook() (x *Book) {
+	if m == nil {
+		return x
+	}
+	return m.Book
+}
+
+// MarshalToWriter marshals CreateBookRequest to the provided writer.
+func (m *CreateBookRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if m.Book != nil {
+		writer.WriteMessage(1, func() {
+			m.Book.MarshalToWriter(writer)
+		})
+	}
+
+	return
+}
+
+// Marshal marshals CreateBookRequest to a slice of bytes.
+func (m *CreateBookRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a CreateBookRequest from the provided reader.
+func (m *CreateBookRequest) UnmarshalFromReader(reader jspb.Reader) *CreateBookRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &CreateBookRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			reader.ReadMessage(func() {
+				m.Book = m.Book.UnmarshalFromReader(reader)
+			})
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a CreateBookRequest from a slice of bytes.
+func (m *CreateBookRequest) Unmarshal(rawBytes []byte) (*CreateBookRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// UpdateBookRequest is the input to the UpdateBook method.
+type UpdateBookRequest struct {
+	// Book contains the new values of the book.
+	// The ISBN identifies the book to update.
+	// The ISBN of a book can't be changed.
+	// If the etag is set, the book is only updated if
+	// it has not been changed since that version was read.
+	Book *Book
+	// UpdateMask lists the fields of the book to update.
+	// If it is not set, all fields except the ISBN are replaced.
+	// Valid paths are title, author_ids, author, book_type, self_published,
+	// publisher, publication_date, copies, work_id, subject_codes,
+	// language_code, original_isbn, description and localizations.
+	UpdateMask *google_protobuf.FieldMask
+}
+
+// GetBook gets the Book of the UpdateBookRequest.
+func (m *UpdateBookRequest) GetBook() (x *Book) {
+	if m == nil {
+		return x
+	}
+	return m.Book
+}
+
+// GetUpdateMask gets the UpdateMask of the UpdateBookRequest.
+func (m *UpdateBookRequest) GetUpdateMask() (x *google_protobuf.FieldMask) {
+	if m == nil {
+		return x
+	}
+	return m.UpdateMask
+}
+
+// MarshalToWriter marshals UpdateBookRequest to the provided writer.
+func (m *UpdateBookRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if m.Book != nil {
+		writer.WriteMessage(1, func() {
+			m.Book.MarshalToWriter(writer)
+		})
+	}
+
+	if m.UpdateMask != nil {
+		writer.WriteMessage(2, func() {
+			m.UpdateMask.MarshalToWriter(writer)
+		})
+	}
+
+	return
+}
+
+// Marshal marshals UpdateBookRequest to a slice of bytes.
+func (m *UpdateBookRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a UpdateBookRequest from the provided reader.
+func (m *UpdateBookRequest) UnmarshalFromReader(reader jspb.Reader) *UpdateBookRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &UpdateBookRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			reader.ReadMessage(func() {
+				m.Book = m.Book.UnmarshalFromReader(reader)
+			})
+		case 2:
+			reader.ReadMessage(func() {
+				m.UpdateMask = m.UpdateMask.UnmarshalFromReader(reader)
+			})
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a UpdateBookRequest from a slice of bytes.
+func (m *UpdateBookRequest) Unmarshal(rawBytes []byte) (*UpdateBookRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// CreateAuthorRequest is the input to the CreateAuthor method.
+type CreateAuthorRequest struct {
+	// Author is the author to add to the library.
+	// The name must be set.
+	Author *Author
+}
+
+// GetAuthor gets the Author of the CreateAuthorRequest.
+func (m *CreateAuthorRequest) GetAuthor() (x *Author) {
+	if m == nil {
+		return x
+	}
+	return m.Author
+}
+
+// MarshalToWriter marshals CreateAuthorRequest to the provided writer.
+func (m *CreateAuthorRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if m.Author != nil {
+		writer.WriteMessage(1, func() {
+			m.Author.MarshalToWriter(writer)
+		})
+	}
+
+	return
+}
+
+// Marshal marshals CreateAuthorRequest to a slice of bytes.
+func (m *CreateAuthorRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a CreateAuthorRequest from the provided reader.
+func (m *CreateAuthorRequest) UnmarshalFromReader(reader jspb.Reader) *CreateAuthorRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &CreateAuthorRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			reader.ReadMessage(func() {
+				m.Author = m.Author.UnmarshalFromReader(reader)
+			})
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a CreateAuthorRequest from a slice of bytes.
+func (m *CreateAuthorRequest) Unmarshal(rawBytes []byte) (*CreateAuthorRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// GetAuthorRequest is the input to the GetAuthor method.
+type GetAuthorRequest struct {
+	// Id is the ID of the author.
+	Id string
+}
+
+// GetId gets the Id of the GetAuthorRequest.
+func (m *GetAuthorRequest) GetId() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Id
+}
+
+// MarshalToWriter marshals GetAuthorRequest to the provided writer.
+func (m *GetAuthorRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Id) > 0 {
+		writer.WriteString(1, m.Id)
+	}
+
+	return
+}
+
+// Marshal marshals GetAuthorRequest to a slice of bytes.
+func (m *GetAuthorRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a GetAuthorRequest from the provided reader.
+func (m *GetAuthorRequest) UnmarshalFromReader(reader jspb.Reader) *GetAuthorRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &GetAuthorRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Id = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a GetAuthorRequest from a slice of bytes.
+func (m *GetAuthorRequest) Unmarshal(rawBytes []byte) (*GetAuthorRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// UpdateAuthorRequest is the input to the UpdateAuthor method.
+type UpdateAuthorRequest struct {
+	// Author contains the new values of the author.
+	// The ID identifies the author to update.
+	Author *Author
+	// UpdateMask lists the fields of the author to update.
+	// If it is not set, all fields except the ID are replaced.
+	// Valid paths are name, sort_name, birth_year and death_year.
+	UpdateMask *google_protobuf.FieldMask
+}
+
+// GetAuthor gets the Author of the UpdateAuthorRequest.
+func (m *UpdateAuthorRequest) GetAuthor() (x *Author) {
+	if m == nil {
+		return x
+	}
+	return m.Author
+}
+
+// GetUpdateMask gets the UpdateMask of the UpdateAuthorRequest.
+func (m *UpdateAuthorRequest) GetUpdateMask() (x *google_protobuf.FieldMask) {
+	if m == nil {
+		return x
+	}
+	return m.UpdateMask
+}
+
+// MarshalToWriter marshals UpdateAuthorRequest to the provided writer.
+func (m *UpdateAuthorRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if m.Author != nil {
+		writer.WriteMessage(1, func() {
+			m.Author.MarshalToWriter(writer)
+		})
+	}
+
+	if m.UpdateMask != nil {
+		writer.WriteMessage(2, func() {
+			m.UpdateMask.MarshalToWriter(writer)
+		})
+	}
+
+	return
+}
+
+// Marshal marshals UpdateAuthorRequest to a slice of bytes.
+func (m *UpdateAuthorRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a UpdateAuthorRequest from the provided reader.
+func (m *UpdateAuthorRequest) UnmarshalFromReader(reader jspb.Reader) *UpdateAuthorRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &UpdateAuthorRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			reader.ReadMessage(func() {
+				m.Author = m.Author.UnmarshalFromReader(reader)
+			})
+		case 2:
+			reader.ReadMessage(func() {
+				m.UpdateMask = m.UpdateMask.UnmarshalFromReader(reader)
+			})
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a UpdateAuthorRequest from a slice of bytes.
+func (m *UpdateAuthorRequest) Unmarshal(rawBytes []byte) (*UpdateAuthorRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// UploadCoverRequest is a chunk of a cover image
+// uploaded with the UploadCover method.
+type UploadCoverRequest struct {
+	// Isbn is the ISBN-10 or ISBN-13, optionally with hyphens, of
+	// the book. It must be set on the first chunk, and is ignored
+	// on the rest.
+	Isbn string
+	// Etag is the etag of the book, if set on the first chunk.
+	// The cover is then only set if the book has not been
+	// changed since that version was read.
+	Etag string
+	// Data is the next chunk of the image. Images must be
+	// JPEG, PNG or GIF, and may be at most 10 MiB.
+	Data []byte
+}
+
+// GetIsbn gets the Isbn of the UploadCoverRequest.
+func (m *UploadCoverRequest) GetIsbn() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Isbn
+}
+
+// GetEtag gets the Etag of the UploadCoverRequest.
+func (m *UploadCoverRequest) GetEtag() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Etag
+}
+
+// GetData gets the Data of the UploadCoverRequest.
+func (m *UploadCoverRequest) GetData() (x []byte) {
+	if m == nil {
+		return x
+	}
+	return m.Data
+}
+
+// MarshalToWriter marshals UploadCoverRequest to the provided writer.
+func (m *UploadCoverRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Isbn) > 0 {
+		writer.WriteString(1, m.Isbn)
+	}
+
+	if len(m.Etag) > 0 {
+		writer.WriteString(2, m.Etag)
+	}
+
+	if len(m.Data) > 0 {
+		writer.WriteBytes(3, m.Data)
+	}
+
+	return
+}
+
+// Marshal marshals UploadCoverRequest to a slice of bytes.
+func (m *UploadCoverRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a UploadCoverRequest from the provided reader.
+func (m *UploadCoverRequest) UnmarshalFromReader(reader jspb.Reader) *UploadCoverRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &UploadCoverRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Isbn = reader.ReadString()
+		case 2:
+			m.Etag = reader.ReadString()
+		case 3:
+			m.Data = reader.ReadBytes()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a UploadCoverRequest from a slice of bytes.
+func (m *UploadCoverRequest) Unmarshal(rawBytes []byte) (*UploadCoverRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// CreateWorkRequest is the input to the CreateWork method.
+type CreateWorkRequest struct {
+	// Work is the work to add to the library.
+	// The title must be set.
+	Work *Work
+}
+
+// GetWork gets the Work of the CreateWorkRequest.
+func (m *CreateWorkRequest) GetWork() (x *Work) {
+	if m == nil {
+		return x
+	}
+	return m.Work
+}
+
+// MarshalToWriter marshals CreateWorkRequest to the provided writer.
+func (m *CreateWorkRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if m.Work != nil {
+		writer.WriteMessage(1, func() {
+			m.Work.MarshalToWriter(writer)
+		})
+	}
+
+	return
+}
+
+// Marshal marshals CreateWorkRequest to a slice of bytes.
+func (m *CreateWorkRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a CreateWorkRequest from the provided reader.
+func (m *CreateWorkRequest) UnmarshalFromReader(reader jspb.Reader) *CreateWorkRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &CreateWorkRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			reader.ReadMessage(func() {
+				m.Work = m.Work.UnmarshalFromReader(reader)
+			})
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a CreateWorkRequest from a slice of bytes.
+func (m *CreateWorkRequest) Unmarshal(rawBytes []byte) (*CreateWorkRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// GetWorkRequest is the input to the GetWork method.
+type GetWorkRequest struct {
+	// Id is the ID of the work.
+	Id string
+}
+
+// GetId gets the Id of the GetWorkRequest.
+func (m *GetWorkRequest) GetId() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Id
+}
+
+// MarshalToWriter marshals GetWorkRequest to the provided writer.
+func (m *GetWorkRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Id) > 0 {
+		writer.WriteString(1, m.Id)
+	}
+
+	return
+}
+
+// Marshal marshals GetWorkRequest to a slice of bytes.
+func (m *GetWorkRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a GetWorkRequest from the provided reader.
+func (m *GetWorkRequest) UnmarshalFromReader(reader jspb.Reader) *GetWorkRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &GetWorkRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Id = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a GetWorkRequest from a slice of bytes.
+func (m *GetWorkRequest) Unmarshal(rawBytes []byte) (*GetWorkRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// UpdateWorkRequest is the input to the UpdateWork method.
+type UpdateWorkRequest struct {
+	// Work contains the new values of the work.
+	// The ID identifies the work to update.
+	Work *Work
+	// UpdateMask lists the fields of the work to update.
+	// If it is not set, all fields except the ID are replaced.
+	// Valid paths are title, author_ids, series_id and series_number.
+	UpdateMask *google_protobuf.FieldMask
+}
+
+// GetWork gets the Work of the UpdateWorkRequest.
+func (m *UpdateWorkRequest) GetWork() (x *Work) {
+	if m == nil {
+		return x
+	}
+	return m.Work
+}
+
+// GetUpdateMask gets the UpdateMask of the UpdateWorkRequest.
+func (m *UpdateWorkRequest) GetUpdateMask() (x *google_protobuf.FieldMask) {
+	if m == nil {
+		return x
+	}
+	return m.UpdateMask
+}
+
+// MarshalToWriter marshals UpdateWorkRequest to the provided writer.
+func (m *UpdateWorkRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if m.Work != nil {
+		writer.WriteMessage(1, func() {
+			m.Work.MarshalToWriter(writer)
+		})
+	}
+
+	if m.UpdateMask != nil {
+		writer.WriteMessage(2, func() {
+			m.UpdateMask.MarshalToWriter(writer)
+		})
+	}
+
+	return
+}
+
+// Marshal marshals UpdateWorkRequest to a slice of bytes.
+func (m *UpdateWorkRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a UpdateWorkRequest from the provided reader.
+func (m *UpdateWorkRequest) UnmarshalFromReader(reader jspb.Reader) *UpdateWorkRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &UpdateWorkRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			reader.ReadMessage(func() {
+				m.Work = m.Work.UnmarshalFromReader(reader)
+			})
+		case 2:
+			reader.ReadMessage(func() {
+				m.UpdateMask = m.UpdateMask.UnmarshalFromReader(reader)
+			})
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a UpdateWorkRequest from a slice of bytes.
+func (m *UpdateWorkRequest) Unmarshal(rawBytes []byte) (*UpdateWorkRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// ListEditionsRequest is the input to the ListEditions method.
+type ListEditionsRequest struct {
+	// WorkId is the ID of the work whose editions to list.
+	WorkId string
+}
+
+// GetWorkId gets the WorkId of the ListEditionsRequest.
+func (m *ListEditionsRequest) GetWorkId() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.WorkId
+}
+
+// MarshalToWriter marshals ListEditionsRequest to the provided writer.
+func (m *ListEditionsRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.WorkId) > 0 {
+		writer.WriteString(1, m.WorkId)
+	}
+
+	return
+}
+
+// Marshal marshals ListEditionsRequest to a slice of bytes.
+func (m *ListEditionsRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a ListEditionsRequest from the provided reader.
+func (m *ListEditionsRequest) UnmarshalFromReader(reader jspb.Reader) *ListEditionsRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &ListEditionsRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.WorkId = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a ListEditionsRequest from a slice of bytes.
+func (m *ListEditionsRequest) Unmarshal(rawBytes []byte) (*ListEditionsRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// ListEditionsResponse is the output of the ListEditions method.
+type ListEditionsResponse struct {
+	// Books are the editions of the work, by book
+	// type and then by publication date.
+	Books []*Book
+}
+
+// GetBooks gets the Books of the ListEditionsResponse.
+func (m *ListEditionsResponse) GetBooks() (x []*Book) {
+	if m == nil {
+		return x
+	}
+	return m.Books
+}
+
+// MarshalToWriter marshals ListEditionsResponse to the provided writer.
+func (m *ListEditionsResponse) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	for _, msg := range m.Books {
+		writer.WriteMessage(1, func() {
+			msg.MarshalToWriter(writer)
+		})
+	}
+
+	return
+}
+
+// Marshal marshals ListEditionsResponse to a slice of bytes.
+func (m *ListEditionsResponse) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a ListEditionsResponse from the provided reader.
+func (m *ListEditionsResponse) UnmarshalFromReader(reader jspb.Reader) *ListEditionsResponse {
+	for reader.Next() {
+		if m == nil {
+			m = &ListEditionsResponse{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			reader.ReadMessage(func() {
+				m.Books = append(m.Books, new(Book).UnmarshalFromReader(reader))
+			})
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a ListEditionsResponse from a slice of bytes.
+func (m *ListEditionsResponse) Unmarshal(rawBytes []byte) (*ListEditionsResponse, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// CreateSeriesRequest is the input to the CreateSeries method.
+type CreateSeriesRequest struct {
+	// Series is the series to add to the library.
+	// The name must be set.
+	Series *Series
+}
+
+// GetSeries gets the Series of the CreateSeriesRequest.
+func (m *CreateSeriesRequest) GetSeries() (x *Series) {
+	if m == nil {
+		return x
+	}
+	return m.Series
+}
+
+// MarshalToWriter marshals CreateSeriesRequest to the provided writer.
+func (m *CreateSeriesRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if m.Series != nil {
+		writer.WriteMessage(1, func() {
+			m.Series.MarshalToWriter(writer)
+		})
+	}
+
+	return
+}
+
+// Marshal marshals CreateSeriesRequest to a slice of bytes.
+func (m *CreateSeriesRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a CreateSeriesRequest from the provided reader.
+func (m *CreateSeriesRequest) UnmarshalFromReader(reader jspb.Reader) *CreateSeriesRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &CreateSeriesRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			reader.ReadMessage(func() {
+				m.Series = m.Series.UnmarshalFromReader(reader)
+			})
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a CreateSeriesRequest from a slice of bytes.
+func (m *CreateSeriesRequest) Unmarshal(rawBytes []byte) (*CreateSeriesRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// GetSeriesRequest is the input to the GetSeries method.
+type GetSeriesRequest struct {
+	// Id is the ID of the series.
+	Id string
+}
+
+// GetId gets the Id of the GetSeriesRequest.
+func (m *GetSeriesRequest) GetId() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Id
+}
+
+// MarshalToWriter marshals GetSeriesRequest to the provided writer.
+func (m *GetSeriesRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Id) > 0 {
+		writer.WriteString(1, m.Id)
+	}
+
+	return
+}
+
+// Marshal marshals GetSeriesRequest to a slice of bytes.
+func (m *GetSeriesRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a GetSeriesRequest from the provided reader.
+func (m *GetSeriesRequest) UnmarshalFromReader(reader jspb.Reader) *GetSeriesRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &GetSeriesRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Id = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a GetSeriesRequest from a slice of bytes.
+func (m *GetSeriesRequest) Unmarshal(rawBytes []byte) (*GetSeriesRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// ListSeriesWorksRequest is the input to the ListSeriesWorks method.
+type ListSeriesWorksRequest struct {
+	// SeriesId is the ID of the series whose works to list.
+	SeriesId string
+}
+
+// GetSeriesId gets the SeriesId of the ListSeriesWorksRequest.
+func (m *ListSeriesWorksRequest) GetSeriesId() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.SeriesId
+}
+
+// MarshalToWriter marshals ListSeriesWorksRequest to the provided writer.
+func (m *ListSeriesWorksRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.SeriesId) > 0 {
+		writer.WriteString(1, m.SeriesId)
+	}
+
+	return
+}
+
+// Marshal marshals ListSeriesWorksRequest to a slice of bytes.
+func (m *ListSeriesWorksRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a ListSeriesWorksRequest from the provided reader.
+func (m *ListSeriesWorksRequest) UnmarshalFromReader(reader jspb.Reader) *ListSeriesWorksRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &ListSeriesWorksRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.SeriesId = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a ListSeriesWorksRequest from a slice of bytes.
+func (m *ListSeriesWorksRequest) Unmarshal(rawBytes []byte) (*ListSeriesWorksRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// ListSeriesWorksResponse is the output of the ListSeriesWorks method.
+type ListSeriesWorksResponse struct {
+	// Works are the works of the series, by series number.
+	Works []*Work
+}
+
+// GetWorks gets the Works of the ListSeriesWorksResponse.
+func (m *ListSeriesWorksResponse) GetWorks() (x []*Work) {
+	if m == nil {
+		return x
+	}
+	return m.Works
+}
+
+// MarshalToWriter marshals ListSeriesWorksResponse to the provided writer.
+func (m *ListSeriesWorksResponse) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	for _, msg := range m.Works {
+		writer.WriteMessage(1, func() {
+			msg.MarshalToWriter(writer)
+		})
+	}
+
+	return
+}
+
+// Marshal marshals ListSeriesWorksResponse to a slice of bytes.
+func (m *ListSeriesWorksResponse) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a ListSeriesWorksResponse from the provided reader.
+func (m *ListSeriesWorksResponse) UnmarshalFromReader(reader jspb.Reader) *ListSeriesWorksResponse {
+	for reader.Next() {
+		if m == nil {
+			m = &ListSeriesWorksResponse{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			reader.ReadMessage(func() {
+				m.Works = append(m.Works, new(Work).UnmarshalFromReader(reader))
+			})
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a ListSeriesWorksResponse from a slice of bytes.
+func (m *ListSeriesWorksResponse) Unmarshal(rawBytes []byte) (*ListSeriesWorksResponse, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// CreateSubjectRequest is the input to the CreateSubject method.
+type CreateSubjectRequest struct {
+	// Subject is the subject to add to the taxonomy.
+	// The code and name must be set.
+	Subject *Subject
+}
+
+// GetSubject gets the Subject of the CreateSubjectRequest.
+func (m *CreateSubjectRequest) GetSubject() (x *Subject) {
+	if m == nil {
+		return x
+	}
+	return m.Subject
+}
+
+// MarshalToWriter marshals CreateSubjectRequest to the provided writer.
+func (m *CreateSubjectRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if m.Subject != nil {
+		writer.WriteMessage(1, func() {
+			m.Subject.MarshalToWriter(writer)
+		})
+	}
+
+	return
+}
+
+// Marshal marshals CreateSubjectRequest to a slice of bytes.
+func (m *CreateSubjectRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a CreateSubjectRequest from the provided reader.
+func (m *CreateSubjectRequest) UnmarshalFromReader(reader jspb.Reader) *CreateSubjectRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &CreateSubjectRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			reader.ReadMessage(func() {
+				m.Subject = m.Subject.UnmarshalFromReader(reader)
+			})
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a CreateSubjectRequest from a slice of bytes.
+func (m *CreateSubjectRequest) Unmarshal(rawBytes []byte) (*CreateSubjectRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// GetSubjectRequest is the input to the GetSubject method.
+type GetSubjectRequest struct {
+	// Code is the code of the subject to return.
+	Code string
+}
+
+// GetCode gets the Code of the GetSubjectRequest.
+func (m *GetSubjectRequest) GetCode() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Code
+}
+
+// MarshalToWriter marshals GetSubjectRequest to the provided writer.
+func (m *GetSubjectRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Code) > 0 {
+		writer.WriteString(1, m.Code)
+	}
+
+	return
+}
+
+// Marshal marshals GetSubjectRequest to a slice of bytes.
+func (m *GetSubjectRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a GetSubjectRequest from the provided reader.
+func (m *GetSubjectRequest) UnmarshalFromReader(reader jspb.Reader) *GetSubjectRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &GetSubjectRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Code = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a GetSubjectRequest from a slice of bytes.
+func (m *GetSubjectRequest) Unmarshal(rawBytes []byte) (*GetSubjectRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// UpdateSubjectRequest is the input to the UpdateSubject method.
+type UpdateSubjectRequest struct {
+	// Subject is the subject to update, identified by its code.
+	Subject *Subject
+	// UpdateMask selects the fields of the subject to update,
+	// which may be name and parent_code. If empty,
+	// all of these fields are updated.
+	UpdateMask *google_protobuf.FieldMask
+}
+
+// GetSubject gets the Subject of the UpdateSubjectRequest.
+func (m *UpdateSubjectRequest) GetSubject() (x *Subject) {
+	if m == nil {
+		return x
+	}
+	return m.Subject
+}
+
+// GetUpdateMask gets the UpdateMask of the UpdateSubjectRequest.
+func (m *UpdateSubjectRequest) GetUpdateMask() (x *google_protobuf.FieldMask) {
+	if m == nil {
+		return x
+	}
+	return m.UpdateMask
+}
+
+// MarshalToWriter marshals UpdateSubjectRequest to the provided writer.
+func (m *UpdateSubjectRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if m.Subject != nil {
+		writer.WriteMessage(1, func() {
+			m.Subject.MarshalToWriter(writer)
+		})
+	}
+
+	if m.UpdateMask != nil {
+		writer.WriteMessage(2, func() {
+			m.UpdateMask.MarshalToWriter(writer)
+		})
+	}
+
+	return
+}
+
+// Marshal marshals UpdateSubjectRequest to a slice of bytes.
+func (m *UpdateSubjectRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a UpdateSubjectRequest from the provided reader.
+func (m *UpdateSubjectRequest) UnmarshalFromReader(reader jspb.Reader) *UpdateSubjectRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &UpdateSubjectRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			reader.ReadMessage(func() {
+				m.Subject = m.Subject.UnmarshalFromReader(reader)
+			})
+		case 2:
+			reader.ReadMessage(func() {
+				m.UpdateMask = m.UpdateMask.UnmarshalFromReader(reader)
+			})
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a UpdateSubjectRequest from a slice of bytes.
+func (m *UpdateSubjectRequest) Unmarshal(rawBytes []byte) (*UpdateSubjectRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// DeleteSubjectRequest is the input to the DeleteSubject method.
+type DeleteSubjectRequest struct {
+	// Code is the code of the subject to delete.
+	Code string
+}
+
+// GetCode gets the Code of the DeleteSubjectRequest.
+func (m *DeleteSubjectRequest) GetCode() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Code
+}
+
+// MarshalToWriter marshals DeleteSubjectRequest to the provided writer.
+func (m *DeleteSubjectRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Code) > 0 {
+		writer.WriteString(1, m.Code)
+	}
+
+	return
+}
+
+// Marshal marshals DeleteSubjectRequest to a slice of bytes.
+func (m *DeleteSubjectRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a DeleteSubjectRequest from the provided reader.
+func (m *DeleteSubjectRequest) UnmarshalFromReader(reader jspb.Reader) *DeleteSubjectRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &DeleteSubjectRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Code = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a DeleteSubjectRequest from a slice of bytes.
+func (m *DeleteSubjectRequest) Unmarshal(rawBytes []byte) (*DeleteSubjectRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// BrowseSubjectsRequest is the input to the BrowseSubjects method.
+type BrowseSubjectsRequest struct {
+	// ParentCode is the code of the subject whose subjects
+	// to return. If empty, the top level subjects are returned.
+	ParentCode string
+}
+
+// GetParentCode gets the ParentCode of the BrowseSubjectsRequest.
+func (m *BrowseSubjectsRequest) GetParentCode() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.ParentCode
+}
+
+// MarshalToWriter marshals BrowseSubjectsRequest to the provided writer.
+func (m *BrowseSubjectsRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.ParentCode) > 0 {
+		writer.WriteString(1, m.ParentCode)
+	}
+
+	return
+}
+
+// Marshal marshals BrowseSubjectsRequest to a slice of bytes.
+func (m *BrowseSubjectsRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a BrowseSubjectsRequest from the provided reader.
+func (m *BrowseSubjectsRequest) UnmarshalFromReader(reader jspb.Reader) *BrowseSubjectsRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &BrowseSubjectsRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.ParentCode = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a BrowseSubjectsRequest from a slice of bytes.
+func (m *BrowseSubjectsRequest) Unmarshal(rawBytes []byte) (*BrowseSubjectsRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// BrowseSubjectsResponse is the output of the BrowseSubjects method.
+type BrowseSubjectsResponse struct {
+	// Subjects are the subjects under the parent, by code.
+	Subjects []*SubjectCount
+}
+
+// GetSubjects gets the Subjects of the BrowseSubjectsResponse.
+func (m *BrowseSubjectsResponse) GetSubjects() (x []*SubjectCount) {
+	if m == nil {
+		return x
+	}
+	return m.Subjects
+}
+
+// MarshalToWriter marshals BrowseSubjectsResponse to the provided writer.
+func (m *BrowseSubjectsResponse) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	for _, msg := range m.Subjects {
+		writer.WriteMessage(1, func() {
+			msg.MarshalToWriter(writer)
+		})
+	}
+
+	return
+}
+
+// Marshal marshals BrowseSubjectsResponse to a slice of bytes.
+func (m *BrowseSubjectsResponse) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a BrowseSubjectsResponse from the provided reader.
+func (m *BrowseSubjectsResponse) UnmarshalFromReader(reader jspb.Reader) *BrowseSubjectsResponse {
+	for reader.Next() {
+		if m == nil {
+			m = &BrowseSubjectsResponse{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			reader.ReadMessage(func() {
+				m.Subjects = append(m.Subjects, new(SubjectCount).UnmarshalFromReader(reader))
+			})
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a BrowseSubjectsResponse from a slice of bytes.
+func (m *BrowseSubjectsResponse) Unmarshal(rawBytes []byte) (*BrowseSubjectsResponse, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// SubjectCount is a Subject and the number of Books about it.
+type SubjectCount struct {
+	// Subject is the subject.
+	Subject *Subject
+	// BookCount is the number of books with the subject,
+	// or any subject under it.
+	BookCount int32
+	// SubjectCount is the number of subjects directly under the subject.
+	SubjectCount int32
+}
+
+// GetSubject gets the Subject of the SubjectCount.
+func (m *SubjectCount) GetSubject() (x *Subject) {
+	if m == nil {
+		return x
+	}
+	return m.Subject
+}
+
+// GetBookCount gets the BookCount of the SubjectCount.
+func (m *SubjectCount) GetBookCount() (x int32) {
+	if m == nil {
+		return x
+	}
+	return m.BookCount
+}
+
+// GetSubjectCount gets the SubjectCount of the SubjectCount.
+func (m *SubjectCount) GetSubjectCount() (x int32) {
+	if m == nil {
+		return x
+	}
+	return m.SubjectCount
+}
+
+// MarshalToWriter marshals SubjectCount to the provided writer.
+func (m *SubjectCount) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if m.Subject != nil {
+		writer.WriteMessage(1, func() {
+			m.Subject.MarshalToWriter(writer)
+		})
+	}
+
+	if m.BookCount != 0 {
+		writer.WriteInt32(2, m.BookCount)
+	}
+
+	if m.SubjectCount != 0 {
+		writer.WriteInt32(3, m.SubjectCount)
+	}
+
+	return
+}
+
+// Marshal marshals SubjectCount to a slice of bytes.
+func (m *SubjectCount) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a SubjectCount from the provided reader.
+func (m *SubjectCount) UnmarshalFromReader(reader jspb.Reader) *SubjectCount {
+	for reader.Next() {
+		if m == nil {
+			m = &SubjectCount{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			reader.ReadMessage(func() {
+				m.Subject = m.Subject.UnmarshalFromReader(reader)
+			})
+		case 2:
+			m.BookCount = reader.ReadInt32()
+		case 3:
+			m.SubjectCount = reader.ReadInt32()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a SubjectCount from a slice of bytes.
+func (m *SubjectCount) Unmarshal(rawBytes []byte) (*SubjectCount, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// TagBookRequest is the input to the TagBook method.
+type TagBookRequest struct {
+	// Isbn is the ISBN-10 or ISBN-13, optionally with hyphens,
+	// of the book to tag.
+	Isbn string
+	// Member is the ID of the member applying the tag.
+	Member string
+	// Tag is the tag to apply. Tags are made lowercase, and may only
+	// contain letters, digits, spaces and dashes, up to 32 characters.
+	Tag string
+}
+
+// GetIsbn gets the Isbn of the TagBookRequest.
+func (m *TagBookRequest) GetIsbn() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Isbn
+}
+
+// GetMember gets the Member of the TagBookRequest.
+func (m *TagBookRequest) GetMember() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Member
+}
+
+// GetTag gets the Tag of the TagBookRequest.
+func (m *TagBookRequest) GetTag() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Tag
+}
+
+// MarshalToWriter marshals TagBookRequest to the provided writer.
+func (m *TagBookRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Isbn) > 0 {
+		writer.WriteString(1, m.Isbn)
+	}
+
+	if len(m.Member) > 0 {
+		writer.WriteString(2, m.Member)
+	}
+
+	if len(m.Tag) > 0 {
+		writer.WriteString(3, m.Tag)
+	}
+
+	return
+}
+
+// Marshal marshals TagBookRequest to a slice of bytes.
+func (m *TagBookRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a TagBookRequest from the provided reader.
+func (m *TagBookRequest) UnmarshalFromReader(reader jspb.Reader) *TagBookRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &TagBookRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Isbn = reader.ReadString()
+		case 2:
+			m.Member = reader.ReadString()
+		case 3:
+			m.Tag = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a TagBookRequest from a slice of bytes.
+func (m *TagBookRequest) Unmarshal(rawBytes []byte) (*TagBookRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// UntagBookRequest is the input to the UntagBook method.
+type UntagBookRequest struct {
+	// Isbn is the ISBN-10 or ISBN-13, optionally with hyphens,
+	// of the book to remove the tag from.
+	Isbn string
+	// Member is the ID of the member who applied the tag.
+	Member string
+	// Tag is the tag to remove.
+	Tag string
+}
+
+// GetIsbn gets the Isbn of the UntagBookRequest.
+func (m *UntagBookRequest) GetIsbn() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Isbn
+}
+
+// GetMember gets the Member of the UntagBookRequest.
+func (m *UntagBookRequest) GetMember() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Member
+}
+
+// GetTag gets the Tag of the UntagBookRequest.
+func (m *UntagBookRequest) GetTag() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Tag
+}
+
+// MarshalToWriter marshals UntagBookRequest to the provided writer.
+func (m *UntagBookRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Isbn) > 0 {
+		writer.WriteString(1, m.Isbn)
+	}
+
+	if len(m.Member) > 0 {
+		writer.WriteString(2, m.Member)
+	}
+
+	if len(m.Tag) > 0 {
+		writer.WriteString(3, m.Tag)
+	}
+
+	return
+}
+
+// Marshal marshals UntagBookRequest to a slice of bytes.
+func (m *UntagBookRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a UntagBookRequest from the provided reader.
+func (m *UntagBookRequest) UnmarshalFromReader(reader jspb.Reader) *UntagBookRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &UntagBookRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Isbn = reader.ReadString()
+		case 2:
+			m.Member = reader.ReadString()
+		case 3:
+			m.Tag = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a UntagBookRequest from a slice of bytes.
+func (m *UntagBookRequest) Unmarshal(rawBytes []byte) (*UntagBookRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// ListTagsRequest is the input to the ListTags method.
+type ListTagsRequest struct {
+	// Member only counts the tags applied by the member
+	// with this ID, if set.
+	Member string
+}
+
+// GetMember gets the Member of the ListTagsRequest.
+func (m *ListTagsRequest) GetMember() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Member
+}
+
+// MarshalToWriter marshals ListTagsRequest to the provided writer.
+func (m *ListTagsRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Member) > 0 {
+		writer.WriteString(1, m.Member)
+	}
+
+	return
+}
+
+// Marshal marshals ListTagsRequest to a slice of bytes.
+func (m *ListTagsRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a ListTagsRequest from the provided reader.
+func (m *ListTagsRequest) UnmarshalFromReader(reader jspb.Reader) *ListTagsRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &ListTagsRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Member = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a ListTagsRequest from a slice of bytes.
+func (m *ListTagsRequest) Unmarshal(rawBytes []byte) (*ListTagsRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// ListTagsResponse is the output of the ListTags method.
+type ListTagsResponse struct {
+	// Tags are the tags applied to books, with the number of books
+	// tagged with each, most used first.
+	Tags []*TagCount
+}
+
+// GetTags gets the Tags of the ListTagsResponse.
+func (m *ListTagsResponse) GetTags() (x []*TagCount) {
+	if m == nil {
+		return x
+	}
+	return m.Tags
+}
+
+// MarshalToWriter marshals ListTagsResponse to the provided writer.
+func (m *ListTagsResponse) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	for _, msg := range m.Tags {
+		writer.WriteMessage(1, func() {
+			msg.MarshalToWriter(writer)
+		})
+	}
+
+	return
+}
+
+// Marshal marshals ListTagsResponse to a slice of bytes.
+func (m *ListTagsResponse) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a ListTagsResponse from the provided reader.
+func (m *ListTagsResponse) UnmarshalFromReader(reader jspb.Reader) *ListTagsResponse {
+	for reader.Next() {
+		if m == nil {
+			m = &ListTagsResponse{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			reader.ReadMessage(func() {
+				m.Tags = append(m.Tags, new(TagCount).UnmarshalFromReader(reader))
+			})
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a ListTagsResponse from a slice of bytes.
+func (m *ListTagsResponse) Unmarshal(rawBytes []byte) (*ListTagsResponse, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// ListAuthorBooksRequest is the input to the ListAuthorBooks method.
+type ListAuthorBooksRequest struct {
+	// AuthorId is the ID of the author whose books to list.
+	AuthorId string
+	// PageSize is the maximum number of books to return.
+	// It defaults to 10, and may be at most 100.
+	PageSize int32
+	// PageToken is the NextPageToken of the previous response,
+	// to return the next page. The author must be the same.
+	PageToken string
+}
+
+// GetAuthorId gets the AuthorId of the ListAuthorBooksRequest.
+func (m *ListAuthorBooksRequest) GetAuthorId() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.AuthorId
+}
+
+// GetPageSize gets the PageSize of the ListAuthorBooksRequest.
+func (m *ListAuthorBooksRequest) GetPageSize() (x int32) {
+	if m == nil {
+		return x
+	}
+	return m.PageSize
+}
+
+// GetPageToken gets the PageToken of the ListAuthorBooksRequest.
+func (m *ListAuthorBooksRequest) GetPageToken() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.PageToken
+}
+
+// MarshalToWriter marshals ListAuthorBooksRequest to the provided writer.
+func (m *ListAuthorBooksRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.AuthorId) > 0 {
+		writer.WriteString(1, m.AuthorId)
+	}
+
+	if m.PageSize != 0 {
+		writer.WriteInt32(2, m.PageSize)
+	}
+
+	if len(m.PageToken) > 0 {
+		writer.WriteString(3, m.PageToken)
+	}
+
+	return
+}
+
+// Marshal marshals ListAuthorBooksRequest to a slice of bytes.
+func (m *ListAuthorBooksRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a ListAuthorBooksRequest from the provided reader.
+func (m *ListAuthorBooksRequest) UnmarshalFromReader(reader jspb.Reader) *ListAuthorBooksRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &ListAuthorBooksRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.AuthorId = reader.ReadString()
+		case 2:
+			m.PageSize = reader.ReadInt32()
+		case 3:
+			m.PageToken = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a ListAuthorBooksRequest from a slice of bytes.
+func (m *ListAuthorBooksRequest) Unmarshal(rawBytes []byte) (*ListAuthorBooksRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// ListAuthorBooksResponse is the output of the ListAuthorBooks method.
+type ListAuthorBooksResponse struct {
+	// Books is a page of the books of the author,
+	// by publication date, oldest first.
+	Books []*Book
+	// NextPageToken returns the next page when passed to ListAuthorBooks.
+	// It is empty on the last page.
+	NextPageToken string
+}
+
+// GetBooks gets the Books of the ListAuthorBooksResponse.
+func (m *ListAuthorBooksResponse) GetBooks() (x []*Book) {
+	if m == nil {
+		return x
+	}
+	return m.Books
+}
+
+// GetNextPageToken gets the NextPageToken of the ListAuthorBooksResponse.
+func (m *ListAuthorBooksResponse) GetNextPageToken() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.NextPageToken
+}
+
+// MarshalToWriter marshals ListAuthorBooksResponse to the provided writer.
+func (m *ListAuthorBooksResponse) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	for _, msg := range m.Books {
+		writer.WriteMessage(1, func() {
+			msg.MarshalToWriter(writer)
+		})
+	}
+
+	if len(m.NextPageToken) > 0 {
+		writer.WriteString(2, m.NextPageToken)
+	}
+
+	return
+}
+
+// Marshal marshals ListAuthorBooksResponse to a slice of bytes.
+func (m *ListAuthorBooksResponse) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a ListAuthorBooksResponse from the provided reader.
+func (m *ListAuthorBooksResponse) UnmarshalFromReader(reader jspb.Reader) *ListAuthorBooksResponse {
+	for reader.Next() {
+		if m == nil {
+			m = &ListAuthorBooksResponse{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			reader.ReadMessage(func() {
+				m.Books = append(m.Books, new(Book).UnmarshalFromReader(reader))
+			})
+		case 2:
+			m.NextPageToken = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a ListAuthorBooksResponse from a slice of bytes.
+func (m *ListAuthorBooksResponse) Unmarshal(rawBytes []byte) (*ListAuthorBooksResponse, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// DeleteBookRequest is the input to the DeleteBook method.
+type DeleteBookRequest struct {
+	// LegacyIsbn is the ISBN of the book to remove from the
+	// library, as a number. It is deprecated in favour of Isbn.
+	LegacyIsbn int64
+	// Isbn is the ISBN-10 or ISBN-13, optionally with hyphens,
+	// of the book to remove from the library.
+	Isbn string
+	// Etag is the etag of the book, if set. The book is only removed
+	// if it has not been changed since that version was read.
+	Etag string
+}
+
+// GetLegacyIsbn gets the LegacyIsbn of the DeleteBookRequest.
+func (m *DeleteBookRequest) GetLegacyIsbn() (x int64) {
+	if m == nil {
+		return x
+	}
+	return m.LegacyIsbn
+}
+
+// GetIsbn gets the Isbn of the DeleteBookRequest.
+func (m *DeleteBookRequest) GetIsbn() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Isbn
+}
+
+// GetEtag gets the Etag of the DeleteBookRequest.
+func (m *DeleteBookRequest) GetEtag() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Etag
+}
+
+// MarshalToWriter marshals DeleteBookRequest to the provided writer.
+func (m *DeleteBookRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if m.LegacyIsbn != 0 {
+		writer.WriteInt64(1, m.LegacyIsbn)
+	}
+
+	if len(m.Isbn) > 0 {
+		writer.WriteString(2, m.Isbn)
+	}
+
+	if len(m.Etag) > 0 {
+		writer.WriteString(3, m.Etag)
+	}
+
+	return
+}
+
+// Marshal marshals DeleteBookRequest to a slice of bytes.
+func (m *DeleteBookRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a DeleteBookRequest from the provided reader.
+func (m *DeleteBookRequest) UnmarshalFromReader(reader jspb.Reader) *DeleteBookRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &DeleteBookRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.LegacyIsbn = reader.ReadInt64()
+		case 2:
+			m.Isbn = reader.ReadString()
+		case 3:
+			m.Etag = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a DeleteBookRequest from a slice of bytes.
+func (m *DeleteBookRequest) Unmarshal(rawBytes []byte) (*DeleteBookRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// RestoreBookRequest is the input to the RestoreBook method.
+type RestoreBookRequest struct {
+	// Isbn is the ISBN-10 or ISBN-13, optionally with hyphens,
+	// of the deleted book to restore.
+	Isbn string
+}
+
+// GetIsbn gets the Isbn of the RestoreBookRequest.
+func (m *RestoreBookRequest) GetIsbn() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Isbn
+}
+
+// MarshalToWriter marshals RestoreBookRequest to the provided writer.
+func (m *RestoreBookRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Isbn) > 0 {
+		writer.WriteString(1, m.Isbn)
+	}
+
+	return
+}
+
+// Marshal marshals RestoreBookRequest to a slice of bytes.
+func (m *RestoreBookRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a RestoreBookRequest from the provided reader.
+func (m *RestoreBookRequest) UnmarshalFromReader(reader jspb.Reader) *RestoreBookRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &RestoreBookRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Isbn = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a RestoreBookRequest from a slice of bytes.
+func (m *RestoreBookRequest) Unmarshal(rawBytes []byte) (*RestoreBookRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// BookRevision is a version of a Book in the revision history.
+type BookRevision struct {
+	// Etag is the etag of the Book after the change.
+	// Deletions keep the etag of the deleted Book.
+	Etag string
+	// ChangeType is the kind of change made.
+	ChangeType BookRevision_ChangeType
+	// Book is the Book after the change. For deletions,
+	// it is the Book as it was deleted.
+	Book *Book
+	// ChangeTime is when the change was made.
+	ChangeTime *google_protobuf1.Timestamp
+	// User is the user who made the change, as provided
+	// in the "user" request metadata. Changes by users who
+	// are not members return an Unauthenticated error.
+	// The metadata is not authenticated, so it is only as
+	// trustworthy as the clients of the library.
+	User string
+	// ChangedFields lists the fields of the Book
+	// changed by an update, such as title or publisher.
+	ChangedFields []string
+}
+
+// GetEtag gets the Etag of the BookRevision.
+func (m *BookRevision) GetEtag() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Etag
+}
+
+// GetChangeType gets the ChangeType of the BookRevision.
+func (m *BookRevision) GetChangeType() (x BookRevision_ChangeType) {
+	if m == nil {
+		return x
+	}
+	return m.ChangeType
+}
+
+// GetBook gets the Book of the BookRevision.
+func (m *BookRevision) GetBook() (x *Book) {
+	if m == nil {
+		return x
+	}
+	return m.Book
+}
+
+// GetChangeTime gets the ChangeTime of the BookRevision.
+func (m *BookRevision) GetChangeTime() (x *google_protobuf1.Timestamp) {
+	if m == nil {
+		return x
+	}
+	return m.ChangeTime
+}
+
+// GetUser gets the User of the BookRevision.
+func (m *BookRevision) GetUser() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.User
+}
+
+// GetChangedFields gets the ChangedFields of the BookRevision.
+func (m *BookRevision) GetChangedFields() (x []string) {
+	if m == nil {
+		return x
+	}
+	return m.ChangedFields
+}
+
+// MarshalToWriter marshals BookRevision to the provided writer.
+func (m *BookRevision) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Etag) > 0 {
+		writer.WriteString(1, m.Etag)
+	}
+
+	if int(m.ChangeType) != 0 {
+		writer.WriteEnum(2, int(m.ChangeType))
+	}
+
+	if m.Book != nil {
+		writer.WriteMessage(3, func() {
+			m.Book.MarshalToWriter(writer)
+		})
+	}
+
+	if m.ChangeTime != nil {
+		writer.WriteMessage(4, func() {
+			m.ChangeTime.MarshalToWriter(writer)
+		})
+	}
+
+	if len(m.User) > 0 {
+		writer.WriteString(5, m.User)
+	}
+
+	for _, val := range m.ChangedFields {
+		writer.WriteString(6, val)
+	}
+
+	return
+}
+
+// Marshal marshals BookRevision to a slice of bytes.
+func (m *BookRevision) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a BookRevision from the provided reader.
+func (m *BookRevision) UnmarshalFromReader(reader jspb.Reader) *BookRevision {
+	for reader.Next() {
+		if m == nil {
+			m = &BookRevision{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Etag = reader.ReadString()
+		case 2:
+			m.ChangeType = BookRevision_ChangeType(reader.ReadEnum())
+		case 3:
+			reader.ReadMessage(func() {
+				m.Book = m.Book.UnmarshalFromReader(reader)
+			})
+		case 4:
+			reader.ReadMessage(func() {
+				m.ChangeTime = m.ChangeTime.UnmarshalFromReader(reader)
+			})
+		case 5:
+			m.User = reader.ReadString()
+		case 6:
+			m.ChangedFields = append(m.ChangedFields, reader.ReadString())
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a BookRevision from a slice of bytes.
+func (m *BookRevision) Unmarshal(rawBytes []byte) (*BookRevision, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// ListBookRevisionsRequest is the input to the ListBookRevisions method.
+type ListBookRevisionsRequest struct {
+	// Isbn is the ISBN-10 or ISBN-13, optionally with hyphens,
+	// of the book to list the revisions of.
+	Isbn string
+	// PageSize is the maximum number of revisions to return.
+	// It defaults to 10, and may be at most 100.
+	PageSize int32
+	// PageToken is the NextPageToken of the previous response,
+	// to return the next page. The ISBN must be the same.
+	PageToken string
+}
+
+// GetIsbn gets the Isbn of the ListBookRevisionsRequest.
+func (m *ListBookRevisionsRequest) GetIsbn() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Isbn
+}
+
+// GetPageSize gets the PageSize of the ListBookRevisionsRequest.
+func (m *ListBookRevisionsRequest) GetPageSize() (x int32) {
+	if m == nil {
+		return x
+	}
+	return m.PageSize
+}
+
+// GetPageToken gets the PageToken of the ListBookRevisionsRequest.
+func (m *ListBookRevisionsRequest) GetPageToken() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.PageToken
+}
+
+// MarshalToWriter marshals ListBookRevisionsRequest to the provided writer.
+func (m *ListBookRevisionsRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Isbn) > 0 {
+		writer.WriteString(1, m.Isbn)
+	}
+
+	if m.PageSize != 0 {
+		writer.WriteInt32(2, m.PageSize)
+	}
+
+	if len(m.PageToken) > 0 {
+		writer.WriteString(3, m.PageToken)
+	}
+
+	return
+}
+
+// Marshal marshals ListBookRevisionsRequest to a slice of bytes.
+func (m *ListBookRevisionsRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a ListBookRevisionsRequest from the provided reader.
+func (m *ListBookRevisionsRequest) UnmarshalFromReader(reader jspb.Reader) *ListBookRevisionsRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &ListBookRevisionsRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Isbn = reader.ReadString()
+		case 2:
+			m.PageSize = reader.ReadInt32()
+		case 3:
+			m.PageToken = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a ListBookRevisionsRequest from a slice of bytes.
+func (m *ListBookRevisionsRequest) Unmarshal(rawBytes []byte) (*ListBookRevisionsRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// ListBookRevisionsResponse is the output of the ListBookRevisions method.
+type ListBookRevisionsResponse struct {
+	// Revisions is a page of revisions, newest first.
+	Revisions []*BookRevision
+	// NextPageToken returns the next page when passed to ListBookRevisions.
+	// It is empty on the last page.
+	NextPageToken string
+}
+
+// GetRevisions gets the Revisions of the ListBookRevisionsResponse.
+func (m *ListBookRevisionsResponse) GetRevisions() (x []*BookRevision) {
+	if m == nil {
+		return x
+	}
+	return m.Revisions
+}
+
+// GetNextPageToken gets the NextPageToken of the ListBookRevisionsResponse.
+func (m *ListBookRevisionsResponse) GetNextPageToken() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.NextPageToken
+}
+
+// MarshalToWriter marshals ListBookRevisionsResponse to the provided writer.
+func (m *ListBookRevisionsResponse) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	for _, msg := range m.Revisions {
+		writer.WriteMessage(1, func() {
+			msg.MarshalToWriter(writer)
+		})
+	}
+
+	if len(m.NextPageToken) > 0 {
+		writer.WriteString(2, m.NextPageToken)
+	}
+
+	return
+}
+
+// Marshal marshals ListBookRevisionsResponse to a slice of bytes.
+func (m *ListBookRevisionsResponse) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a ListBookRevisionsResponse from the provided reader.
+func (m *ListBookRevisionsResponse) UnmarshalFromReader(reader jspb.Reader) *ListBookRevisionsResponse {
+	for reader.Next() {
+		if m == nil {
+			m = &ListBookRevisionsResponse{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			reader.ReadMessage(func() {
+				m.Revisions = append(m.Revisions, new(BookRevision).UnmarshalFromReader(reader))
+			})
+		case 2:
+			m.NextPageToken = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a ListBookRevisionsResponse from a slice of bytes.
+func (m *ListBookRevisionsResponse) Unmarshal(rawBytes []byte) (*ListBookRevisionsResponse, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Collection is a collection of books
+type Collection struct {
+	// Books is a list of books
+	Books []*Book
+	// Id identifies the Collection. It is assigned
+	// when the Collection is made.
+	Id string
+	// Owner is the ID of the member who made the Collection.
+	Owner string
+	// Name is the name of the Collection.
+	Name string
+	// CreateTime is when the Collection was made.
+	CreateTime *google_protobuf1.Timestamp
+}
+
+// GetBooks gets the Books of the Collection.
+func (m *Collection) GetBooks() (x []*Book) {
+	if m == nil {
+		return x
+	}
+	return m.Books
+}
+
+// GetId gets the Id of the Collection.
+func (m *Collection) GetId() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Id
+}
+
+// GetOwner gets the Owner of the Collection.
+func (m *Collection) GetOwner() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Owner
+}
+
+// GetName gets the Name of the Collection.
+func (m *Collection) GetName() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Name
+}
+
+// GetCreateTime gets the CreateTime of the Collection.
+func (m *Collection) GetCreateTime() (x *google_protobuf1.Timestamp) {
+	if m == nil {
+		return x
+	}
+	return m.CreateTime
+}
+
+// MarshalToWriter marshals Collection to the provided writer.
+func (m *Collection) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	for _, msg := range m.Books {
+		writer.WriteMessage(1, func() {
+			msg.MarshalToWriter(writer)
+		})
+	}
+
+	if len(m.Id) > 0 {
+		writer.WriteString(2, m.Id)
+	}
+
+	if len(m.Owner) > 0 {
+		writer.WriteString(3, m.Owner)
+	}
+
+	if len(m.Name) > 0 {
+		writer.WriteString(4, m.Name)
+	}
+
+	if m.CreateTime != nil {
+		writer.WriteMessage(5, func() {
+			m.CreateTime.MarshalToWriter(writer)
+		})
+	}
+
+	return
+}
+
+// Marshal marshals Collection to a slice of bytes.
+func (m *Collection) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a Collection from the provided reader.
+func (m *Collection) UnmarshalFromReader(reader jspb.Reader) *Collection {
+	for reader.Next() {
+		if m == nil {
+			m = &Collection{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			reader.ReadMessage(func() {
+				m.Books = append(m.Books, new(Book).UnmarshalFromReader(reader))
+			})
+		case 2:
+			m.Id = reader.ReadString()
+		case 3:
+			m.Owner = reader.ReadString()
+		case 4:
+			m.Name = reader.ReadString()
+		case 5:
+			reader.ReadMessage(func() {
+				m.CreateTime = m.CreateTime.UnmarshalFromReader(reader)
+			})
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a Collection from a slice of bytes.
+func (m *Collection) Unmarshal(rawBytes []byte) (*Collection, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// GetCollectionRequest is the input to the GetCollection method.
+type GetCollectionRequest struct {
+	// Id is the ID of the Collection to return.
+	Id string
+}
+
+// GetId gets the Id of the GetCollectionRequest.
+func (m *GetCollectionRequest) GetId() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Id
+}
+
+// MarshalToWriter marshals GetCollectionRequest to the provided writer.
+func (m *GetCollectionRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Id) > 0 {
+		writer.WriteString(1, m.Id)
+	}
+
+	return
+}
+
+// Marshal marshals GetCollectionRequest to a slice of bytes.
+func (m *GetCollectionRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a GetCollectionRequest from the provided reader.
+func (m *GetCollectionRequest) UnmarshalFromReader(reader jspb.Reader) *GetCollectionRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &GetCollectionRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Id = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a GetCollectionRequest from a slice of bytes.
+func (m *GetCollectionRequest) Unmarshal(rawBytes []byte) (*GetCollectionRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// ListCollectionsRequest is the input to the ListCollections method.
+type ListCollectionsRequest struct {
+	// Owner selects the Collections made by the member with this ID.
+	// If empty, Collections of all members are listed.
+	Owner string
+	// PageSize is the maximum number of Collections to return.
+	// It defaults to 10, and may be at most 100.
+	PageSize int32
+	// PageToken is the NextPageToken of the previous response,
+	// to return the next page. The owner must be the same.
+	PageToken string
+}
+
+// GetOwner gets the Owner of the ListCollectionsRequest.
+func (m *ListCollectionsRequest) GetOwner() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Owner
+}
+
+// GetPageSize gets the PageSize of the ListCollectionsRequest.
+func (m *ListCollectionsRequest) GetPageSize() (x int32) {
+	if m == nil {
+		return x
+	}
+	return m.PageSize
+}
+
+// GetPageToken gets the PageToken of the ListCollectionsRequest.
+func (m *ListCollectionsRequest) GetPageToken() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.PageToken
+}
+
+// MarshalToWriter marshals ListCollectionsRequest to the provided writer.
+func (m *ListCollectionsRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Owner) > 0 {
+		writer.WriteString(1, m.Owner)
+	}
+
+	if m.PageSize != 0 {
+		writer.WriteInt32(2, m.PageSize)
+	}
+
+	if len(m.PageToken) > 0 {
+		writer.WriteString(3, m.PageToken)
+	}
+
+	return
+}
+
+// Marshal marshals ListCollectionsRequest to a slice of bytes.
+func (m *ListCollectionsRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a ListCollectionsRequest from the provided reader.
+func (m *ListCollectionsRequest) UnmarshalFromReader(reader jspb.Reader) *ListCollectionsRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &ListCollectionsRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Owner = reader.ReadString()
+		case 2:
+			m.PageSize = reader.ReadInt32()
+		case 3:
+			m.PageToken = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a ListCollectionsRequest from a slice of bytes.
+func (m *ListCollectionsRequest) Unmarshal(rawBytes []byte) (*ListCollectionsRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// ListCollectionsResponse is the output of the ListCollections method.
+type ListCollectionsResponse struct {
+	// Collections is a page of Collections, oldest first.
+	Collections []*Collection
+	// NextPageToken returns the next page when passed to ListCollections.
+	// It is empty on the last page.
+	NextPageToken string
+}
+
+// GetCollections gets the Collections of the ListCollectionsResponse.
+func (m *ListCollectionsResponse) GetCollections() (x []*Collection) {
+	if m == nil {
+		return x
+	}
+	return m.Collections
+}
+
+// GetNextPageToken gets the NextPageToken of the ListCollectionsResponse.
+func (m *ListCollectionsResponse) GetNextPageToken() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.NextPageToken
+}
+
+// MarshalToWriter marshals ListCollectionsResponse to the provided writer.
+func (m *ListCollectionsResponse) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	for _, msg := range m.Collections {
+		writer.WriteMessage(1, func() {
+			msg.MarshalToWriter(writer)
+		})
+	}
+
+	if len(m.NextPageToken) > 0 {
+		writer.WriteString(2, m.NextPageToken)
+	}
+
+	return
+}
+
+// Marshal marshals ListCollectionsResponse to a slice of bytes.
+func (m *ListCollectionsResponse) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a ListCollectionsResponse from the provided reader.
+func (m *ListCollectionsResponse) UnmarshalFromReader(reader jspb.Reader) *ListCollectionsResponse {
+	for reader.Next() {
+		if m == nil {
+			m = &ListCollectionsResponse{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			reader.ReadMessage(func() {
+				m.Collections = append(m.Collections, new(Collection).UnmarshalFromReader(reader))
+			})
+		case 2:
+			m.NextPageToken = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a ListCollectionsResponse from a slice of bytes.
+func (m *ListCollectionsResponse) Unmarshal(rawBytes []byte) (*ListCollectionsResponse, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// UpdateCollectionRequest is the input to the UpdateCollection method.
+type UpdateCollectionRequest struct {
+	// Collection contains the new values of the Collection.
+	// The ID identifies the Collection to update.
+	Collection *Collection
+	// UpdateMask lists the fields of the Collection to update.
+	// Valid paths are name and books. Updating the books replaces
+	// the Books of the Collection with the ISBNs of the Books provided,
+	// in order, which adds, removes and reorders Books.
+	// If it is not set, both fields are replaced.
+	UpdateMask *google_protobuf.FieldMask
+}
+
+// GetCollection gets the Collection of the UpdateCollectionRequest.
+func (m *UpdateCollectionRequest) GetCollection() (x *Collection) {
+	if m == nil {
+		return x
+	}
+	return m.Collection
+}
+
+// GetUpdateMask gets the UpdateMask of the UpdateCollectionRequest.
+func (m *UpdateCollectionRequest) GetUpdateMask() (x *google_protobuf.FieldMask) {
+	if m == nil {
+		return x
+	}
+	return m.UpdateMask
+}
+
+// MarshalToWriter marshals UpdateCollectionRequest to the provided writer.
+func (m *UpdateCollectionRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if m.Collection != nil {
+		writer.WriteMessage(1, func() {
+			m.Collection.MarshalToWriter(writer)
+		})
+	}
+
+	if m.UpdateMask != nil {
+		writer.WriteMessage(2, func() {
+			m.UpdateMask.MarshalToWriter(writer)
+		})
+	}
+
+	return
+}
+
+// Marshal marshals UpdateCollectionRequest to a slice of bytes.
+func (m *UpdateCollectionRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a UpdateCollectionRequest from the provided reader.
+func (m *UpdateCollectionRequest) UnmarshalFromReader(reader jspb.Reader) *UpdateCollectionRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &UpdateCollectionRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			reader.ReadMessage(func() {
+				m.Collection = m.Collection.UnmarshalFromReader(reader)
+			})
+		case 2:
+			reader.ReadMessage(func() {
+				m.UpdateMask = m.UpdateMask.UnmarshalFromReader(reader)
+			})
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a UpdateCollectionRequest from a slice of bytes.
+func (m *UpdateCollectionRequest) Unmarshal(rawBytes []byte) (*UpdateCollectionRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// DeleteCollectionRequest is the input to the DeleteCollection method.
+type DeleteCollectionRequest struct {
+	// Id is the ID of the Collection to delete.
+	Id string
+}
+
+// GetId gets the Id of the DeleteCollectionRequest.
+func (m *DeleteCollectionRequest) GetId() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Id
+}
+
+// MarshalToWriter marshals DeleteCollectionRequest to the provided writer.
+func (m *DeleteCollectionRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Id) > 0 {
+		writer.WriteString(1, m.Id)
+	}
+
+	return
+}
+
+// Marshal marshals DeleteCollectionRequest to a slice of bytes.
+func (m *DeleteCollectionRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a DeleteCollectionRequest from the provided reader.
+func (m *DeleteCollectionRequest) UnmarshalFromReader(reader jspb.Reader) *DeleteCollectionRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &DeleteCollectionRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Id = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a DeleteCollectionRequest from a slice of bytes.
+func (m *DeleteCollectionRequest) Unmarshal(rawBytes []byte) (*DeleteCollectionRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// ExportCollectionRequest is the input to the ExportCollection method.
+// Either a collection ID or a query must be set.
+type ExportCollectionRequest struct {
+	// Types that are valid to be assigned to Source:
+	//	*ExportCollectionRequest_CollectionId
+	//	*ExportCollectionRequest_Query
+	Source isExportCollectionRequest_Source
+	// Format is the file format to export the Books in.
+	Format ExportFormat
+}
+
+// isExportCollectionRequest_Source is used to distinguish types assignable to Source
+type isExportCollectionRequest_Source interface{ isExportCollectionRequest_Source() }
+
+// ExportCollectionRequest_CollectionId is assignable to Source
+type ExportCollectionRequest_CollectionId struct {
+	// CollectionId is the ID of the Collection to export,
+	// as returned by MakeCollection. Books of the Collection
+	// that are no longer in the library are left out.
+	CollectionId string
+}
+
+// ExportCollectionRequest_Query is assignable to Source
+type ExportCollectionRequest_Query struct {
+	// Query selects the Books in the library to export,
+	// in the order requested.
+	Query *QueryBooksRequest
+}
+
+func (*ExportCollectionRequest_CollectionId) isExportCollectionRequest_Source() {}
+func (*ExportCollectionRequest_Query) isExportCollectionRequest_Source()        {}
+
+// GetSource gets the Source of the ExportCollectionRequest.
+func (m *ExportCollectionRequest) GetSource() (x isExportCollectionRequest_Source) {
+	if m == nil {
+		return x
+	}
+	return m.Source
+}
+
+// GetCollectionId gets the CollectionId of the ExportCollectionRequest.
+func (m *ExportCollectionRequest) GetCollectionId() (x string) {
+	if v, ok := m.GetSource().(*ExportCollectionRequest_CollectionId); ok {
+		return v.CollectionId
+	}
+	return x
+}
+
+// GetQuery gets the Query of the ExportCollectionRequest.
+func (m *ExportCollectionRequest) GetQuery() (x *QueryBooksRequest) {
+	if v, ok := m.GetSource().(*ExportCollectionRequest_Query); ok {
+		return v.Query
+	}
+	return x
+}
+
+// GetFormat gets the Format of the ExportCollectionRequest.
+func (m *ExportCollectionRequest) GetFormat() (x ExportFormat) {
+	if m == nil {
+		return x
+	}
+	return m.Format
+}
+
+// MarshalToWriter marshals ExportCollectionRequest to the provided writer.
+func (m *ExportCollectionRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	switch t := m.Source.(type) {
+	case *ExportCollectionRequest_CollectionId:
+		if len(t.CollectionId) > 0 {
+			writer.WriteString(4, t.CollectionId)
+		}
+	case *ExportCollectionRequest_Query:
+		if t.Query != nil {
+			writer.WriteMessage(2, func() {
+				t.Query.MarshalToWriter(writer)
+			})
+		}
+	}
+
+	if int(m.Format) != 0 {
+		writer.WriteEnum(3, int(m.Format))
+	}
+
+	return
+}
+
+// Marshal marshals ExportCollectionRequest to a slice of bytes.
+func (m *ExportCollectionRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a ExportCollectionRequest from the provided reader.
+func (m *ExportCollectionRequest) UnmarshalFromReader(reader jspb.Reader) *ExportCollectionRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &ExportCollectionRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 4:
+			m.Source = &ExportCollectionRequest_CollectionId{
+				CollectionId: reader.ReadString(),
+			}
+		case 2:
+			reader.ReadMessage(func() {
+				m.Source = &ExportCollectionRequest_Query{
+					Query: new(QueryBooksRequest).UnmarshalFromReader(reader),
+				}
+			})
+		case 3:
+			m.Format = ExportFormat(reader.ReadEnum())
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a ExportCollectionRequest from a slice of bytes.
+func (m *ExportCollectionRequest) Unmarshal(rawBytes []byte) (*ExportCollectionRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// ExportChunk is a part of an exported file.
+type ExportChunk struct {
+	// ContentType is the media type of the file.
+	// It is only set in the first chunk.
+	ContentType string
+	// Filename is a suggested name for the file.
+	// It is only set in the first chunk.
+	Filename string
+	// Data is the next part of the file.
+	Data []byte
+}
+
+// GetContentType gets the ContentType of the ExportChunk.
+func (m *ExportChunk) GetContentType() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.ContentType
+}
+
+// GetFilename gets the Filename of the ExportChunk.
+func (m *ExportChunk) GetFilename() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Filename
+}
+
+// GetData gets the Data of the ExportChunk.
+func (m *ExportChunk) GetData() (x []byte) {
+	if m == nil {
+		return x
+	}
+	return m.Data
+}
+
+// MarshalToWriter marshals ExportChunk to the provided writer.
+func (m *ExportChunk) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.ContentType) > 0 {
+		writer.WriteString(1, m.ContentType)
+	}
+
+	if len(m.Filename) > 0 {
+		writer.WriteString(2, m.Filename)
+	}
+
+	if len(m.Data) > 0 {
+		writer.WriteBytes(3, m.Data)
+	}
+
+	return
+}
+
+// Marshal marshals ExportChunk to a slice of bytes.
+func (m *ExportChunk) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a ExportChunk from the provided reader.
+func (m *ExportChunk) UnmarshalFromReader(reader jspb.Reader) *ExportChunk {
+	for reader.Next() {
+		if m == nil {
+			m = &ExportChunk{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.ContentType = reader.ReadString()
+		case 2:
+			m.Filename = reader.ReadString()
+		case 3:
+			m.Data = reader.ReadBytes()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a ExportChunk from a slice of bytes.
+func (m *ExportChunk) Unmarshal(rawBytes []byte) (*ExportChunk, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// WatchBooksRequest is the input to the WatchBooks method.
+type WatchBooksRequest struct {
+	// Filter selects the Books to watch, using
+	// the same syntax as the filter of ListBooks.
+	Filter string
+	// ResumeToken is the resume token of the last event received,
+	// to resume a watch after reconnecting. The filter must be
+	// the same as in the watch that sent the event.
+	ResumeToken string
+}
+
+// GetFilter gets the Filter of the WatchBooksRequest.
+func (m *WatchBooksRequest) GetFilter() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Filter
+}
+
+// GetResumeToken gets the ResumeToken of the WatchBooksRequest.
+func (m *WatchBooksRequest) GetResumeToken() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.ResumeToken
+}
+
+// MarshalToWriter marshals WatchBooksRequest to the provided writer.
+func (m *WatchBooksRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Filter) > 0 {
+		writer.WriteString(1, m.Filter)
+	}
+
+	if len(m.ResumeToken) > 0 {
+		writer.WriteString(2, m.ResumeToken)
+	}
+
+	return
+}
+
+// Marshal marshals WatchBooksRequest to a slice of bytes.
+func (m *WatchBooksRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a WatchBooksRequest from the provided reader.
+func (m *WatchBooksRequest) UnmarshalFromReader(reader jspb.Reader) *WatchBooksRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &WatchBooksRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Filter = reader.ReadString()
+		case 2:
+			m.ResumeToken = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a WatchBooksRequest from a slice of bytes.
+func (m *WatchBooksRequest) Unmarshal(rawBytes []byte) (*WatchBooksRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// BookEvent is a change to the Books in the library.
+type BookEvent struct {
+	// Type is the kind of change made.
+	Type BookEvent_Type
+	// Book is the Book after the change,
+	// or before it, if it was deleted.
+	Book *Book
+	// Revision identifies the change.
+	// Revisions increase with each change to the library.
+	Revision int64
+	// ResumeToken can be used to resume the watch after this event.
+	ResumeToken string
+}
+
+// GetType gets the Type of the BookEvent.
+func (m *BookEvent) GetType() (x BookEvent_Type) {
+	if m == nil {
+		return x
+	}
+	return m.Type
+}
+
+// GetBook gets the Book of the BookEvent.
+func (m *BookEvent) GetBook() (x *Book) {
+	if m == nil {
+		return x
+	}
+	return m.Book
+}
+
+// GetRevision gets the Revision of the BookEvent.
+func (m *BookEvent) GetRevision() (x int64) {
+	if m == nil {
+		return x
+	}
+	return m.Revision
+}
+
+// GetResumeToken gets the ResumeToken of the BookEvent.
+func (m *BookEvent) GetResumeToken() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.ResumeToken
+}
+
+// MarshalToWriter marshals BookEvent to the provided writer.
+func (m *BookEvent) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if int(m.Type) != 0 {
+		writer.WriteEnum(1, int(m.Type))
+	}
+
+	if m.Book != nil {
+		writer.WriteMessage(2, func() {
+			m.Book.MarshalToWriter(writer)
+		})
+	}
+
+	if m.Revision != 0 {
+		writer.WriteInt64(3, m.Revision)
+	}
+
+	if len(m.ResumeToken) > 0 {
+		writer.WriteString(4, m.ResumeToken)
+	}
+
+	return
+}
+
+// Marshal marshals BookEvent to a slice of bytes.
+func (m *BookEvent) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a BookEvent from the provided reader.
+func (m *BookEvent) UnmarshalFromReader(reader jspb.Reader) *BookEvent {
+	for reader.Next() {
+		if m == nil {
+			m = &BookEvent{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Type = BookEvent_Type(reader.ReadEnum())
+		case 2:
+			reader.ReadMessage(func() {
+				m.Book = m.Book.UnmarshalFromReader(reader)
+			})
+		case 3:
+			m.Revision = reader.ReadInt64()
+		case 4:
+			m.ResumeToken = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a BookEvent from a slice of bytes.
+func (m *BookEvent) Unmarshal(rawBytes []byte) (*BookEvent, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Loan is a copy of a Book lent to a member of the library.
+type Loan struct {
+	// Id identifies the loan. It is set by the server.
+	Id string
+	// Isbn is the ISBN-13 of the book lent.
+	Isbn string
+	// Member is the ID of the member borrowing the book.
+	Member string
+	// CheckoutTime is when the book was checked out.
+	CheckoutTime *google_protobuf1.Timestamp
+	// DueTime is when the book must be returned.
+	DueTime *google_protobuf1.Timestamp
+	// Renewals is the number of times the loan has been renewed.
+	Renewals int32
+	// ReturnTime is when the book was returned.
+	// It is only set on returned loans.
+	ReturnTime *google_protobuf1.Timestamp
+	// Barcode is the barcode of the copy lent, if it
+	// was checked out by barcode.
+	Barcode string
+}
+
+// GetId gets the Id of the Loan.
+func (m *Loan) GetId() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Id
+}
+
+// GetIsbn gets the Isbn of the Loan.
+func (m *Loan) GetIsbn() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Isbn
+}
+
+// GetMember gets the Member of the Loan.
+func (m *Loan) GetMember() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Member
+}
+
+// GetCheckoutTime gets the CheckoutTime of the Loan.
+func (m *Loan) GetCheckoutTime() (x *google_protobuf1.Timestamp) {
+	if m == nil {
+		return x
+	}
+	return m.CheckoutTime
+}
+
+// GetDueTime gets the DueTime of the Loan.
+func (m *Loan) GetDueTime() (x *google_protobuf1.Timestamp) {
+	if m == nil {
+		return x
+	}
+	return m.DueTime
+}
+
+// GetRenewals gets the Renewals of the Loan.
+func (m *Loan) GetRenewals() (x int32) {
+	if m == nil {
+		return x
+	}
+	return m.Renewals
+}
+
+// GetReturnTime gets the ReturnTime of the Loan.
+func (m *Loan) GetReturnTime() (x *google_protobuf1.Timestamp) {
+	if m == nil {
+		return x
+	}
+	return m.ReturnTime
+}
+
+// GetBarcode gets the Barcode of the Loan.
+func (m *Loan) GetBarcode() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Barcode
+}
+
+// MarshalToWriter marshals Loan to the provided writer.
+func (m *Loan) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Id) > 0 {
+		writer.WriteString(1, m.Id)
+	}
+
+	if len(m.Isbn) > 0 {
+		writer.WriteString(2, m.Isbn)
+	}
+
+	if len(m.Member) > 0 {
+		writer.WriteString(3, m.Member)
+	}
+
+	if m.CheckoutTime != nil {
+		writer.WriteMessage(4, func() {
+			m.CheckoutTime.MarshalToWriter(writer)
+		})
+	}
+
+	if m.DueTime != nil {
+		writer.WriteMessage(5, func() {
+			m.DueTime.MarshalToWriter(writer)
+		})
+	}
+
+	if m.Renewals != 0 {
+		writer.WriteInt32(6, m.Renewals)
+	}
+
+	if m.ReturnTime != nil {
+		writer.WriteMessage(7, func() {
+			m.ReturnTime.MarshalToWriter(writer)
+		})
+	}
+
+	if len(m.Barcode) > 0 {
+		writer.WriteString(8, m.Barcode)
+	}
+
+	return
+}
+
+// Marshal marshals Loan to a slice of bytes.
+func (m *Loan) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a Loan from the provided reader.
+func (m *Loan) UnmarshalFromReader(reader jspb.Reader) *Loan {
+	for reader.Next() {
+		if m == nil {
+			m = &Loan{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Id = reader.ReadString()
+		case 2:
+			m.Isbn = reader.ReadString()
+		case 3:
+			m.Member = reader.ReadString()
+		case 4:
+			reader.ReadMessage(func() {
+				m.CheckoutTime = m.CheckoutTime.UnmarshalFromReader(reader)
+			})
+		case 5:
+			reader.ReadMessage(func() {
+				m.DueTime = m.DueTime.UnmarshalFromReader(reader)
+			})
+		case 6:
+			m.Renewals = reader.ReadInt32()
+		case 7:
+			reader.ReadMessage(func() {
+				m.ReturnTime = m.ReturnTime.UnmarshalFromReader(reader)
+			})
+		case 8:
+			m.Barcode = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a Loan from a slice of bytes.
+func (m *Loan) Unmarshal(rawBytes []byte) (*Loan, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// CheckoutRequest is the input to the Checkout method.
+type CheckoutRequest struct {
+	// Isbn is the ISBN-10 or ISBN-13, optionally with hyphens,
+	// of the book to check out.
+	Isbn string
+	// Member is the ID of the member borrowing the book.
+	Member string
+	// Barcode is the barcode of the copy to check out, if set.
+	// The ISBN may then be omitted.
+	Barcode string
+}
+
+// GetIsbn gets the Isbn of the CheckoutRequest.
+func (m *CheckoutRequest) GetIsbn() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Isbn
+}
+
+// GetMember gets the Member of the CheckoutRequest.
+func (m *CheckoutRequest) GetMember() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Member
+}
+
+// GetBarcode gets the Barcode of the CheckoutRequest.
+func (m *CheckoutRequest) GetBarcode() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Barcode
+}
+
+// MarshalToWriter marshals CheckoutRequest to the provided writer.
+func (m *CheckoutRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Isbn) > 0 {
+		writer.WriteString(1, m.Isbn)
+	}
+
+	if len(m.Member) > 0 {
+		writer.WriteString(2, m.Member)
+	}
+
+	if len(m.Barcode) > 0 {
+		writer.WriteString(3, m.Barcode)
+	}
+
+	return
+}
+
+// Marshal marshals CheckoutRequest to a slice of bytes.
+func (m *CheckoutRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a CheckoutRequest from the provided reader.
+func (m *CheckoutRequest) UnmarshalFromReader(reader jspb.Reader) *CheckoutRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &CheckoutRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Isbn = reader.ReadString()
+		case 2:
+			m.Member = reader.ReadString()
+		case 3:
+			m.Barcode = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a CheckoutRequest from a slice of bytes.
+func (m *CheckoutRequest) Unmarshal(rawBytes []byte) (*CheckoutRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// ReturnRequest is the input to the Return method.
+type ReturnRequest struct {
+	// Id is the ID of the loan to return.
+	Id string
+	// BranchId is the ID of the branch the copy was returned to,
+	// if the loan was checked out by barcode. It defaults to the
+	// branch the copy was lent from.
+	BranchId string
+}
+
+// GetId gets the Id of the ReturnRequest.
+func (m *ReturnRequest) GetId() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Id
+}
+
+// GetBranchId gets the BranchId of the ReturnRequest.
+func (m *ReturnRequest) GetBranchId() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.BranchId
+}
+
+// MarshalToWriter marshals ReturnRequest to the provided writer.
+func (m *ReturnRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Id) > 0 {
+		writer.WriteString(1, m.Id)
+	}
+
+	if len(m.BranchId) > 0 {
+		writer.WriteString(2, m.BranchId)
+	}
+
+	return
+}
+
+// Marshal marshals ReturnRequest to a slice of bytes.
+func (m *ReturnRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a ReturnRequest from the provided reader.
+func (m *ReturnRequest) UnmarshalFromReader(reader jspb.Reader) *ReturnRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &ReturnRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Id = reader.ReadString()
+		case 2:
+			m.BranchId = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a ReturnRequest from a slice of bytes.
+func (m *ReturnRequest) Unmarshal(rawBytes []byte) (*ReturnRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// RenewRequest is the input to the Renew method.
+type RenewRequest struct {
+	// Id is the ID of the loan to renew.
+	Id string
+}
+
+// GetId gets the Id of the RenewRequest.
+func (m *RenewRequest) GetId() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Id
+}
+
+// MarshalToWriter marshals RenewRequest to the provided writer.
+func (m *RenewRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Id) > 0 {
+		writer.WriteString(1, m.Id)
+	}
+
+	return
+}
+
+// Marshal marshals RenewRequest to a slice of bytes.
+func (m *RenewRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a RenewRequest from the provided reader.
+func (m *RenewRequest) UnmarshalFromReader(reader jspb.Reader) *RenewRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &RenewRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Id = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a RenewRequest from a slice of bytes.
+func (m *RenewRequest) Unmarshal(rawBytes []byte) (*RenewRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// ListLoansRequest is the input to the ListLoans method.
+type ListLoansRequest struct {
+	// Member only lists the loans of the member with this ID, if set.
+	Member string
+	// Isbn is the ISBN-10 or ISBN-13, optionally with hyphens,
+	// of the book to list the loans of, if set.
+	Isbn string
+	// IncludeReturned also lists loans that have been returned.
+	IncludeReturned bool
+	// PageSize is the maximum number of loans to return.
+	// It defaults to 10, and may be at most 100.
+	PageSize int32
+	// PageToken is the NextPageToken of the previous response,
+	// to return the next page. The filters must be the same.
+	PageToken string
+}
+
+// GetMember gets the Member of the ListLoansRequest.
+func (m *ListLoansRequest) GetMember() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Member
+}
+
+// GetIsbn gets the Isbn of the ListLoansRequest.
+func (m *ListLoansRequest) GetIsbn() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Isbn
+}
+
+// GetIncludeReturned gets the IncludeReturned of the ListLoansRequest.
+func (m *ListLoansRequest) GetIncludeReturned() (x bool) {
+	if m == nil {
+		return x
+	}
+	return m.IncludeReturned
+}
+
+// GetPageSize gets the PageSize of the ListLoansRequest.
+func (m *ListLoansRequest) GetPageSize() (x int32) {
+	if m == nil {
+		return x
+	}
+	return m.PageSize
+}
+
+// GetPageToken gets the PageToken of the ListLoansRequest.
+func (m *ListLoansRequest) GetPageToken() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.PageToken
+}
+
+// MarshalToWriter marshals ListLoansRequest to the provided writer.
+func (m *ListLoansRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Member) > 0 {
+		writer.WriteString(1, m.Member)
+	}
+
+	if len(m.Isbn) > 0 {
+		writer.WriteString(2, m.Isbn)
+	}
+
+	if m.IncludeReturned {
+		writer.WriteBool(3, m.IncludeReturned)
+	}
+
+	if m.PageSize != 0 {
+		writer.WriteInt32(4, m.PageSize)
+	}
+
+	if len(m.PageToken) > 0 {
+		writer.WriteString(5, m.PageToken)
+	}
+
+	return
+}
+
+// Marshal marshals ListLoansRequest to a slice of bytes.
+func (m *ListLoansRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a ListLoansRequest from the provided reader.
+func (m *ListLoansRequest) UnmarshalFromReader(reader jspb.Reader) *ListLoansRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &ListLoansRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Member = reader.ReadString()
+		case 2:
+			m.Isbn = reader.ReadString()
+		case 3:
+			m.IncludeReturned = reader.ReadBool()
+		case 4:
+			m.PageSize = reader.ReadInt32()
+		case 5:
+			m.PageToken = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a ListLoansRequest from a slice of bytes.
+func (m *ListLoansRequest) Unmarshal(rawBytes []byte) (*ListLoansRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// ListLoansResponse is the output of the ListLoans method.
+type ListLoansResponse struct {
+	// Loans is a page of loans, oldest first.
+	Loans []*Loan
+	// NextPageToken returns the next page when passed to ListLoans.
+	// It is empty on the last page.
+	NextPageToken string
+}
+
+// GetLoans gets the Loans of the ListLoansResponse.
+func (m *ListLoansResponse) GetLoans() (x []*Loan) {
+	if m == nil {
+		return x
+	}
+	return m.Loans
+}
+
+// GetNextPageToken gets the NextPageToken of the ListLoansResponse.
+func (m *ListLoansResponse) GetNextPageToken() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.NextPageToken
+}
+
+// MarshalToWriter marshals ListLoansResponse to the provided writer.
+func (m *ListLoansResponse) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	for _, msg := range m.Loans {
+		writer.WriteMessage(1, func() {
+			msg.MarshalToWriter(writer)
+		})
+	}
+
+	if len(m.NextPageToken) > 0 {
+		writer.WriteString(2, m.NextPageToken)
+	}
+
+	return
+}
+
+// Marshal marshals ListLoansResponse to a slice of bytes.
+func (m *ListLoansResponse) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a ListLoansResponse from the provided reader.
+func (m *ListLoansResponse) UnmarshalFromReader(reader jspb.Reader) *ListLoansResponse {
+	for reader.Next() {
+		if m == nil {
+			m = &ListLoansResponse{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			reader.ReadMessage(func() {
+				m.Loans = append(m.Loans, new(Loan).UnmarshalFromReader(reader))
+			})
+		case 2:
+			m.NextPageToken = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a ListLoansResponse from a slice of bytes.
+func (m *ListLoansResponse) Unmarshal(rawBytes []byte) (*ListLoansResponse, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Hold is a member's place in the queue for a Book
+// when every copy is on loan.
+type Hold struct {
+	// Id identifies the hold. It is set by the server.
+	Id string
+	// Isbn is the ISBN-13 of the book held.
+	Isbn string
+	// Member is the ID of the member waiting for the book.
+	Member string
+	// CreateTime is when the hold was placed.
+	CreateTime *google_protobuf1.Timestamp
+	// State is the state of the hold. It is set by the server.
+	State Hold_State
+	// Position is the 1-based position of a waiting hold in
+	// the queue for the book, first come first served.
+	// It is 0 for holds that are not waiting.
+	Position int32
+	// ReadyTime is when a copy was set aside for the hold.
+	ReadyTime *google_protobuf1.Timestamp
+	// ExpireTime is when the copy set aside for a ready hold
+	// is offered to the next member in the queue.
+	ExpireTime *google_protobuf1.Timestamp
+	// Barcode is the barcode of the copy set aside for a ready hold.
+	// It is empty if a copy without a barcode was set aside.
+	// It is set by the server.
+	Barcode string
+}
+
+// GetId gets the Id of the Hold.
+func (m *Hold) GetId() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Id
+}
+
+// GetIsbn gets the Isbn of the Hold.
+func (m *Hold) GetIsbn() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Isbn
+}
+
+// GetMember gets the Member of the Hold.
+func (m *Hold) GetMember() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Member
+}
+
+// GetCreateTime gets the CreateTime of the Hold.
+func (m *Hold) GetCreateTime() (x *google_protobuf1.Timestamp) {
+	if m == nil {
+		return x
+	}
+	return m.CreateTime
+}
+
+// GetState gets the State of the Hold.
+func (m *Hold) GetState() (x Hold_State) {
+	if m == nil {
+		return x
+	}
+	return m.State
+}
+
+// GetPosition gets the Position of the Hold.
+func (m *Hold) GetPosition() (x int32) {
+	if m == nil {
+		return x
+	}
+	return m.Position
+}
+
+// GetReadyTime gets the ReadyTime of the Hold.
+func (m *Hold) GetReadyTime() (x *google_protobuf1.Timestamp) {
+	if m == nil {
+		return x
+	}
+	return m.ReadyTime
+}
+
+// GetExpireTime gets the ExpireTime of the Hold.
+func (m *Hold) GetExpireTime() (x *google_protobuf1.Timestamp) {
+	if m == nil {
+		return x
+	}
+	return m.ExpireTime
+}
+
+// GetBarcode gets the Barcode of the Hold.
+func (m *Hold) GetBarcode() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Barcode
+}
+
+// MarshalToWriter marshals Hold to the provided writer.
+func (m *Hold) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Id) > 0 {
+		writer.WriteString(1, m.Id)
+	}
+
+	if len(m.Isbn) > 0 {
+		writer.WriteString(2, m.Isbn)
+	}
+
+	if len(m.Member) > 0 {
+		writer.WriteString(3, m.Member)
+	}
+
+	if m.CreateTime != nil {
+		writer.WriteMessage(4, func() {
+			m.CreateTime.MarshalToWriter(writer)
+		})
+	}
+
+	if int(m.State) != 0 {
+		writer.WriteEnum(5, int(m.State))
+	}
+
+	if m.Position != 0 {
+		writer.WriteInt32(6, m.Position)
+	}
+
+	if m.ReadyTime != nil {
+		writer.WriteMessage(7, func() {
+			m.ReadyTime.MarshalToWriter(writer)
+		})
+	}
+
+	if m.ExpireTime != nil {
+		writer.WriteMessage(8, func() {
+			m.ExpireTime.MarshalToWriter(writer)
+		})
+	}
+
+	if len(m.Barcode) > 0 {
+		writer.WriteString(9, m.Barcode)
+	}
+
+	return
+}
+
+// Marshal marshals Hold to a slice of bytes.
+func (m *Hold) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a Hold from the provided reader.
+func (m *Hold) UnmarshalFromReader(reader jspb.Reader) *Hold {
+	for reader.Next() {
+		if m == nil {
+			m = &Hold{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Id = reader.ReadString()
+		case 2:
+			m.Isbn = reader.ReadString()
+		case 3:
+			m.Member = reader.ReadString()
+		case 4:
+			reader.ReadMessage(func() {
+				m.CreateTime = m.CreateTime.UnmarshalFromReader(reader)
+			})
+		case 5:
+			m.State = Hold_State(reader.ReadEnum())
+		case 6:
+			m.Position = reader.ReadInt32()
+		case 7:
+			reader.ReadMessage(func() {
+				m.ReadyTime = m.ReadyTime.UnmarshalFromReader(reader)
+			})
+		case 8:
+			reader.ReadMessage(func() {
+				m.ExpireTime = m.ExpireTime.UnmarshalFromReader(reader)
+			})
+		case 9:
+			m.Barcode = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a Hold from a slice of bytes.
+func (m *Hold) Unmarshal(rawBytes []byte) (*Hold, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// PlaceHoldRequest is the input to the PlaceHold method.
+type PlaceHoldRequest struct {
+	// Isbn is the ISBN-10 or ISBN-13, optionally with hyphens,
+	// of the book to place a hold on.
+	Isbn string
+	// Member is the ID of the member placing the hold.
+	Member string
+}
+
+// GetIsbn gets the Isbn of the PlaceHoldRequest.
+func (m *PlaceHoldRequest) GetIsbn() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Isbn
+}
+
+// GetMember gets the Member of the PlaceHoldRequest.
+func (m *PlaceHoldRequest) GetMember() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Member
+}
+
+// MarshalToWriter marshals PlaceHoldRequest to the provided writer.
+func (m *PlaceHoldRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Isbn) > 0 {
+		writer.WriteString(1, m.Isbn)
+	}
+
+	if len(m.Member) > 0 {
+		writer.WriteString(2, m.Member)
+	}
+
+	return
+}
+
+// Marshal marshals PlaceHoldRequest to a slice of bytes.
+func (m *PlaceHoldRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a PlaceHoldRequest from the provided reader.
+func (m *PlaceHoldRequest) UnmarshalFromReader(reader jspb.Reader) *PlaceHoldRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &PlaceHoldRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Isbn = reader.ReadString()
+		case 2:
+			m.Member = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a PlaceHoldRequest from a slice of bytes.
+func (m *PlaceHoldRequest) Unmarshal(rawBytes []byte) (*PlaceHoldRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// CancelHoldRequest is the input to the CancelHold method.
+type CancelHoldRequest struct {
+	// Id is the ID of the hold to cancel.
+	Id string
+}
+
+// GetId gets the Id of the CancelHoldRequest.
+func (m *CancelHoldRequest) GetId() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Id
+}
+
+// MarshalToWriter marshals CancelHoldRequest to the provided writer.
+func (m *CancelHoldRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Id) > 0 {
+		writer.WriteString(1, m.Id)
+	}
+
+	return
+}
+
+// Marshal marshals CancelHoldRequest to a slice of bytes.
+func (m *CancelHoldRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a CancelHoldRequest from the provided reader.
+func (m *CancelHoldRequest) UnmarshalFromReader(reader jspb.Reader) *CancelHoldRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &CancelHoldRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Id = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a CancelHoldRequest from a slice of bytes.
+func (m *CancelHoldRequest) Unmarshal(rawBytes []byte) (*CancelHoldRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// ListHoldsRequest is the input to the ListHolds method.
+type ListHoldsRequest struct {
+	// Member only lists the holds of the member with this ID, if set.
+	Member string
+	// Isbn is the ISBN-10 or ISBN-13, optionally with hyphens,
+	// of the book to list the holds of, if set.
+	Isbn string
+	// IncludeClosed also lists holds that have been
+	// fulfilled, cancelled or have expired.
+	IncludeClosed bool
+	// PageSize is the maximum number of holds to return.
+	// It defaults to 10, and may be at most 100.
+	PageSize int32
+	// PageToken is the NextPageToken of the previous response,
+	// to return the next page. The filters must be the same.
+	PageToken string
+}
+
+// GetMember gets the Member of the ListHoldsRequest.
+func (m *ListHoldsRequest) GetMember() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Member
+}
+
+// GetIsbn gets the Isbn of the ListHoldsRequest.
+func (m *ListHoldsRequest) GetIsbn() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Isbn
+}
+
+// GetIncludeClosed gets the IncludeClosed of the ListHoldsRequest.
+func (m *ListHoldsRequest) GetIncludeClosed() (x bool) {
+	if m == nil {
+		return x
+	}
+	return m.IncludeClosed
+}
+
+// GetPageSize gets the PageSize of the ListHoldsRequest.
+func (m *ListHoldsRequest) GetPageSize() (x int32) {
+	if m == nil {
+		return x
+	}
+	return m.PageSize
+}
+
+// GetPageToken gets the PageToken of the ListHoldsRequest.
+func (m *ListHoldsRequest) GetPageToken() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.PageToken
+}
+
+// MarshalToWriter marshals ListHoldsRequest to the provided writer.
+func (m *ListHoldsRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Member) > 0 {
+		writer.WriteString(1, m.Member)
+	}
+
+	if len(m.Isbn) > 0 {
+		writer.WriteString(2, m.Isbn)
+	}
+
+	if m.IncludeClosed {
+		writer.WriteBool(3, m.IncludeClosed)
+	}
+
+	if m.PageSize != 0 {
+		writer.WriteInt32(4, m.PageSize)
+	}
+
+	if len(m.PageToken) > 0 {
+		writer.WriteString(5, m.PageToken)
+	}
+
+	return
+}
+
+// Marshal marshals ListHoldsRequest to a slice of bytes.
+func (m *ListHoldsRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a ListHoldsRequest from the provided reader.
+func (m *ListHoldsRequest) UnmarshalFromReader(reader jspb.Reader) *ListHoldsRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &ListHoldsRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Member = reader.ReadString()
+		case 2:
+			m.Isbn = reader.ReadString()
+		case 3:
+			m.IncludeClosed = reader.ReadBool()
+		case 4:
+			m.PageSize = reader.ReadInt32()
+		case 5:
+			m.PageToken = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a ListHoldsRequest from a slice of bytes.
+func (m *ListHoldsRequest) Unmarshal(rawBytes []byte) (*ListHoldsRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// ListHoldsResponse is the output of the ListHolds method.
+type ListHoldsResponse struct {
+	// Holds is a page of holds, oldest first.
+	Holds []*Hold
+	// NextPageToken returns the next page when passed to ListHolds.
+	// It is empty on the last page.
+	NextPageToken string
+}
+
+// GetHolds gets the Holds of the ListHoldsResponse.
+func (m *ListHoldsResponse) GetHolds() (x []*Hold) {
+	if m == nil {
+		return x
+	}
+	return m.Holds
+}
+
+// GetNextPageToken gets the NextPageToken of the ListHoldsResponse.
+func (m *ListHoldsResponse) GetNextPageToken() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.NextPageToken
+}
+
+// MarshalToWriter marshals ListHoldsResponse to the provided writer.
+func (m *ListHoldsResponse) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	for _, msg := range m.Holds {
+		writer.WriteMessage(1, func() {
+			msg.MarshalToWriter(writer)
+		})
+	}
+
+	if len(m.NextPageToken) > 0 {
+		writer.WriteString(2, m.NextPageToken)
+	}
+
+	return
+}
+
+// Marshal marshals ListHoldsResponse to a slice of bytes.
+func (m *ListHoldsResponse) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a ListHoldsResponse from the provided reader.
+func (m *ListHoldsResponse) UnmarshalFromReader(reader jspb.Reader) *ListHoldsResponse {
+	for reader.Next() {
+		if m == nil {
+			m = &ListHoldsResponse{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			reader.ReadMessage(func() {
+				m.Holds = append(m.Holds, new(Hold).UnmarshalFromReader(reader))
+			})
+		case 2:
+			m.NextPageToken = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a ListHoldsResponse from a slice of bytes.
+func (m *ListHoldsResponse) Unmarshal(rawBytes []byte) (*ListHoldsResponse, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// WatchHoldsRequest is the input to the WatchHolds method.
+type WatchHoldsRequest struct {
+	// Member is the ID of the member whose holds to watch.
+	Member string
+}
+
+// GetMember gets the Member of the WatchHoldsRequest.
+func (m *WatchHoldsRequest) GetMember() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Member
+}
+
+// MarshalToWriter marshals WatchHoldsRequest to the provided writer.
+func (m *WatchHoldsRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Member) > 0 {
+		writer.WriteString(1, m.Member)
+	}
+
+	return
+}
+
+// Marshal marshals WatchHoldsRequest to a slice of bytes.
+func (m *WatchHoldsRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a WatchHoldsRequest from the provided reader.
+func (m *WatchHoldsRequest) UnmarshalFromReader(reader jspb.Reader) *WatchHoldsRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &WatchHoldsRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Member = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a WatchHoldsRequest from a slice of bytes.
+func (m *WatchHoldsRequest) Unmarshal(rawBytes []byte) (*WatchHoldsRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Branch is a branch of the library, where copies of Books are kept.
+type Branch struct {
+	// Id identifies the branch. It is set by the server.
+	Id string
+	// Name is the name of the branch.
+	Name string
+	// Address is the postal address of the branch.
+	Address string
+}
+
+// GetId gets the Id of the Branch.
+func (m *Branch) GetId() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Id
+}
+
+// GetName gets the Name of the Branch.
+func (m *Branch) GetName() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Name
+}
+
+// GetAddress gets the Address of the Branch.
+func (m *Branch) GetAddress() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Address
+}
+
+// MarshalToWriter marshals Branch to the provided writer.
+func (m *Branch) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Id) > 0 {
+		writer.WriteString(1, m.Id)
+	}
+
+	if len(m.Name) > 0 {
+		writer.WriteString(2, m.Name)
+	}
+
+	if len(m.Address) > 0 {
+		writer.WriteString(3, m.Address)
+	}
+
+	return
+}
+
+// Marshal marshals Branch to a slice of bytes.
+func (m *Branch) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a Branch from the provided reader.
+func (m *Branch) UnmarshalFromReader(reader jspb.Reader) *Branch {
+	for reader.Next() {
+		if m == nil {
+			m = &Branch{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Id = reader.ReadString()
+		case 2:
+			m.Name = reader.ReadString()
+		case 3:
+			m.Address = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a Branch from a slice of bytes.
+func (m *Branch) Unmarshal(rawBytes []byte) (*Branch, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Copy is a physical copy of a Book, kept at a Branch.
+type Copy struct {
+	// Barcode identifies the copy. It is printed on a label
+	// on the copy, and may only contain letters, digits and dashes.
+	Barcode string
+	// Isbn is the ISBN-13 of the book the copy is of.
+	Isbn string
+	// BranchId is the ID of the branch the copy is kept at.
+	// In transit copies are kept at the branch they were sent from.
+	BranchId string
+	// State is the state of the copy. It is set by the server.
+	State Copy_State
+	// DestinationBranchId is the ID of the branch an in transit
+	// copy is being transferred to. It is set by the server.
+	DestinationBranchId string
+	// TransferTime is when an in transit copy
+	// was sent. It is set by the server.
+	TransferTime *google_protobuf1.Timestamp
+}
+
+// GetBarcode gets the Barcode of the Copy.
+func (m *Copy) GetBarcode() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Barcode
+}
+
+// GetIsbn gets the Isbn of the Copy.
+func (m *Copy) GetIsbn() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Isbn
+}
+
+// GetBranchId gets the BranchId of the Copy.
+func (m *Copy) GetBranchId() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.BranchId
+}
+
+// GetState gets the State of the Copy.
+func (m *Copy) GetState() (x Copy_State) {
+	if m == nil {
+		return x
+	}
+	return m.State
+}
+
+// GetDestinationBranchId gets the DestinationBranchId of the Copy.
+func (m *Copy) GetDestinationBranchId() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.DestinationBranchId
+}
+
+// GetTransferTime gets the TransferTime of the Copy.
+func (m *Copy) GetTransferTime() (x *google_protobuf1.Timestamp) {
+	if m == nil {
+		return x
+	}
+	return m.TransferTime
+}
+
+// MarshalToWriter marshals Copy to the provided writer.
+func (m *Copy) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Barcode) > 0 {
+		writer.WriteString(1, m.Barcode)
+	}
+
+	if len(m.Isbn) > 0 {
+		writer.WriteString(2, m.Isbn)
+	}
+
+	if len(m.BranchId) > 0 {
+		writer.WriteString(3, m.BranchId)
+	}
+
+	if int(m.State) != 0 {
+		writer.WriteEnum(4, int(m.State))
+	}
+
+	if len(m.DestinationBranchId) > 0 {
+		writer.WriteString(5, m.DestinationBranchId)
+	}
+
+	if m.TransferTime != nil {
+		writer.WriteMessage(6, func() {
+			m.TransferTime.MarshalToWriter(writer)
+		})
+	}
+
+	return
+}
+
+// Marshal marshals Copy to a slice of bytes.
+func (m *Copy) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a Copy from the provided reader.
+func (m *Copy) UnmarshalFromReader(reader jspb.Reader) *Copy {
+	for reader.Next() {
+		if m == nil {
+			m = &Copy{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Barcode = reader.ReadString()
+		case 2:
+			m.Isbn = reader.ReadString()
+		case 3:
+			m.BranchId = reader.ReadString()
+		case 4:
+			m.State = Copy_State(reader.ReadEnum())
+		case 5:
+			m.DestinationBranchId = reader.ReadString()
+		case 6:
+			reader.ReadMessage(func() {
+				m.TransferTime = m.TransferTime.UnmarshalFromReader(reader)
+			})
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a Copy from a slice of bytes.
+func (m *Copy) Unmarshal(rawBytes []byte) (*Copy, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// CreateBranchRequest is the input to the CreateBranch method.
+type CreateBranchRequest struct {
+	// Branch is the branch to create.
+	Branch *Branch
+}
+
+// GetBranch gets the Branch of the CreateBranchRequest.
+func (m *CreateBranchRequest) GetBranch() (x *Branch) {
+	if m == nil {
+		return x
+	}
+	return m.Branch
+}
+
+// MarshalToWriter marshals CreateBranchRequest to the provided writer.
+func (m *CreateBranchRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if m.Branch != nil {
+		writer.WriteMessage(1, func() {
+			m.Branch.MarshalToWriter(writer)
+		})
+	}
+
+	return
+}
+
+// Marshal marshals CreateBranchRequest to a slice of bytes.
+func (m *CreateBranchRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a CreateBranchRequest from the provided reader.
+func (m *CreateBranchRequest) UnmarshalFromReader(reader jspb.Reader) *CreateBranchRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &CreateBranchRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			reader.ReadMessage(func() {
+				m.Branch = m.Branch.UnmarshalFromReader(reader)
+			})
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a CreateBranchRequest from a slice of bytes.
+func (m *CreateBranchRequest) Unmarshal(rawBytes []byte) (*CreateBranchRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// GetBranchRequest is the input to the GetBranch method.
+type GetBranchRequest struct {
+	// Id is the ID of the branch to return.
+	Id string
+}
+
+// GetId gets the Id of the GetBranchRequest.
+func (m *GetBranchRequest) GetId() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Id
+}
+
+// MarshalToWriter marshals GetBranchRequest to the provided writer.
+func (m *GetBranchRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Id) > 0 {
+		writer.WriteString(1, m.Id)
+	}
+
+	return
+}
+
+// Marshal marshals GetBranchRequest to a slice of bytes.
+func (m *GetBranchRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a GetBranchRequest from the provided reader.
+func (m *GetBranchRequest) UnmarshalFromReader(reader jspb.Reader) *GetBranchRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &GetBranchRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Id = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a GetBranchRequest from a slice of bytes.
+func (m *GetBranchRequest) Unmarshal(rawBytes []byte) (*GetBranchRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// ListBranchesRequest is the input to the ListBranches method.
+type ListBranchesRequest struct {
+}
+
+// MarshalToWriter marshals ListBranchesRequest to the provided writer.
+func (m *ListBranchesRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	return
+}
+
+// Marshal marshals ListBranchesRequest to a slice of bytes.
+func (m *ListBranchesRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a ListBranchesRequest from the provided reader.
+func (m *ListBranchesRequest) UnmarshalFromReader(reader jspb.Reader) *ListBranchesRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &ListBranchesRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a ListBranchesRequest from a slice of bytes.
+func (m *ListBranchesRequest) Unmarshal(rawBytes []byte) (*ListBranchesRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// ListBranchesResponse is the output of the ListBranches method.
+type ListBranchesResponse struct {
+	// Branches are the branches of the library,
+	// in the order they were created.
+	Branches []*Branch
+}
+
+// GetBranches gets the Branches of the ListBranchesResponse.
+func (m *ListBranchesResponse) GetBranches() (x []*Branch) {
+	if m == nil {
+		return x
+	}
+	return m.Branches
+}
+
+// MarshalToWriter marshals ListBranchesResponse to the provided writer.
+func (m *ListBranchesResponse) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	for _, msg := range m.Branches {
+		writer.WriteMessage(1, func() {
+			msg.MarshalToWriter(writer)
+		})
+	}
+
+	return
+}
+
+// Marshal marshals ListBranchesResponse to a slice of bytes.
+func (m *ListBranchesResponse) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a ListBranchesResponse from the provided reader.
+func (m *ListBranchesResponse) UnmarshalFromReader(reader jspb.Reader) *ListBranchesResponse {
+	for reader.Next() {
+		if m == nil {
+			m = &ListBranchesResponse{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			reader.ReadMessage(func() {
+				m.Branches = append(m.Branches, new(Branch).UnmarshalFromReader(reader))
+			})
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a ListBranchesResponse from a slice of bytes.
+func (m *ListBranchesResponse) Unmarshal(rawBytes []byte) (*ListBranchesResponse, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// AddCopyRequest is the input to the AddCopy method.
+type AddCopyRequest struct {
+	// Copy is the copy to add. Its barcode, ISBN and branch must be set.
+	// The ISBN may be an ISBN-10 or ISBN-13, optionally with hyphens.
+	Copy *Copy
+}
+
+// GetCopy gets the Copy of the AddCopyRequest.
+func (m *AddCopyRequest) GetCopy() (x *Copy) {
+	if m == nil {
+		return x
+	}
+	return m.Copy
+}
+
+// MarshalToWriter marshals AddCopyRequest to the provided writer.
+func (m *AddCopyRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if m.Copy != nil {
+		writer.WriteMessage(1, func() {
+			m.Copy.MarshalToWriter(writer)
+		})
+	}
+
+	return
+}
+
+// Marshal marshals AddCopyRequest to a slice of bytes.
+func (m *AddCopyRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a AddCopyRequest from the provided reader.
+func (m *AddCopyRequest) UnmarshalFromReader(reader jspb.Reader) *AddCopyRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &AddCopyRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			reader.ReadMessage(func() {
+				m.Copy = m.Copy.UnmarshalFromReader(reader)
+			})
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a AddCopyRequest from a slice of bytes.
+func (m *AddCopyRequest) Unmarshal(rawBytes []byte) (*AddCopyRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// GetCopyRequest is the input to the GetCopy method.
+type GetCopyRequest struct {
+	// Barcode is the barcode of the copy to return.
+	Barcode string
+}
+
+// GetBarcode gets the Barcode of the GetCopyRequest.
+func (m *GetCopyRequest) GetBarcode() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Barcode
+}
+
+// MarshalToWriter marshals GetCopyRequest to the provided writer.
+func (m *GetCopyRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Barcode) > 0 {
+		writer.WriteString(1, m.Barcode)
+	}
+
+	return
+}
+
+// Marshal marshals GetCopyRequest to a slice of bytes.
+func (m *GetCopyRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a GetCopyRequest from the provided reader.
+func (m *GetCopyRequest) UnmarshalFromReader(reader jspb.Reader) *GetCopyRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &GetCopyRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Barcode = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a GetCopyRequest from a slice of bytes.
+func (m *GetCopyRequest) Unmarshal(rawBytes []byte) (*GetCopyRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// GetAvailabilityRequest is the input to the GetAvailability method.
+type GetAvailabilityRequest struct {
+	// Isbn is the ISBN-10 or ISBN-13, optionally with hyphens,
+	// of the book to return the availability of.
+	Isbn string
+}
+
+// GetIsbn gets the Isbn of the GetAvailabilityRequest.
+func (m *GetAvailabilityRequest) GetIsbn() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Isbn
+}
+
+// MarshalToWriter marshals GetAvailabilityRequest to the provided writer.
+func (m *GetAvailabilityRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Isbn) > 0 {
+		writer.WriteString(1, m.Isbn)
+	}
+
+	return
+}
+
+// Marshal marshals GetAvailabilityRequest to a slice of bytes.
+func (m *GetAvailabilityRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a GetAvailabilityRequest from the provided reader.
+func (m *GetAvailabilityRequest) UnmarshalFromReader(reader jspb.Reader) *GetAvailabilityRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &GetAvailabilityRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Isbn = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a GetAvailabilityRequest from a slice of bytes.
+func (m *GetAvailabilityRequest) Unmarshal(rawBytes []byte) (*GetAvailabilityRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Availability is where the copies of a Book are.
+type Availability struct {
+	// Isbn is the ISBN-13 of the book.
+	Isbn string
+	// AvailableCopies is the number of copies of the book that
+	// can be checked out, across all branches. Copies without a
+	// barcode are not tied to a branch, so it may be more than
+	// the sum of the available copies of the branches.
+	AvailableCopies int32
+	// Branches is the availability of the book at each
+	// branch, in the order the branches were created.
+	Branches []*BranchAvailability
+}
+
+// GetIsbn gets the Isbn of the Availability.
+func (m *Availability) GetIsbn() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Isbn
+}
+
+// GetAvailableCopies gets the AvailableCopies of the Availability.
+func (m *Availability) GetAvailableCopies() (x int32) {
+	if m == nil {
+		return x
+	}
+	return m.AvailableCopies
+}
+
+// GetBranches gets the Branches of the Availability.
+func (m *Availability) GetBranches() (x []*BranchAvailability) {
+	if m == nil {
+		return x
+	}
+	return m.Branches
+}
+
+// MarshalToWriter marshals Availability to the provided writer.
+func (m *Availability) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Isbn) > 0 {
+		writer.WriteString(1, m.Isbn)
+	}
+
+	if m.AvailableCopies != 0 {
+		writer.WriteInt32(2, m.AvailableCopies)
+	}
+
+	for _, msg := range m.Branches {
+		writer.WriteMessage(3, func() {
+			msg.MarshalToWriter(writer)
+		})
+	}
+
+	return
+}
+
+// Marshal marshals Availability to a slice of bytes.
+func (m *Availability) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a Availability from the provided reader.
+func (m *Availability) UnmarshalFromReader(reader jspb.Reader) *Availability {
+	for reader.Next() {
+		if m == nil {
+			m = &Availability{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Isbn = reader.ReadString()
+		case 2:
+			m.AvailableCopies = reader.ReadInt32()
+		case 3:
+			reader.ReadMessage(func() {
+				m.Branches = append(m.Branches, new(BranchAvailability).UnmarshalFromReader(reader))
+			})
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a Availability from a slice of bytes.
+func (m *Availability) Unmarshal(rawBytes []byte) (*Availability, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// BranchAvailability is how many copies of a Book a Branch has.
+type BranchAvailability struct {
+	// Branch is the branch.
+	Branch *Branch
+	// Copies is the number of copies kept at the branch.
+	Copies int32
+	// AvailableCopies is the number of those copies that
+	// are not on loan, in transit or set aside for a hold.
+	AvailableCopies int32
+	// IncomingCopies is the number of copies in
+	// transit to the branch from other branches.
+	IncomingCopies int32
+}
+
+// GetBranch gets the Branch of the BranchAvailability.
+func (m *BranchAvailability) GetBranch() (x *Branch) {
+	if m == nil {
+		return x
+	}
+	return m.Branch
+}
+
+// GetCopies gets the Copies of the BranchAvailability.
+func (m *BranchAvailability) GetCopies() (x int32) {
+	if m == nil {
+		return x
+	}
+	return m.Copies
+}
+
+// GetAvailableCopies gets the AvailableCopies of the BranchAvailability.
+func (m *BranchAvailability) GetAvailableCopies() (x int32) {
+	if m == nil {
+		return x
+	}
+	return m.AvailableCopies
+}
+
+// GetIncomingCopies gets the IncomingCopies of the BranchAvailability.
+func (m *BranchAvailability) GetIncomingCopies() (x int32) {
+	if m == nil {
+		return x
+	}
+	return m.IncomingCopies
+}
+
+// MarshalToWriter marshals BranchAvailability to the provided writer.
+func (m *BranchAvailability) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if m.Branch != nil {
+		writer.WriteMessage(1, func() {
+			m.Branch.MarshalToWriter(writer)
+		})
+	}
+
+	if m.Copies != 0 {
+		writer.WriteInt32(2, m.Copies)
+	}
+
+	if m.AvailableCopies != 0 {
+		writer.WriteInt32(3, m.AvailableCopies)
+	}
+
+	if m.IncomingCopies != 0 {
+		writer.WriteInt32(4, m.IncomingCopies)
+	}
+
+	return
+}
+
+// Marshal marshals BranchAvailability to a slice of bytes.
+func (m *BranchAvailability) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a BranchAvailability from the provided reader.
+func (m *BranchAvailability) UnmarshalFromReader(reader jspb.Reader) *BranchAvailability {
+	for reader.Next() {
+		if m == nil {
+			m = &BranchAvailability{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			reader.ReadMessage(func() {
+				m.Branch = m.Branch.UnmarshalFromReader(reader)
+			})
+		case 2:
+			m.Copies = reader.ReadInt32()
+		case 3:
+			m.AvailableCopies = reader.ReadInt32()
+		case 4:
+			m.IncomingCopies = reader.ReadInt32()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a BranchAvailability from a slice of bytes.
+func (m *BranchAvailability) Unmarshal(rawBytes []byte) (*BranchAvailability, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// TransferCopyRequest is the input to the TransferCopy method.
+type TransferCopyRequest struct {
+	// Barcode is the barcode of the copy to transfer.
+	Barcode string
+	// BranchId is the ID of the branch to transfer the copy to.
+	BranchId string
+}
+
+// GetBarcode gets the Barcode of the TransferCopyRequest.
+func (m *TransferCopyRequest) GetBarcode() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Barcode
+}
+
+// GetBranchId gets the BranchId of the TransferCopyRequest.
+func (m *TransferCopyRequest) GetBranchId() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.BranchId
+}
+
+// MarshalToWriter marshals TransferCopyRequest to the provided writer.
+func (m *TransferCopyRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Barcode) > 0 {
+		writer.WriteString(1, m.Barcode)
+	}
+
+	if len(m.BranchId) > 0 {
+		writer.WriteString(2, m.BranchId)
+	}
+
+	return
+}
+
+// Marshal marshals TransferCopyRequest to a slice of bytes.
+func (m *TransferCopyRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a TransferCopyRequest from the provided reader.
+func (m *TransferCopyRequest) UnmarshalFromReader(reader jspb.Reader) *TransferCopyRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &TransferCopyRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Barcode = reader.ReadString()
+		case 2:
+			m.BranchId = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a TransferCopyRequest from a slice of bytes.
+func (m *TransferCopyRequest) Unmarshal(rawBytes []byte) (*TransferCopyRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// ReceiveCopyRequest is the input to the ReceiveCopy method.
+type ReceiveCopyRequest struct {
+	// Barcode is the barcode of the copy received.
+	Barcode string
+}
+
+// GetBarcode gets the Barcode of the ReceiveCopyRequest.
+func (m *ReceiveCopyRequest) GetBarcode() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Barcode
+}
+
+// MarshalToWriter marshals ReceiveCopyRequest to the provided writer.
+func (m *ReceiveCopyRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Barcode) > 0 {
+		writer.WriteString(1, m.Barcode)
+	}
+
+	return
+}
+
+// Marshal marshals ReceiveCopyRequest to a slice of bytes.
+func (m *ReceiveCopyRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a ReceiveCopyRequest from the provided reader.
+func (m *ReceiveCopyRequest) UnmarshalFromReader(reader jspb.Reader) *ReceiveCopyRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &ReceiveCopyRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Barcode = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a ReceiveCopyRequest from a slice of bytes.
+func (m *ReceiveCopyRequest) Unmarshal(rawBytes []byte) (*ReceiveCopyRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// BookMessage is used to discuss books
+type BookMessage struct {
+	// Types that are valid to be assigned to Content:
+	//	*BookMessage_Name
+	//	*BookMessage_Message
+	//	*BookMessage_MemberId
+	Content isBookMessage_Content
+}
+
+// isBookMessage_Content is used to distinguish types assignable to Content
+type isBookMessage_Content interface{ isBookMessage_Content() }
+
+// BookMessage_Name is assignable to Content
+type BookMessage_Name struct {
+	// Name was the name of the person sending messages.
+	// It is no longer accepted, send the MemberId instead.
+	Name string
+}
+
+// BookMessage_Message is assignable to Content
+type BookMessage_Message struct {
+	// Message is any message the user wishes to send.
+	Message string
+}
+
+// BookMessage_MemberId is assignable to Content
+type BookMessage_MemberId struct {
+	// MemberId is the ID of the member sending messages, whose
+	// name is shown with them. It should be sent as the first
+	// message on the stream.
+	MemberId string
+}
+
+func (*BookMessage_Name) isBookMessage_Content()     {}
+func (*BookMessage_Message) isBookMessage_Content()  {}
+func (*BookMessage_MemberId) isBookMessage_Content() {}
+
+// GetContent gets the Content of the BookMessage.
+func (m *BookMessage) GetContent() (x isBookMessage_Content) {
+	if m == nil {
+		return x
+	}
+	return m.Content
+}
+
+// GetName gets the Name of the BookMessage.
+func (m *BookMessage) GetName() (x string) {
+	if v, ok := m.GetContent().(*BookMessage_Name); ok {
+		return v.Name
+	}
+	return x
+}
+
+// GetMessage gets the Message of the BookMessage.
+func (m *BookMessage) GetMessage() (x string) {
+	if v, ok := m.GetContent().(*BookMessage_Message); ok {
+		return v.Message
+	}
+	return x
+}
+
+// GetMemberId gets the MemberId of the BookMessage.
+func (m *BookMessage) GetMemberId() (x string) {
+	if v, ok := m.GetContent().(*BookMessage_MemberId); ok {
+		return v.MemberId
+	}
+	return x
+}
+
+// MarshalToWriter marshals BookMessage to the provided writer.
+func (m *BookMessage) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	switch t := m.Content.(type) {
+	case *BookMessage_Name:
+		if len(t.Name) > 0 {
+			writer.WriteString(1, t.Name)
+		}
+	case *BookMessage_Message:
+		if len(t.Message) > 0 {
+			writer.WriteString(2, t.Message)
+		}
+	case *BookMessage_MemberId:
+		if len(t.MemberId) > 0 {
+			writer.WriteString(3, t.MemberId)
+		}
+	}
+
+	return
+}
+
+// Marshal marshals BookMessage to a slice of bytes.
+func (m *BookMessage) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a BookMessage from the provided reader.
+func (m *BookMessage) UnmarshalFromReader(reader jspb.Reader) *BookMessage {
+	for reader.Next() {
+		if m == nil {
+			m = &BookMessage{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Content = &BookMessage_Name{
+				Name: reader.ReadString(),
+			}
+		case 2:
+			m.Content = &BookMessage_Message{
+				Message: reader.ReadString(),
+			}
+		case 3:
+			m.Content = &BookMessage_MemberId{
+				MemberId: reader.ReadString(),
+			}
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a BookMessage from a slice of bytes.
+func (m *BookMessage) Unmarshal(rawBytes []byte) (*BookMessage, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// BookResponse is used to discuss books
+type BookResponse struct {
+	// Message is a message from a user.
+	Message string
+}
+
+// GetMessage gets the Message of the BookResponse.
+func (m *BookResponse) GetMessage() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Message
+}
+
+// MarshalToWriter marshals BookResponse to the provided writer.
+func (m *BookResponse) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Message) > 0 {
+		writer.WriteString(2, m.Message)
+	}
+
+	return
+}
+
+// Marshal marshals BookResponse to a slice of bytes.
+func (m *BookResponse) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a BookResponse from the provided reader.
+func (m *BookResponse) UnmarshalFromReader(reader jspb.Reader) *BookResponse {
+	for reader.Next() {
+		if m == nil {
+			m = &BookResponse{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 2:
+			m.Message = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a BookResponse from a slice of bytes.
+func (m *BookResponse) Unmarshal(rawBytes []byte) (*BookResponse, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Review is a member's review of a Book.
+type Review struct {
+	// Id identifies the review. It is set by the server.
+	Id string
+	// Isbn is the ISBN-13 of the book reviewed. An ISBN-10 or ISBN-13,
+	// optionally with hyphens, is accepted when creating a review.
+	Isbn string
+	// Member is the ID of the member who wrote the review.
+	Member string
+	// Rating is the rating of the book, from 1 to 5 stars.
+	Rating int32
+	// Text is the text of the review.
+	Text string
+	// CreateTime is when the review was written.
+	CreateTime *google_protobuf1.Timestamp
+}
+
+// GetId gets the Id of the Review.
+func (m *Review) GetId() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Id
+}
+
+// GetIsbn gets the Isbn of the Review.
+func (m *Review) GetIsbn() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Isbn
+}
+
+// GetMember gets the Member of the Review.
+func (m *Review) GetMember() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Member
+}
+
+// GetRating gets the Rating of the Review.
+func (m *Review) GetRating() (x int32) {
+	if m == nil {
+		return x
+	}
+	return m.Rating
+}
+
+// GetText gets the Text of the Review.
+func (m *Review) GetText() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Text
+}
+
+// GetCreateTime gets the CreateTime of the Review.
+func (m *Review) GetCreateTime() (x *google_protobuf1.Timestamp) {
+	if m == nil {
+		return x
+	}
+	return m.CreateTime
+}
+
+// MarshalToWriter marshals Review to the provided writer.
+func (m *Review) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Id) > 0 {
+		writer.WriteString(1, m.Id)
+	}
+
+	if len(m.Isbn) > 0 {
+		writer.WriteString(2, m.Isbn)
+	}
+
+	if len(m.Member) > 0 {
+		writer.WriteString(3, m.Member)
+	}
+
+	if m.Rating != 0 {
+		writer.WriteInt32(4, m.Rating)
+	}
+
+	if len(m.Text) > 0 {
+		writer.WriteString(5, m.Text)
+	}
+
+	if m.CreateTime != nil {
+		writer.WriteMessage(6, func() {
+			m.CreateTime.MarshalToWriter(writer)
+		})
+	}
+
+	return
+}
+
+// Marshal marshals Review to a slice of bytes.
+func (m *Review) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a Review from the provided reader.
+func (m *Review) UnmarshalFromReader(reader jspb.Reader) *Review {
+	for reader.Next() {
+		if m == nil {
+			m = &Review{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Id = reader.ReadString()
+		case 2:
+			m.Isbn = reader.ReadString()
+		case 3:
+			m.Member = reader.ReadString()
+		case 4:
+			m.Rating = reader.ReadInt32()
+		case 5:
+			m.Text = reader.ReadString()
+		case 6:
+			reader.ReadMessage(func() {
+				m.CreateTime = m.CreateTime.UnmarshalFromReader(reader)
+			})
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a Review from a slice of bytes.
+func (m *Review) Unmarshal(rawBytes []byte) (*Review, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// CreateReviewRequest is the input to the CreateReview method.
+type CreateReviewRequest struct {
+	// Review is the review to create.
+	Review *Review
+}
+
+// GetReview gets the Review of the CreateReviewRequest.
+func (m *CreateReviewRequest) GetReview() (x *Review) {
+	if m == nil {
+		return x
+	}
+	return m.Review
+}
+
+// MarshalToWriter marshals CreateReviewRequest to the provided writer.
+func (m *CreateReviewRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if m.Review != nil {
+		writer.WriteMessage(1, func() {
+			m.Review.MarshalToWriter(writer)
+		})
+	}
+
+	return
+}
+
+// Marshal marshals CreateReviewRequest to a slice of bytes.
+func (m *CreateReviewRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a CreateReviewRequest from the provided reader.
+func (m *CreateReviewRequest) UnmarshalFromReader(reader jspb.Reader) *CreateReviewRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &CreateReviewRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			reader.ReadMessage(func() {
+				m.Review = m.Review.UnmarshalFromReader(reader)
+			})
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a CreateReviewRequest from a slice of bytes.
+func (m *CreateReviewRequest) Unmarshal(rawBytes []byte) (*CreateReviewRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// ListReviewsRequest is the input to the ListReviews method.
+type ListReviewsRequest struct {
+	// Isbn is the ISBN-10 or ISBN-13, optionally with hyphens,
+	// of the book to list the reviews of, if set.
+	Isbn string
+	// Member only lists the reviews of the member with this ID, if set.
+	Member string
+	// PageSize is the maximum number of reviews to return.
+	// It defaults to 10, and may be at most 100.
+	PageSize int32
+	// PageToken is the NextPageToken of the previous response,
+	// to return the next page. The filters must be the same.
+	PageToken string
+}
+
+// GetIsbn gets the Isbn of the ListReviewsRequest.
+func (m *ListReviewsRequest) GetIsbn() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Isbn
+}
+
+// GetMember gets the Member of the ListReviewsRequest.
+func (m *ListReviewsRequest) GetMember() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Member
+}
+
+// GetPageSize gets the PageSize of the ListReviewsRequest.
+func (m *ListReviewsRequest) GetPageSize() (x int32) {
+	if m == nil {
+		return x
+	}
+	return m.PageSize
+}
+
+// GetPageToken gets the PageToken of the ListReviewsRequest.
+func (m *ListReviewsRequest) GetPageToken() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.PageToken
+}
+
+// MarshalToWriter marshals ListReviewsRequest to the provided writer.
+func (m *ListReviewsRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Isbn) > 0 {
+		writer.WriteString(1, m.Isbn)
+	}
+
+	if len(m.Member) > 0 {
+		writer.WriteString(2, m.Member)
+	}
+
+	if m.PageSize != 0 {
+		writer.WriteInt32(3, m.PageSize)
+	}
+
+	if len(m.PageToken) > 0 {
+		writer.WriteString(4, m.PageToken)
+	}
+
+	return
+}
+
+// Marshal marshals ListReviewsRequest to a slice of bytes.
+func (m *ListReviewsRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a ListReviewsRequest from the provided reader.
+func (m *ListReviewsRequest) UnmarshalFromReader(reader jspb.Reader) *ListReviewsRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &ListReviewsRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Isbn = reader.ReadString()
+		case 2:
+			m.Member = reader.ReadString()
+		case 3:
+			m.PageSize = reader.ReadInt32()
+		case 4:
+			m.PageToken = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a ListReviewsRequest from a slice of bytes.
+func (m *ListReviewsRequest) Unmarshal(rawBytes []byte) (*ListReviewsRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// ListReviewsResponse is the output of the ListReviews method.
+type ListReviewsResponse struct {
+	// Reviews is a page of reviews, newest first.
+	Reviews []*Review
+	// NextPageToken returns the next page when passed to ListReviews.
+	// It is empty on the last page.
+	NextPageToken string
+}
+
+// GetReviews gets the Reviews of the ListReviewsResponse.
+func (m *ListReviewsResponse) GetReviews() (x []*Review) {
+	if m == nil {
+		return x
+	}
+	return m.Reviews
+}
+
+// GetNextPageToken gets the NextPageToken of the ListReviewsResponse.
+func (m *ListReviewsResponse) GetNextPageToken() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.NextPageToken
+}
+
+// MarshalToWriter marshals ListReviewsResponse to the provided writer.
+func (m *ListReviewsResponse) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	for _, msg := range m.Reviews {
+		writer.WriteMessage(1, func() {
+			msg.MarshalToWriter(writer)
+		})
+	}
+
+	if len(m.NextPageToken) > 0 {
+		writer.WriteString(2, m.NextPageToken)
+	}
+
+	return
+}
+
+// Marshal marshals ListReviewsResponse to a slice of bytes.
+func (m *ListReviewsResponse) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a ListReviewsResponse from the provided reader.
+func (m *ListReviewsResponse) UnmarshalFromReader(reader jspb.Reader) *ListReviewsResponse {
+	for reader.Next() {
+		if m == nil {
+			m = &ListReviewsResponse{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			reader.ReadMessage(func() {
+				m.Reviews = append(m.Reviews, new(Review).UnmarshalFromReader(reader))
+			})
+		case 2:
+			m.NextPageToken = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a ListReviewsResponse from a slice of bytes.
+func (m *ListReviewsResponse) Unmarshal(rawBytes []byte) (*ListReviewsResponse, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// DeleteReviewRequest is the input to the DeleteReview method.
+type DeleteReviewRequest struct {
+	// Id is the ID of the review to delete.
+	Id string
+	// Member is the ID of the member deleting the review,
+	// who must be the member who wrote it.
+	Member string
+}
+
+// GetId gets the Id of the DeleteReviewRequest.
+func (m *DeleteReviewRequest) GetId() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Id
+}
+
+// GetMember gets the Member of the DeleteReviewRequest.
+func (m *DeleteReviewRequest) GetMember() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Member
+}
+
+// MarshalToWriter marshals DeleteReviewRequest to the provided writer.
+func (m *DeleteReviewRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Id) > 0 {
+		writer.WriteString(1, m.Id)
+	}
+
+	if len(m.Member) > 0 {
+		writer.WriteString(2, m.Member)
+	}
+
+	return
+}
+
+// Marshal marshals DeleteReviewRequest to a slice of bytes.
+func (m *DeleteReviewRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a DeleteReviewRequest from the provided reader.
+func (m *DeleteReviewRequest) UnmarshalFromReader(reader jspb.Reader) *DeleteReviewRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &DeleteReviewRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Id = reader.ReadString()
+		case 2:
+			m.Member = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a DeleteReviewRequest from a slice of bytes.
+func (m *DeleteReviewRequest) Unmarshal(rawBytes []byte) (*DeleteReviewRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Member is a member of the library.
+type Member struct {
+	// Id identifies the member. It is set by the server.
+	Id string
+	// CardNumber is the number on the library card of the member.
+	// It is set by the server.
+	CardNumber string
+	// Name is the name of the member.
+	Name string
+	// Email is the email address of the member.
+	Email string
+	// Phone is the phone number of the member.
+	Phone string
+	// BorrowingLimit is the number of books the member
+	// may have on loan at once. It defaults to 5.
+	BorrowingLimit int32
+	// State is the state of the membership. It is set by the server.
+	State Member_State
+	// SuspensionReason is why a suspended member was suspended.
+	SuspensionReason string
+	// CreateTime is when the member registered.
+	CreateTime *google_protobuf1.Timestamp
+}
+
+// GetId gets the Id of the Member.
+func (m *Member) GetId() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Id
+}
+
+// GetCardNumber gets the CardNumber of the Member.
+func (m *Member) GetCardNumber() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.CardNumber
+}
+
+// GetName gets the Name of the Member.
+func (m *Member) GetName() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Name
+}
+
+// GetEmail gets the Email of the Member.
+func (m *Member) GetEmail() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Email
+}
+
+// GetPhone gets the Phone of the Member.
+func (m *Member) GetPhone() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Phone
+}
+
+// GetBorrowingLimit gets the BorrowingLimit of the Member.
+func (m *Member) GetBorrowingLimit() (x int32) {
+	if m == nil {
+		return x
+	}
+	return m.BorrowingLimit
+}
+
+// GetState gets the State of the Member.
+func (m *Member) GetState() (x Member_State) {
+	if m == nil {
+		return x
+	}
+	return m.State
+}
+
+// GetSuspensionReason gets the SuspensionReason of the Member.
+func (m *Member) GetSuspensionReason() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.SuspensionReason
+}
+
+// GetCreateTime gets the CreateTime of the Member.
+func (m *Member) GetCreateTime() (x *google_protobuf1.Timestamp) {
+	if m == nil {
+		return x
+	}
+	return m.CreateTime
+}
+
+// MarshalToWriter marshals Member to the provided writer.
+func (m *Member) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Id) > 0 {
+		writer.WriteString(1, m.Id)
+	}
+
+	if len(m.CardNumber) > 0 {
+		writer.WriteString(2, m.CardNumber)
+	}
+
+	if len(m.Name) > 0 {
+		writer.WriteString(3, m.Name)
+	}
+
+	if len(m.Email) > 0 {
+		writer.WriteString(4, m.Email)
+	}
+
+	if len(m.Phone) > 0 {
+		writer.WriteString(5, m.Phone)
+	}
+
+	if m.BorrowingLimit != 0 {
+		writer.WriteInt32(6, m.BorrowingLimit)
+	}
+
+	if int(m.State) != 0 {
+		writer.WriteEnum(7, int(m.State))
+	}
+
+	if len(m.SuspensionReason) > 0 {
+		writer.WriteString(8, m.SuspensionReason)
+	}
+
+	if m.CreateTime != nil {
+		writer.WriteMessage(9, func() {
+			m.CreateTime.MarshalToWriter(writer)
+		})
+	}
+
+	return
+}
+
+// Marshal marshals Member to a slice of bytes.
+func (m *Member) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a Member from the provided reader.
+func (m *Member) UnmarshalFromReader(reader jspb.Reader) *Member {
+	for reader.Next() {
+		if m == nil {
+			m = &Member{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Id = reader.ReadString()
+		case 2:
+			m.CardNumber = reader.ReadString()
+		case 3:
+			m.Name = reader.ReadString()
+		case 4:
+			m.Email = reader.ReadString()
+		case 5:
+			m.Phone = reader.ReadString()
+		case 6:
+			m.BorrowingLimit = reader.ReadInt32()
+		case 7:
+			m.State = Member_State(reader.ReadEnum())
+		case 8:
+			m.SuspensionReason = reader.ReadString()
+		case 9:
+			reader.ReadMessage(func() {
+				m.CreateTime = m.CreateTime.UnmarshalFromReader(reader)
+			})
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a Member from a slice of bytes.
+func (m *Member) Unmarshal(rawBytes []byte) (*Member, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// RegisterMemberRequest is the input to the RegisterMember method.
+type RegisterMemberRequest struct {
+	// Member is the profile of the member to register.
+	Member *Member
+}
+
+// GetMember gets the Member of the RegisterMemberRequest.
+func (m *RegisterMemberRequest) GetMember() (x *Member) {
+	if m == nil {
+		return x
+	}
+	return m.Member
+}
+
+// MarshalToWriter marshals RegisterMemberRequest to the provided writer.
+func (m *RegisterMemberRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if m.Member != nil {
+		writer.WriteMessage(1, func() {
+			m.Member.MarshalToWriter(writer)
+		})
+	}
+
+	return
+}
+
+// Marshal marshals RegisterMemberRequest to a slice of bytes.
+func (m *RegisterMemberRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a RegisterMemberRequest from the provided reader.
+func (m *RegisterMemberRequest) UnmarshalFromReader(reader jspb.Reader) *RegisterMemberRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &RegisterMemberRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			reader.ReadMessage(func() {
+				m.Member = m.Member.UnmarshalFromReader(reader)
+			})
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a RegisterMemberRequest from a slice of bytes.
+func (m *RegisterMemberRequest) Unmarshal(rawBytes []byte) (*RegisterMemberRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// GetMemberRequest is the input to the GetMember method.
+// Either the ID or the card number must be set.
+type GetMemberRequest struct {
+	// Id is the ID of the member to return.
+	Id string
+	// CardNumber is the card number of the member to return.
+	CardNumber string
+}
+
+// GetId gets the Id of the GetMemberRequest.
+func (m *GetMemberRequest) GetId() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Id
+}
+
+// GetCardNumber gets the CardNumber of the GetMemberRequest.
+func (m *GetMemberRequest) GetCardNumber() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.CardNumber
+}
+
+// MarshalToWriter marshals GetMemberRequest to the provided writer.
+func (m *GetMemberRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Id) > 0 {
+		writer.WriteString(1, m.Id)
+	}
+
+	if len(m.CardNumber) > 0 {
+		writer.WriteString(2, m.CardNumber)
+	}
+
+	return
+}
+
+// Marshal marshals GetMemberRequest to a slice of bytes.
+func (m *GetMemberRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a GetMemberRequest from the provided reader.
+func (m *GetMemberRequest) UnmarshalFromReader(reader jspb.Reader) *GetMemberRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &GetMemberRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Id = reader.ReadString()
+		case 2:
+			m.CardNumber = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a GetMemberRequest from a slice of bytes.
+func (m *GetMemberRequest) Unmarshal(rawBytes []byte) (*GetMemberRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// UpdateMemberRequest is the input to the UpdateMember method.
+type UpdateMemberRequest struct {
+	// Member is the member to update, identified by its ID.
+	Member *Member
+	// UpdateMask selects the fields of the member to update,
+	// which may be name, email, phone and borrowing_limit.
+	// If empty, all of these fields are updated.
+	UpdateMask *google_protobuf.FieldMask
+}
+
+// GetMember gets the Member of the UpdateMemberRequest.
+func (m *UpdateMemberRequest) GetMember() (x *Member) {
+	if m == nil {
+		return x
+	}
+	return m.Member
+}
+
+// GetUpdateMask gets the UpdateMask of the UpdateMemberRequest.
+func (m *UpdateMemberRequest) GetUpdateMask() (x *google_protobuf.FieldMask) {
+	if m == nil {
+		return x
+	}
+	return m.UpdateMask
+}
+
+// MarshalToWriter marshals UpdateMemberRequest to the provided writer.
+func (m *UpdateMemberRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if m.Member != nil {
+		writer.WriteMessage(1, func() {
+			m.Member.MarshalToWriter(writer)
+		})
+	}
+
+	if m.UpdateMask != nil {
+		writer.WriteMessage(2, func() {
+			m.UpdateMask.MarshalToWriter(writer)
+		})
+	}
+
+	return
+}
+
+// Marshal marshals UpdateMemberRequest to a slice of bytes.
+func (m *UpdateMemberRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a UpdateMemberRequest from the provided reader.
+func (m *UpdateMemberRequest) UnmarshalFromReader(reader jspb.Reader) *UpdateMemberRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &UpdateMemberRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			reader.ReadMessage(func() {
+				m.Member = m.Member.UnmarshalFromReader(reader)
+			})
+		case 2:
+			reader.ReadMessage(func() {
+				m.UpdateMask = m.UpdateMask.UnmarshalFromReader(reader)
+			})
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a UpdateMemberRequest from a slice of bytes.
+func (m *UpdateMemberRequest) Unmarshal(rawBytes []byte) (*UpdateMemberRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// SuspendMemberRequest is the input to the SuspendMember method.
+type SuspendMemberRequest struct {
+	// Id is the ID of the member to suspend.
+	Id string
+	// Reason is why the member is suspended.
+	Reason string
+}
+
+// GetId gets the Id of the SuspendMemberRequest.
+func (m *SuspendMemberRequest) GetId() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Id
+}
+
+// GetReason gets the Reason of the SuspendMemberRequest.
+func (m *SuspendMemberRequest) GetReason() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Reason
+}
+
+// MarshalToWriter marshals SuspendMemberRequest to the provided writer.
+func (m *SuspendMemberRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Id) > 0 {
+		writer.WriteString(1, m.Id)
+	}
+
+	if len(m.Reason) > 0 {
+		writer.WriteString(2, m.Reason)
+	}
+
+	return
+}
+
+// Marshal marshals SuspendMemberRequest to a slice of bytes.
+func (m *SuspendMemberRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a SuspendMemberRequest from the provided reader.
+func (m *SuspendMemberRequest) UnmarshalFromReader(reader jspb.Reader) *SuspendMemberRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &SuspendMemberRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Id = reader.ReadString()
+		case 2:
+			m.Reason = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a SuspendMemberRequest from a slice of bytes.
+func (m *SuspendMemberRequest) Unmarshal(rawBytes []byte) (*SuspendMemberRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// ReinstateMemberRequest is the input to the ReinstateMember method.
+type ReinstateMemberRequest struct {
+	// Id is the ID of the member to reinstate.
+	Id string
+}
+
+// GetId gets the Id of the ReinstateMemberRequest.
+func (m *ReinstateMemberRequest) GetId() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Id
+}
+
+// MarshalToWriter marshals ReinstateMemberRequest to the provided writer.
+func (m *ReinstateMemberRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Id) > 0 {
+		writer.WriteString(1, m.Id)
+	}
+
+	return
+}
+
+// Marshal marshals ReinstateMemberRequest to a slice of bytes.
+func (m *ReinstateMemberRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a ReinstateMemberRequest from the provided reader.
+func (m *ReinstateMemberRequest) UnmarshalFromReader(reader jspb.Reader) *ReinstateMemberRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &ReinstateMemberRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Id = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a ReinstateMemberRequest from a slice of bytes.
+func (m *ReinstateMemberRequest) Unmarshal(rawBytes []byte) (*ReinstateMemberRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// DeleteMemberRequest is the input to the DeleteMember method.
+type DeleteMemberRequest struct {
+	// Id is the ID of the member to delete.
+	Id string
+}
+
+// GetId gets the Id of the DeleteMemberRequest.
+func (m *DeleteMemberRequest) GetId() (x string) {
+	if m == nil {
+		return x
+	}
+	return m.Id
+}
+
+// MarshalToWriter marshals DeleteMemberRequest to the provided writer.
+func (m *DeleteMemberRequest) MarshalToWriter(writer jspb.Writer) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Id) > 0 {
+		writer.WriteString(1, m.Id)
+	}
+
+	return
+}
+
+// Marshal marshals DeleteMemberRequest to a slice of bytes.
+func (m *DeleteMemberRequest) Marshal() []byte {
+	writer := jspb.NewWriter()
+	m.MarshalToWriter(writer)
+	return writer.GetResult()
+}
+
+// UnmarshalFromReader unmarshals a DeleteMemberRequest from the provided reader.
+func (m *DeleteMemberRequest) UnmarshalFromReader(reader jspb.Reader) *DeleteMemberRequest {
+	for reader.Next() {
+		if m == nil {
+			m = &DeleteMemberRequest{}
+		}
+
+		switch reader.GetFieldNumber() {
+		case 1:
+			m.Id = reader.ReadString()
+		default:
+			reader.SkipField()
+		}
+	}
+
+	return m
+}
+
+// Unmarshal unmarshals a DeleteMemberRequest from a slice of bytes.
+func (m *DeleteMemberRequest) Unmarshal(rawBytes []byte) (*DeleteMemberRequest, error) {
+	reader := jspb.NewReader(rawBytes)
+
+	m = m.UnmarshalFromReader(reader)
+
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpcweb.Client
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpcweb package it is being compiled against.
+const _ = grpcweb.GrpcWebPackageIsVersion3
+
+// Client API for BookService service
+
+// BookService exposes GetBook and QueryBooks,
+// which allow querying of the library.
+type BookServiceClient interface {
+	// GetBook returns a Book from the library
+	// that matches the ISBN provided, if found.
+	// Otherwise it returns a NotFound error.
+	GetBook(ctx context.Context, in *GetBookRequest, opts ...grpcweb.CallOption) (*Book, error)
+	// QueryBooks returns all Books matching the
+	// filters provided, as a stream of Books.
+	// It returns an InvalidArgument error if the
+	// filters are invalid.
+	QueryBooks(ctx context.Context, in *QueryBooksRequest, opts ...grpcweb.CallOption) (BookService_QueryBooksClient, error)
+	// ListBooks returns a page of Books matching the filter
+	// provided, in the order requested. Pages are stable as
+	// Books are added and removed between calls.
+	ListBooks(ctx context.Context, in *ListBooksRequest, opts ...grpcweb.CallOption) (*ListBooksResponse, error)
+	// SearchBooks returns the Books whose title, author or
+	// publisher match the query provided, most relevant first.
+	// Matching ignores case and diacritics.
+	SearchBooks(ctx context.Context, in *SearchBooksRequest, opts ...grpcweb.CallOption) (*SearchBooksResponse, error)
+	// RecommendBooks returns Books similar to a Book, or to the Books a
+	// member has borrowed and collected, best first. Similarity is learnt from
+	// the Collections and Loans of the library. When there is too little of
+	// either, Books by the same author and of the same type are recommended.
+	// It returns a NotFound error if the Book or member does not exist.
+	RecommendBooks(ctx context.Context, in *RecommendBooksRequest, opts ...grpcweb.CallOption) (*RecommendBooksResponse, error)
+	// CreateBook adds a Book to the library.
+	// It returns an AlreadyExists error if a Book
+	// with the same ISBN is already in the library.
+	CreateBook(ctx context.Context, in *CreateBookRequest, opts ...grpcweb.CallOption) (*Book, error)
+	// UpdateBook updates the fields of a Book in the library
+	// selected by the update mask, and returns the updated Book.
+	// It returns a NotFound error if the Book does not exist,
+	// and an Aborted error if the etag does not match.
+	UpdateBook(ctx context.Context, in *UpdateBookRequest, opts ...grpcweb.CallOption) (*Book, error)
+	// DeleteBook removes a Book from the library
+	// and returns the removed Book. Deleted Books
+	// can be restored with RestoreBook.
+	// It returns a NotFound error if the Book does not exist,
+	// an Aborted error if the etag does not match, and a
+	// FailedPrecondition error if any copies are on loan or on hold,
+	// or translations of the Book are in the library.
+	DeleteBook(ctx context.Context, in *DeleteBookRequest, opts ...grpcweb.CallOption) (*Book, error)
+	// RestoreBook restores a deleted Book and returns it.
+	// It returns a NotFound error if no such deleted Book exists.
+	RestoreBook(ctx context.Context, in *RestoreBookRequest, opts ...grpcweb.CallOption) (*Book, error)
+	// ListBookRevisions returns the revision history of
+	// a Book, newest first, including any deletions. Changes to only the
+	// copies, available copies, average rating and review count of the
+	// Book are not revisions.
+	// It returns a NotFound error if the Book never existed.
+	ListBookRevisions(ctx context.Context, in *ListBookRevisionsRequest, opts ...grpcweb.CallOption) (*ListBookRevisionsResponse, error)
+	// CreateAuthor adds an Author to the library and returns it.
+	CreateAuthor(ctx context.Context, in *CreateAuthorRequest, opts ...grpcweb.CallOption) (*Author, error)
+	// GetAuthor returns the Author with the ID provided.
+	// It returns a NotFound error if the Author does not exist.
+	GetAuthor(ctx context.Context, in *GetAuthorRequest, opts ...grpcweb.CallOption) (*Author, error)
+	// UpdateAuthor updates the fields of an Author selected by the
+	// update mask, and returns the updated Author. Renaming an Author
+	// updates the author names of their Books.
+	// It returns a NotFound error if the Author does not exist.
+	UpdateAuthor(ctx context.Context, in *UpdateAuthorRequest, opts ...grpcweb.CallOption) (*Author, error)
+	// ListAuthorBooks returns a page of the Books of
+	// an Author, by publication date, oldest first.
+	// It returns a NotFound error if the Author does not exist.
+	ListAuthorBooks(ctx context.Context, in *ListAuthorBooksRequest, opts ...grpcweb.CallOption) (*ListAuthorBooksResponse, error)
+	// UploadCover takes a stream of chunks of an image, and sets it
+	// as the cover of a Book. It returns the Book with its new cover URL.
+	// It returns a NotFound error if the Book does not exist, an Aborted
+	// error if the etag does not match, and an InvalidArgument error
+	// if the image is too large or not in a supported format.
+	UploadCover(ctx context.Context, opts ...grpcweb.CallOption) (BookService_UploadCoverClient, error)
+	// CreateWork adds a Work to the library and returns it. Books
+	// are made editions of a Work by setting their work ID.
+	CreateWork(ctx context.Context, in *CreateWorkRequest, opts ...grpcweb.CallOption) (*Work, error)
+	// GetWork returns the Work with the ID provided.
+	// It returns a NotFound error if the Work does not exist.
+	GetWork(ctx context.Context, in *GetWorkRequest, opts ...grpcweb.CallOption) (*Work, error)
+	// UpdateWork updates the fields of a Work selected by the
+	// update mask, and returns the updated Work.
+	// It returns a NotFound error if the Work does not exist.
+	UpdateWork(ctx context.Context, in *UpdateWorkRequest, opts ...grpcweb.CallOption) (*Work, error)
+	// ListEditions returns the Books that are editions of a Work,
+	// such as its hardcover, paperback and audiobook.
+	// It returns a NotFound error if the Work does not exist.
+	ListEditions(ctx context.Context, in *ListEditionsRequest, opts ...grpcweb.CallOption) (*ListEditionsResponse, error)
+	// CreateSeries adds a Series to the library and returns it. Works
+	// are added to a Series by setting their series ID and number.
+	CreateSeries(ctx context.Context, in *CreateSeriesRequest, opts ...grpcweb.CallOption) (*Series, error)
+	// GetSeries returns the Series with the ID provided.
+	// It returns a NotFound error if the Series does not exist.
+	GetSeries(ctx context.Context, in *GetSeriesRequest, opts ...grpcweb.CallOption) (*Series, error)
+	// ListSeriesWorks returns the Works of a Series, in order.
+	// It returns a NotFound error if the Series does not exist.
+	ListSeriesWorks(ctx context.Context, in *ListSeriesWorksRequest, opts ...grpcweb.CallOption) (*ListSeriesWorksResponse, error)
+	// CreateSubject adds a Subject to the subject taxonomy and returns it.
+	// It returns an AlreadyExists error if a Subject with the same code exists.
+	CreateSubject(ctx context.Context, in *CreateSubjectRequest, opts ...grpcweb.CallOption) (*Subject, error)
+	// GetSubject returns the Subject with the code provided.
+	GetSubject(ctx context.Context, in *GetSubjectRequest, opts ...grpcweb.CallOption) (*Subject, error)
+	// UpdateSubject renames or moves a Subject, and returns it.
+	// A Subject can't be moved under itself or its subjects.
+	UpdateSubject(ctx context.Context, in *UpdateSubjectRequest, opts ...grpcweb.CallOption) (*Subject, error)
+	// DeleteSubject removes a Subject from the taxonomy and returns it.
+	// It returns a FailedPrecondition error if any Subjects are under
+	// it, or any Books have it.
+	DeleteSubject(ctx context.Context, in *DeleteSubjectRequest, opts ...grpcweb.CallOption) (*Subject, error)
+	// BrowseSubjects returns the Subjects under a Subject, or the top
+	// level Subjects, with the number of Books about each. The Books
+	// themselves are returned by QueryBooks with the subject code.
+	BrowseSubjects(ctx context.Context, in *BrowseSubjectsRequest, opts ...grpcweb.CallOption) (*BrowseSubjectsResponse, error)
+	// TagBook applies a tag to a Book on behalf of a member,
+	// and returns the Book. It returns an AlreadyExists error
+	// if the member has already applied the tag to the Book.
+	TagBook(ctx context.Context, in *TagBookRequest, opts ...grpcweb.CallOption) (*Book, error)
+	// UntagBook removes a tag a member applied to a Book, and returns
+	// the Book. It returns a NotFound error if the member does not
+	// exist or has not applied the tag to the Book, and a
+	// FailedPrecondition error if the member is suspended.
+	UntagBook(ctx context.Context, in *UntagBookRequest, opts ...grpcweb.CallOption) (*Book, error)
+	// ListTags returns the tags applied to Books, with the number
+	// of Books tagged with each. Deleted Books are not counted.
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpcweb.CallOption) (*ListTagsResponse, error)
+	// MakeCollection takes a stream of books and returns a Book collection.
+	// Books are identified by their ISBN and resolved to the Books in the
+	// library. Duplicates are dropped. If any ISBN is invalid or unknown,
+	// it returns an InvalidArgument error with a google.rpc.BadRequest
+	// detail listing every bad entry, and no collection is made.
+	// The collection is stored, with the owner and name provided in
+	// the "owner" and "collection-name" request metadata. The owner
+	// must be the ID of a member, else an InvalidArgument error is returned.
+	MakeCollection(ctx context.Context, opts ...grpcweb.CallOption) (BookService_MakeCollectionClient, error)
+	// GetCollection returns a Collection that was made with MakeCollection.
+	// It returns a NotFound error if the Collection does not exist.
+	GetCollection(ctx context.Context, in *GetCollectionRequest, opts ...grpcweb.CallOption) (*Collection, error)
+	// ListCollections returns a page of Collections, oldest first.
+	ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpcweb.CallOption) (*ListCollectionsResponse, error)
+	// UpdateCollection renames a Collection or changes its Books,
+	// and returns the updated Collection. Books are resolved like in
+	// MakeCollection, with the same InvalidArgument error for bad ISBNs.
+	// It returns a NotFound error if the Collection does not exist, an
+	// Unauthenticated error if the "owner" request metadata is not the ID
+	// of a member, and a PermissionDenied error if the Collection has an
+	// owner other than that member. The metadata is not authenticated.
+	UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpcweb.CallOption) (*Collection, error)
+	// DeleteCollection deletes a Collection and returns it.
+	// It returns a NotFound error if the Collection does not exist, an
+	// Unauthenticated error if the "owner" request metadata is not the ID
+	// of a member, and a PermissionDenied error if the Collection has an
+	// owner other than that member. The metadata is not authenticated.
+	DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpcweb.CallOption) (*Collection, error)
+	// ExportCollection renders a collection or the result of a query
+	// as a file in the format requested, streamed in chunks.
+	// It returns an InvalidArgument error if neither is set,
+	// and a NotFound error if the Collection does not exist.
+	ExportCollection(ctx context.Context, in *ExportCollectionRequest, opts ...grpcweb.CallOption) (BookService_ExportCollectionClient, error)
+	// WatchBooks streams the changes made to the Books matching the filter,
+	// except changes to only their copies, available copies, average rating
+	// and review count. Watches can be resumed with the resume token of the
+	// last event received. If the changes after that event are no longer
+	// retained, it returns a ResourceExhausted error, and the client should
+	// start a new watch without a resume token and then reload the Books.
+	WatchBooks(ctx context.Context, in *WatchBooksRequest, opts ...grpcweb.CallOption) (BookService_WatchBooksClient, error)
+	// BookChat allows discussion about books between members.
+	// It returns a NotFound error if the member does not exist,
+	// and a PermissionDenied error if the member is suspended.
+	BookChat(ctx context.Context, opts ...grpcweb.CallOption) (BookService_BookChatClient, error)
+}
+
+type bookServiceClient struct {
+	client *grpcweb.Client
+}
+
+// NewBookServiceClient creates a new gRPC-Web client.
+func NewBookServiceClient(hostname string, opts ...grpcweb.DialOption) BookServiceClient {
+	return &bookServiceClient{
+		client: grpcweb.NewClient(hostname, "library.BookService", opts...),
+	}
+}
+
+func (c *bookServiceClient) GetBook(ctx context.Context, in *GetBookRequest, opts ...grpcweb.CallOption) (*Book, error) {
+	resp, err := c.client.RPCCall(ctx, "GetBook", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Book).Unmarshal(resp)
+}
+
+func (c *bookServiceClient) QueryBooks(ctx context.Context, in *QueryBooksRequest, opts ...grpcweb.CallOption) (BookService_QueryBooksClient, error) {
+	srv, err := c.client.NewClientStream(ctx, false, true, "QueryBooks", opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = srv.SendMsg(in.Marshal())
+	if err != nil {
+		return nil, err
+	}
+
+	return &bookServiceQueryBooksClient{srv}, nil
 }
 
 type BookService_QueryBooksClient interface {
@@ -781,54 +10583,434 @@ type BookService_QueryBooksClient interface {
 	grpcweb.ClientStream
 }
 
-type bookServiceQueryBooksClient struct {
-	grpcweb.ClientStream
+type bookServiceQueryBooksClient struct {
+	grpcweb.ClientStream
+}
+
+func (x *bookServiceQueryBooksClient) Recv() (*Book, error) {
+	resp, err := x.RecvMsg()
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Book).Unmarshal(resp)
+}
+
+func (c *bookServiceClient) ListBooks(ctx context.Context, in *ListBooksRequest, opts ...grpcweb.CallOption) (*ListBooksResponse, error) {
+	resp, err := c.client.RPCCall(ctx, "ListBooks", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(ListBooksResponse).Unmarshal(resp)
+}
+
+func (c *bookServiceClient) SearchBooks(ctx context.Context, in *SearchBooksRequest, opts ...grpcweb.CallOption) (*SearchBooksResponse, error) {
+	resp, err := c.client.RPCCall(ctx, "SearchBooks", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(SearchBooksResponse).Unmarshal(resp)
+}
+
+func (c *bookServiceClient) RecommendBooks(ctx context.Context, in *RecommendBooksRequest, opts ...grpcweb.CallOption) (*RecommendBooksResponse, error) {
+	resp, err := c.client.RPCCall(ctx, "RecommendBooks", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(RecommendBooksResponse).Unmarshal(resp)
+}
+
+func (c *bookServiceClient) CreateBook(ctx context.Context, in *CreateBookRequest, opts ...grpcweb.CallOption) (*Book, error) {
+	resp, err := c.client.RPCCall(ctx, "CreateBook", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Book).Unmarshal(resp)
+}
+
+func (c *bookServiceClient) UpdateBook(ctx context.Context, in *UpdateBookRequest, opts ...grpcweb.CallOption) (*Book, error) {
+	resp, err := c.client.RPCCall(ctx, "UpdateBook", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Book).Unmarshal(resp)
+}
+
+func (c *bookServiceClient) DeleteBook(ctx context.Context, in *DeleteBookRequest, opts ...grpcweb.CallOption) (*Book, error) {
+	resp, err := c.client.RPCCall(ctx, "DeleteBook", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Book).Unmarshal(resp)
+}
+
+func (c *bookServiceClient) RestoreBook(ctx context.Context, in *RestoreBookRequest, opts ...grpcweb.CallOption) (*Book, error) {
+	resp, err := c.client.RPCCall(ctx, "RestoreBook", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Book).Unmarshal(resp)
+}
+
+func (c *bookServiceClient) ListBookRevisions(ctx context.Context, in *ListBookRevisionsRequest, opts ...grpcweb.CallOption) (*ListBookRevisionsResponse, error) {
+	resp, err := c.client.RPCCall(ctx, "ListBookRevisions", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(ListBookRevisionsResponse).Unmarshal(resp)
+}
+
+func (c *bookServiceClient) CreateAuthor(ctx context.Context, in *CreateAuthorRequest, opts ...grpcweb.CallOption) (*Author, error) {
+	resp, err := c.client.RPCCall(ctx, "CreateAuthor", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Author).Unmarshal(resp)
+}
+
+func (c *bookServiceClient) GetAuthor(ctx context.Context, in *GetAuthorRequest, opts ...grpcweb.CallOption) (*Author, error) {
+	resp, err := c.client.RPCCall(ctx, "GetAuthor", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Author).Unmarshal(resp)
+}
+
+func (c *bookServiceClient) UpdateAuthor(ctx context.Context, in *UpdateAuthorRequest, opts ...grpcweb.CallOption) (*Author, error) {
+	resp, err := c.client.RPCCall(ctx, "UpdateAuthor", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Author).Unmarshal(resp)
+}
+
+func (c *bookServiceClient) ListAuthorBooks(ctx context.Context, in *ListAuthorBooksRequest, opts ...grpcweb.CallOption) (*ListAuthorBooksResponse, error) {
+	resp, err := c.client.RPCCall(ctx, "ListAuthorBooks", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(ListAuthorBooksResponse).Unmarshal(resp)
+}
+
+func (c *bookServiceClient) UploadCover(ctx context.Context, opts ...grpcweb.CallOption) (BookService_UploadCoverClient, error) {
+	srv, err := c.client.NewClientStream(ctx, true, false, "UploadCover", opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bookServiceUploadCoverClient{srv}, nil
+}
+
+type BookService_UploadCoverClient interface {
+	Send(*UploadCoverRequest) error
+	CloseAndRecv() (*Book, error)
+	grpcweb.ClientStream
+}
+
+type bookServiceUploadCoverClient struct {
+	grpcweb.ClientStream
+}
+
+func (x *bookServiceUploadCoverClient) Send(req *UploadCoverRequest) error {
+	return x.SendMsg(req.Marshal())
+}
+
+func (x *bookServiceUploadCoverClient) CloseAndRecv() (*Book, error) {
+	err := x.CloseSend()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := x.RecvMsg()
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Book).Unmarshal(resp)
+}
+
+func (c *bookServiceClient) CreateWork(ctx context.Context, in *CreateWorkRequest, opts ...grpcweb.CallOption) (*Work, error) {
+	resp, err := c.client.RPCCall(ctx, "CreateWork", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Work).Unmarshal(resp)
+}
+
+func (c *bookServiceClient) GetWork(ctx context.Context, in *GetWorkRequest, opts ...grpcweb.CallOption) (*Work, error) {
+	resp, err := c.client.RPCCall(ctx, "GetWork", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Work).Unmarshal(resp)
+}
+
+func (c *bookServiceClient) UpdateWork(ctx context.Context, in *UpdateWorkRequest, opts ...grpcweb.CallOption) (*Work, error) {
+	resp, err := c.client.RPCCall(ctx, "UpdateWork", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Work).Unmarshal(resp)
+}
+
+func (c *bookServiceClient) ListEditions(ctx context.Context, in *ListEditionsRequest, opts ...grpcweb.CallOption) (*ListEditionsResponse, error) {
+	resp, err := c.client.RPCCall(ctx, "ListEditions", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(ListEditionsResponse).Unmarshal(resp)
+}
+
+func (c *bookServiceClient) CreateSeries(ctx context.Context, in *CreateSeriesRequest, opts ...grpcweb.CallOption) (*Series, error) {
+	resp, err := c.client.RPCCall(ctx, "CreateSeries", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Series).Unmarshal(resp)
+}
+
+func (c *bookServiceClient) GetSeries(ctx context.Context, in *GetSeriesRequest, opts ...grpcweb.CallOption) (*Series, error) {
+	resp, err := c.client.RPCCall(ctx, "GetSeries", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Series).Unmarshal(resp)
+}
+
+func (c *bookServiceClient) ListSeriesWorks(ctx context.Context, in *ListSeriesWorksRequest, opts ...grpcweb.CallOption) (*ListSeriesWorksResponse, error) {
+	resp, err := c.client.RPCCall(ctx, "ListSeriesWorks", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(ListSeriesWorksResponse).Unmarshal(resp)
+}
+
+func (c *bookServiceClient) CreateSubject(ctx context.Context, in *CreateSubjectRequest, opts ...grpcweb.CallOption) (*Subject, error) {
+	resp, err := c.client.RPCCall(ctx, "CreateSubject", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Subject).Unmarshal(resp)
+}
+
+func (c *bookServiceClient) GetSubject(ctx context.Context, in *GetSubjectRequest, opts ...grpcweb.CallOption) (*Subject, error) {
+	resp, err := c.client.RPCCall(ctx, "GetSubject", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Subject).Unmarshal(resp)
+}
+
+func (c *bookServiceClient) UpdateSubject(ctx context.Context, in *UpdateSubjectRequest, opts ...grpcweb.CallOption) (*Subject, error) {
+	resp, err := c.client.RPCCall(ctx, "UpdateSubject", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Subject).Unmarshal(resp)
+}
+
+func (c *bookServiceClient) DeleteSubject(ctx context.Context, in *DeleteSubjectRequest, opts ...grpcweb.CallOption) (*Subject, error) {
+	resp, err := c.client.RPCCall(ctx, "DeleteSubject", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Subject).Unmarshal(resp)
+}
+
+func (c *bookServiceClient) BrowseSubjects(ctx context.Context, in *BrowseSubjectsRequest, opts ...grpcweb.CallOption) (*BrowseSubjectsResponse, error) {
+	resp, err := c.client.RPCCall(ctx, "BrowseSubjects", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(BrowseSubjectsResponse).Unmarshal(resp)
+}
+
+func (c *bookServiceClient) TagBook(ctx context.Context, in *TagBookRequest, opts ...grpcweb.CallOption) (*Book, error) {
+	resp, err := c.client.RPCCall(ctx, "TagBook", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Book).Unmarshal(resp)
+}
+
+func (c *bookServiceClient) UntagBook(ctx context.Context, in *UntagBookRequest, opts ...grpcweb.CallOption) (*Book, error) {
+	resp, err := c.client.RPCCall(ctx, "UntagBook", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Book).Unmarshal(resp)
+}
+
+func (c *bookServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpcweb.CallOption) (*ListTagsResponse, error) {
+	resp, err := c.client.RPCCall(ctx, "ListTags", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(ListTagsResponse).Unmarshal(resp)
+}
+
+func (c *bookServiceClient) MakeCollection(ctx context.Context, opts ...grpcweb.CallOption) (BookService_MakeCollectionClient, error) {
+	srv, err := c.client.NewClientStream(ctx, true, false, "MakeCollection", opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bookServiceMakeCollectionClient{srv}, nil
+}
+
+type BookService_MakeCollectionClient interface {
+	Send(*Book) error
+	CloseAndRecv() (*Collection, error)
+	grpcweb.ClientStream
+}
+
+type bookServiceMakeCollectionClient struct {
+	grpcweb.ClientStream
+}
+
+func (x *bookServiceMakeCollectionClient) Send(req *Book) error {
+	return x.SendMsg(req.Marshal())
+}
+
+func (x *bookServiceMakeCollectionClient) CloseAndRecv() (*Collection, error) {
+	err := x.CloseSend()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := x.RecvMsg()
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Collection).Unmarshal(resp)
+}
+
+func (c *bookServiceClient) GetCollection(ctx context.Context, in *GetCollectionRequest, opts ...grpcweb.CallOption) (*Collection, error) {
+	resp, err := c.client.RPCCall(ctx, "GetCollection", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Collection).Unmarshal(resp)
 }
 
-func (x *bookServiceQueryBooksClient) Recv() (*Book, error) {
-	resp, err := x.RecvMsg()
+func (c *bookServiceClient) ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpcweb.CallOption) (*ListCollectionsResponse, error) {
+	resp, err := c.client.RPCCall(ctx, "ListCollections", in.Marshal(), opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	return new(Book).Unmarshal(resp)
+	return new(ListCollectionsResponse).Unmarshal(resp)
 }
 
-func (c *bookServiceClient) MakeCollection(ctx context.Context, opts ...grpcweb.CallOption) (BookService_MakeCollectionClient, error) {
-	srv, err := c.client.NewClientStream(ctx, true, false, "MakeCollection", opts...)
+func (c *bookServiceClient) UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpcweb.CallOption) (*Collection, error) {
+	resp, err := c.client.RPCCall(ctx, "UpdateCollection", in.Marshal(), opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	return &bookServiceMakeCollectionClient{srv}, nil
+	return new(Collection).Unmarshal(resp)
 }
 
-type BookService_MakeCollectionClient interface {
-	Send(*Book) error
-	CloseAndRecv() (*Collection, error)
+func (c *bookServiceClient) DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpcweb.CallOption) (*Collection, error) {
+	resp, err := c.client.RPCCall(ctx, "DeleteCollection", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Collection).Unmarshal(resp)
+}
+
+func (c *bookServiceClient) ExportCollection(ctx context.Context, in *ExportCollectionRequest, opts ...grpcweb.CallOption) (BookService_ExportCollectionClient, error) {
+	srv, err := c.client.NewClientStream(ctx, false, true, "ExportCollection", opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = srv.SendMsg(in.Marshal())
+	if err != nil {
+		return nil, err
+	}
+
+	return &bookServiceExportCollectionClient{srv}, nil
+}
+
+type BookService_ExportCollectionClient interface {
+	Recv() (*ExportChunk, error)
 	grpcweb.ClientStream
 }
 
-type bookServiceMakeCollectionClient struct {
+type bookServiceExportCollectionClient struct {
 	grpcweb.ClientStream
 }
 
-func (x *bookServiceMakeCollectionClient) Send(req *Book) error {
-	return x.SendMsg(req.Marshal())
+func (x *bookServiceExportCollectionClient) Recv() (*ExportChunk, error) {
+	resp, err := x.RecvMsg()
+	if err != nil {
+		return nil, err
+	}
+
+	return new(ExportChunk).Unmarshal(resp)
 }
 
-func (x *bookServiceMakeCollectionClient) CloseAndRecv() (*Collection, error) {
-	err := x.CloseSend()
+func (c *bookServiceClient) WatchBooks(ctx context.Context, in *WatchBooksRequest, opts ...grpcweb.CallOption) (BookService_WatchBooksClient, error) {
+	srv, err := c.client.NewClientStream(ctx, false, true, "WatchBooks", opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = srv.SendMsg(in.Marshal())
 	if err != nil {
 		return nil, err
 	}
 
+	return &bookServiceWatchBooksClient{srv}, nil
+}
+
+type BookService_WatchBooksClient interface {
+	Recv() (*BookEvent, error)
+	grpcweb.ClientStream
+}
+
+type bookServiceWatchBooksClient struct {
+	grpcweb.ClientStream
+}
+
+func (x *bookServiceWatchBooksClient) Recv() (*BookEvent, error) {
 	resp, err := x.RecvMsg()
 	if err != nil {
 		return nil, err
 	}
 
-	return new(Collection).Unmarshal(resp)
+	return new(BookEvent).Unmarshal(resp)
 }
 
 func (c *bookServiceClient) BookChat(ctx context.Context, opts ...grpcweb.CallOption) (BookService_BookChatClient, error) {
@@ -862,3 +11044,403 @@ func (x *bookServiceBookChatClient) Recv() (*BookResponse, error) {
 
 	return new(BookResponse).Unmarshal(resp)
 }
+
+// Client API for MemberService service
+
+// MemberService manages the members of the library.
+type MemberServiceClient interface {
+	// RegisterMember adds a member to the library and returns
+	// the Member, with its ID and card number.
+	RegisterMember(ctx context.Context, in *RegisterMemberRequest, opts ...grpcweb.CallOption) (*Member, error)
+	// GetMember returns a Member by ID or card number.
+	// It returns a NotFound error if the Member does not exist.
+	GetMember(ctx context.Context, in *GetMemberRequest, opts ...grpcweb.CallOption) (*Member, error)
+	// UpdateMember updates the profile fields of a Member selected
+	// by the update mask, and returns the updated Member.
+	// It returns a NotFound error if the Member does not exist.
+	UpdateMember(ctx context.Context, in *UpdateMemberRequest, opts ...grpcweb.CallOption) (*Member, error)
+	// SuspendMember suspends a Member and returns it.
+	// It returns a NotFound error if the Member does not exist.
+	SuspendMember(ctx context.Context, in *SuspendMemberRequest, opts ...grpcweb.CallOption) (*Member, error)
+	// ReinstateMember reinstates a suspended Member and returns it.
+	// It returns a NotFound error if the Member does not exist.
+	ReinstateMember(ctx context.Context, in *ReinstateMemberRequest, opts ...grpcweb.CallOption) (*Member, error)
+	// DeleteMember removes a Member from the library and returns it.
+	// It returns a NotFound error if the Member does not exist, and a
+	// FailedPrecondition error if the Member has books on loan or open holds,
+	// owns collections, has written reviews or has tagged books.
+	DeleteMember(ctx context.Context, in *DeleteMemberRequest, opts ...grpcweb.CallOption) (*Member, error)
+}
+
+type memberServiceClient struct {
+	client *grpcweb.Client
+}
+
+// NewMemberServiceClient creates a new gRPC-Web client.
+func NewMemberServiceClient(hostname string, opts ...grpcweb.DialOption) MemberServiceClient {
+	return &memberServiceClient{
+		client: grpcweb.NewClient(hostname, "library.MemberService", opts...),
+	}
+}
+
+func (c *memberServiceClient) RegisterMember(ctx context.Context, in *RegisterMemberRequest, opts ...grpcweb.CallOption) (*Member, error) {
+	resp, err := c.client.RPCCall(ctx, "RegisterMember", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Member).Unmarshal(resp)
+}
+
+func (c *memberServiceClient) GetMember(ctx context.Context, in *GetMemberRequest, opts ...grpcweb.CallOption) (*Member, error) {
+	resp, err := c.client.RPCCall(ctx, "GetMember", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Member).Unmarshal(resp)
+}
+
+func (c *memberServiceClient) UpdateMember(ctx context.Context, in *UpdateMemberRequest, opts ...grpcweb.CallOption) (*Member, error) {
+	resp, err := c.client.RPCCall(ctx, "UpdateMember", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Member).Unmarshal(resp)
+}
+
+func (c *memberServiceClient) SuspendMember(ctx context.Context, in *SuspendMemberRequest, opts ...grpcweb.CallOption) (*Member, error) {
+	resp, err := c.client.RPCCall(ctx, "SuspendMember", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Member).Unmarshal(resp)
+}
+
+func (c *memberServiceClient) ReinstateMember(ctx context.Context, in *ReinstateMemberRequest, opts ...grpcweb.CallOption) (*Member, error) {
+	resp, err := c.client.RPCCall(ctx, "ReinstateMember", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Member).Unmarshal(resp)
+}
+
+func (c *memberServiceClient) DeleteMember(ctx context.Context, in *DeleteMemberRequest, opts ...grpcweb.CallOption) (*Member, error) {
+	resp, err := c.client.RPCCall(ctx, "DeleteMember", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Member).Unmarshal(resp)
+}
+
+// Client API for ReviewService service
+
+// ReviewService collects the reviews and ratings of Books by members.
+// The average rating and number of reviews are kept on each Book.
+type ReviewServiceClient interface {
+	// CreateReview adds a review of a Book and returns it.
+	// It returns a NotFound error if the Book or member does not exist,
+	// an InvalidArgument error if the rating is not from 1 to 5, and an
+	// AlreadyExists error if the member has already reviewed the Book.
+	CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpcweb.CallOption) (*Review, error)
+	// ListReviews returns a page of Reviews, newest first.
+	ListReviews(ctx context.Context, in *ListReviewsRequest, opts ...grpcweb.CallOption) (*ListReviewsResponse, error)
+	// DeleteReview removes a Review and returns it.
+	// It returns a NotFound error if the Review does not exist,
+	// and a PermissionDenied error if it was written by another member.
+	DeleteReview(ctx context.Context, in *DeleteReviewRequest, opts ...grpcweb.CallOption) (*Review, error)
+}
+
+type reviewServiceClient struct {
+	client *grpcweb.Client
+}
+
+// NewReviewServiceClient creates a new gRPC-Web client.
+func NewReviewServiceClient(hostname string, opts ...grpcweb.DialOption) ReviewServiceClient {
+	return &reviewServiceClient{
+		client: grpcweb.NewClient(hostname, "library.ReviewService", opts...),
+	}
+}
+
+func (c *reviewServiceClient) CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpcweb.CallOption) (*Review, error) {
+	resp, err := c.client.RPCCall(ctx, "CreateReview", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Review).Unmarshal(resp)
+}
+
+func (c *reviewServiceClient) ListReviews(ctx context.Context, in *ListReviewsRequest, opts ...grpcweb.CallOption) (*ListReviewsResponse, error) {
+	resp, err := c.client.RPCCall(ctx, "ListReviews", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(ListReviewsResponse).Unmarshal(resp)
+}
+
+func (c *reviewServiceClient) DeleteReview(ctx context.Context, in *DeleteReviewRequest, opts ...grpcweb.CallOption) (*Review, error) {
+	resp, err := c.client.RPCCall(ctx, "DeleteReview", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Review).Unmarshal(resp)
+}
+
+// Client API for LendingService service
+
+// LendingService lends the Books of the library to its members.
+type LendingServiceClient interface {
+	// Checkout lends a copy of a Book to a member and returns the Loan.
+	// Members with a ready Hold on the Book check out the copy set aside.
+	// If a barcode is provided, that Copy is marked as on loan.
+	// It returns a NotFound error if the Book, Copy or Member does not
+	// exist, and a FailedPrecondition error if no copies are available,
+	// the Copy is set aside for another member's Hold, or the Member
+	// is suspended or has reached their borrowing limit.
+	Checkout(ctx context.Context, in *CheckoutRequest, opts ...grpcweb.CallOption) (*Loan, error)
+	// Return ends a Loan and makes the copy available again,
+	// at the branch it was returned to.
+	// It returns a NotFound error if the Loan does not exist, and
+	// a FailedPrecondition error if it has already been returned.
+	Return(ctx context.Context, in *ReturnRequest, opts ...grpcweb.CallOption) (*Loan, error)
+	// Renew extends a Loan by a loan period, from its due time,
+	// or from now if it is overdue.
+	// It returns a NotFound error if the Loan does not exist, and a
+	// FailedPrecondition error if it has been returned, renewed the
+	// maximum number of times, or other members hold the Book.
+	Renew(ctx context.Context, in *RenewRequest, opts ...grpcweb.CallOption) (*Loan, error)
+	// ListLoans returns a page of Loans, oldest first.
+	// Only current loans are listed, unless include_returned is set.
+	ListLoans(ctx context.Context, in *ListLoansRequest, opts ...grpcweb.CallOption) (*ListLoansResponse, error)
+	// PlaceHold queues a member for a Book whose copies are all
+	// on loan, and returns the Hold. Returned copies are set aside
+	// for the first Hold in the queue, which the member may check out
+	// until the Hold expires. It returns a FailedPrecondition error if
+	// a copy is available or the member is suspended, and an
+	// AlreadyExists error if the member already has a Hold on the Book.
+	PlaceHold(ctx context.Context, in *PlaceHoldRequest, opts ...grpcweb.CallOption) (*Hold, error)
+	// CancelHold cancels a Hold and returns it. Any copy set aside
+	// for the Hold is offered to the next member in the queue.
+	// It returns a NotFound error if the Hold does not exist, and a
+	// FailedPrecondition error if it is no longer waiting or ready.
+	CancelHold(ctx context.Context, in *CancelHoldRequest, opts ...grpcweb.CallOption) (*Hold, error)
+	// ListHolds returns a page of Holds, oldest first. Only waiting
+	// and ready holds are listed, unless include_closed is set.
+	ListHolds(ctx context.Context, in *ListHoldsRequest, opts ...grpcweb.CallOption) (*ListHoldsResponse, error)
+	// WatchHolds streams the open Holds of a member, followed
+	// by every change to them, such as a copy being set aside.
+	// It returns a NotFound error if the member does not exist,
+	// and a FailedPrecondition error if the member is suspended.
+	WatchHolds(ctx context.Context, in *WatchHoldsRequest, opts ...grpcweb.CallOption) (LendingService_WatchHoldsClient, error)
+	// CreateBranch adds a branch to the library and
+	// returns the Branch, with its ID.
+	CreateBranch(ctx context.Context, in *CreateBranchRequest, opts ...grpcweb.CallOption) (*Branch, error)
+	// GetBranch returns the Branch with the ID provided.
+	GetBranch(ctx context.Context, in *GetBranchRequest, opts ...grpcweb.CallOption) (*Branch, error)
+	// ListBranches returns every Branch of the library.
+	ListBranches(ctx context.Context, in *ListBranchesRequest, opts ...grpcweb.CallOption) (*ListBranchesResponse, error)
+	// AddCopy adds a Copy of a Book to a Branch, and returns it.
+	// The copies of the Book increase by one. It returns a NotFound
+	// error if the Book does not exist, and an AlreadyExists error
+	// if a Copy with the same barcode exists.
+	AddCopy(ctx context.Context, in *AddCopyRequest, opts ...grpcweb.CallOption) (*Copy, error)
+	// GetCopy returns the Copy with the barcode provided.
+	GetCopy(ctx context.Context, in *GetCopyRequest, opts ...grpcweb.CallOption) (*Copy, error)
+	// GetAvailability returns how many copies of a Book each Branch has,
+	// and how many of them are available or on their way to the Branch.
+	GetAvailability(ctx context.Context, in *GetAvailabilityRequest, opts ...grpcweb.CallOption) (*Availability, error)
+	// TransferCopy sends an available Copy to another Branch, and
+	// returns it in transit. It can't be checked out until it is
+	// received. It returns a FailedPrecondition error if the Copy
+	// is not available, or is needed for a ready Hold.
+	TransferCopy(ctx context.Context, in *TransferCopyRequest, opts ...grpcweb.CallOption) (*Copy, error)
+	// ReceiveCopy marks a Copy in transit as arrived at the Branch
+	// it was sent to, and returns it available again. It returns a
+	// FailedPrecondition error if the Copy is not in transit.
+	ReceiveCopy(ctx context.Context, in *ReceiveCopyRequest, opts ...grpcweb.CallOption) (*Copy, error)
+}
+
+type lendingServiceClient struct {
+	client *grpcweb.Client
+}
+
+// NewLendingServiceClient creates a new gRPC-Web client.
+func NewLendingServiceClient(hostname string, opts ...grpcweb.DialOption) LendingServiceClient {
+	return &lendingServiceClient{
+		client: grpcweb.NewClient(hostname, "library.LendingService", opts...),
+	}
+}
+
+func (c *lendingServiceClient) Checkout(ctx context.Context, in *CheckoutRequest, opts ...grpcweb.CallOption) (*Loan, error) {
+	resp, err := c.client.RPCCall(ctx, "Checkout", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Loan).Unmarshal(resp)
+}
+
+func (c *lendingServiceClient) Return(ctx context.Context, in *ReturnRequest, opts ...grpcweb.CallOption) (*Loan, error) {
+	resp, err := c.client.RPCCall(ctx, "Return", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Loan).Unmarshal(resp)
+}
+
+func (c *lendingServiceClient) Renew(ctx context.Context, in *RenewRequest, opts ...grpcweb.CallOption) (*Loan, error) {
+	resp, err := c.client.RPCCall(ctx, "Renew", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Loan).Unmarshal(resp)
+}
+
+func (c *lendingServiceClient) ListLoans(ctx context.Context, in *ListLoansRequest, opts ...grpcweb.CallOption) (*ListLoansResponse, error) {
+	resp, err := c.client.RPCCall(ctx, "ListLoans", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(ListLoansResponse).Unmarshal(resp)
+}
+
+func (c *lendingServiceClient) PlaceHold(ctx context.Context, in *PlaceHoldRequest, opts ...grpcweb.CallOption) (*Hold, error) {
+	resp, err := c.client.RPCCall(ctx, "PlaceHold", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Hold).Unmarshal(resp)
+}
+
+func (c *lendingServiceClient) CancelHold(ctx context.Context, in *CancelHoldRequest, opts ...grpcweb.CallOption) (*Hold, error) {
+	resp, err := c.client.RPCCall(ctx, "CancelHold", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Hold).Unmarshal(resp)
+}
+
+func (c *lendingServiceClient) ListHolds(ctx context.Context, in *ListHoldsRequest, opts ...grpcweb.CallOption) (*ListHoldsResponse, error) {
+	resp, err := c.client.RPCCall(ctx, "ListHolds", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(ListHoldsResponse).Unmarshal(resp)
+}
+
+func (c *lendingServiceClient) WatchHolds(ctx context.Context, in *WatchHoldsRequest, opts ...grpcweb.CallOption) (LendingService_WatchHoldsClient, error) {
+	srv, err := c.client.NewClientStream(ctx, false, true, "WatchHolds", opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = srv.SendMsg(in.Marshal())
+	if err != nil {
+		return nil, err
+	}
+
+	return &lendingServiceWatchHoldsClient{srv}, nil
+}
+
+type LendingService_WatchHoldsClient interface {
+	Recv() (*Hold, error)
+	grpcweb.ClientStream
+}
+
+type lendingServiceWatchHoldsClient struct {
+	grpcweb.ClientStream
+}
+
+func (x *lendingServiceWatchHoldsClient) Recv() (*Hold, error) {
+	resp, err := x.RecvMsg()
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Hold).Unmarshal(resp)
+}
+
+func (c *lendingServiceClient) CreateBranch(ctx context.Context, in *CreateBranchRequest, opts ...grpcweb.CallOption) (*Branch, error) {
+	resp, err := c.client.RPCCall(ctx, "CreateBranch", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Branch).Unmarshal(resp)
+}
+
+func (c *lendingServiceClient) GetBranch(ctx context.Context, in *GetBranchRequest, opts ...grpcweb.CallOption) (*Branch, error) {
+	resp, err := c.client.RPCCall(ctx, "GetBranch", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Branch).Unmarshal(resp)
+}
+
+func (c *lendingServiceClient) ListBranches(ctx context.Context, in *ListBranchesRequest, opts ...grpcweb.CallOption) (*ListBranchesResponse, error) {
+	resp, err := c.client.RPCCall(ctx, "ListBranches", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(ListBranchesResponse).Unmarshal(resp)
+}
+
+func (c *lendingServiceClient) AddCopy(ctx context.Context, in *AddCopyRequest, opts ...grpcweb.CallOption) (*Copy, error) {
+	resp, err := c.client.RPCCall(ctx, "AddCopy", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Copy).Unmarshal(resp)
+}
+
+func (c *lendingServiceClient) GetCopy(ctx context.Context, in *GetCopyRequest, opts ...grpcweb.CallOption) (*Copy, error) {
+	resp, err := c.client.RPCCall(ctx, "GetCopy", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Copy).Unmarshal(resp)
+}
+
+func (c *lendingServiceClient) GetAvailability(ctx context.Context, in *GetAvailabilityRequest, opts ...grpcweb.CallOption) (*Availability, error) {
+	resp, err := c.client.RPCCall(ctx, "GetAvailability", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Availability).Unmarshal(resp)
+}
+
+func (c *lendingServiceClient) TransferCopy(ctx context.Context, in *TransferCopyRequest, opts ...grpcweb.CallOption) (*Copy, error) {
+	resp, err := c.client.RPCCall(ctx, "TransferCopy", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Copy).Unmarshal(resp)
+}
+
+func (c *lendingServiceClient) ReceiveCopy(ctx context.Context, in *ReceiveCopyRequest, opts ...grpcweb.CallOption) (*Copy, error) {
+	resp, err := c.client.RPCCall(ctx, "ReceiveCopy", in.Marshal(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(Copy).Unmarshal(resp)
+}
@@ -0,0 +1,202 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server"
+	"github.com/johanbrandhorst/grpcweb-example/server/catalog"
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// progressInterval is the number of records between progress reports.
+const progressInterval = 1000
+
+// Duplicate handling modes of the import command.
+const (
+	onDuplicateSkip      = "skip"
+	onDuplicateOverwrite = "overwrite"
+	onDuplicateFail      = "fail"
+)
+
+// importStats counts the outcomes of the records imported.
+type importStats struct {
+	read, added, overwritten, skipped, failed int
+}
+
+func (s importStats) String() string {
+	return fmt.Sprintf("%d read, %d added, %d overwritten, %d skipped, %d failed",
+		s.read, s.added, s.overwritten, s.skipped, s.failed)
+}
+
+// runImport implements the import subcommand, which adds the
+// books in CSV, JSON or ONIX files to the catalog file served
+// with the -catalog flag.
+func runImport(args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	catalogPath := fs.String("catalog", "catalog.json", "catalog file to import books into")
+	formatName := fs.String("format", "", "format of the files imported: csv, json or onix (default: detected from the file extension)")
+	dryRun := fs.Bool("dry-run", false, "validate the files without changing the catalog")
+	onDuplicate := fs.String("on-duplicate", onDuplicateFail, "what to do with books already in the catalog: skip, overwrite or fail (abort the import)")
+	allowPartial := fs.Bool("allow-partial", false, "write the catalog even if some books could not be imported")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s import [flags] file...\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+	_ = fs.Parse(args)
+
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return errors.New("no files to import")
+	}
+	switch *onDuplicate {
+	case onDuplicateSkip, onDuplicateOverwrite, onDuplicateFail:
+	default:
+		return fmt.Errorf("invalid -on-duplicate %q", *onDuplicate)
+	}
+	var format catalog.Format
+	if *formatName != "" {
+		var err error
+		format, err = catalog.ParseFormat(*formatName)
+		if err != nil {
+			return err
+		}
+	}
+
+	books, err := catalog.Load(*catalogPath)
+	if err != nil {
+		return err
+	}
+	authors := &server.MemoryAuthorStore{}
+	err = server.MigrateAuthors(context.Background(), authors, books)
+	if err != nil {
+		return err
+	}
+	// The subject taxonomy is kept by the server rather than in the
+	// catalog, so the subjects of the books in the catalog are taken
+	// to exist. Imported books may only use those subjects.
+	subjects := &server.MemorySubjectStore{}
+	for _, bk := range books {
+		for _, code := range bk.GetSubjectCodes() {
+			err = subjects.AddSubject(context.Background(), &library.Subject{Code: code, Name: code})
+			if err != nil && status.Code(err) != codes.AlreadyExists {
+				return err
+			}
+		}
+	}
+	store := server.NewMemoryBookStore(books...)
+	svc := server.NewBookService(store,
+		server.WithAuthorStore(authors),
+		server.WithSubjectStore(subjects),
+	)
+
+	var stats importStats
+	for _, path := range fs.Args() {
+		fileFormat := format
+		if fileFormat == "" {
+			fileFormat, err = catalog.FormatFromPath(path)
+			if err != nil {
+				return err
+			}
+		}
+		err = importFile(svc, path, fileFormat, *onDuplicate, &stats)
+		if err != nil {
+			return err
+		}
+	}
+
+	logger.Info("Import finished: ", stats)
+	if stats.failed > 0 && !*allowPartial {
+		return fmt.Errorf("%d books could not be imported, not writing %s", stats.failed, *catalogPath)
+	}
+	if *dryRun {
+		logger.Info("Dry run, not writing ", *catalogPath)
+		return nil
+	}
+
+	books, err = store.QueryBooks(context.Background(), func(*library.Book) bool { return true })
+	if err != nil {
+		return err
+	}
+	err = catalog.Save(*catalogPath, books)
+	if err != nil {
+		return err
+	}
+	logger.Infof("Wrote %d books to %s", len(books), *catalogPath)
+
+	if stats.failed > 0 {
+		return fmt.Errorf("%d books could not be imported", stats.failed)
+	}
+	return nil
+}
+
+// importFile adds the books in the file at path to the catalog
+// through svc, so that books are validated the same way as books
+// added through the API.
+func importFile(svc *server.BookService, path string, format catalog.Format, onDuplicate string, stats *importStats) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	ctx := context.Background()
+	logger.Infof("Importing %s as %s", path, format)
+	err = catalog.Read(f, format, func(rec catalog.Record) error {
+		stats.read++
+		if stats.read%progressInterval == 0 {
+			logger.Info("Progress: ", *stats)
+		}
+
+		if rec.Err != nil {
+			stats.failed++
+			logger.Errorf("%s: %s: %v", path, rec.Pos, rec.Err)
+			return nil
+		}
+
+		_, err := svc.CreateBook(ctx, &library.CreateBookRequest{Book: rec.Book})
+		switch status.Code(err) {
+		case codes.OK:
+			stats.added++
+			return nil
+		case codes.AlreadyExists:
+		default:
+			stats.failed++
+			logger.Errorf("%s: %s: %v", path, rec.Pos, status.Convert(err).Message())
+			return nil
+		}
+
+		switch onDuplicate {
+		case onDuplicateSkip:
+			stats.skipped++
+			logger.Warnf("%s: %s: skipping duplicate ISBN %s", path, rec.Pos, rec.Book.GetIsbn())
+			return nil
+		case onDuplicateOverwrite:
+			// Overwrite whatever version is in the catalog
+			rec.Book.Etag = ""
+			_, err = svc.UpdateBook(ctx, &library.UpdateBookRequest{Book: rec.Book})
+			if err != nil {
+				stats.failed++
+				logger.Errorf("%s: %s: %v", path, rec.Pos, status.Convert(err).Message())
+				return nil
+			}
+			stats.overwritten++
+			return nil
+		}
+		return fmt.Errorf("%s: %s: duplicate ISBN %s", path, rec.Pos, rec.Book.GetIsbn())
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
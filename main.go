@@ -16,16 +16,26 @@ import (
 	"github.com/lpar/gzipped"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/crypto/acme/autocert"
+	"golang.org/x/net/context"
+	"golang.org/x/text/language"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/grpclog"
 
 	"github.com/johanbrandhorst/grpcweb-example/client/compiled"
 	"github.com/johanbrandhorst/grpcweb-example/server"
+	"github.com/johanbrandhorst/grpcweb-example/server/blob"
+	"github.com/johanbrandhorst/grpcweb-example/server/catalog"
 	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
 )
 
 var logger *logrus.Logger
 var host = flag.String("host", "", "host to get LetsEncrypt certificate for")
+var locale = flag.String("locale", "en", "BCP 47 locale used to order book titles and authors")
+var catalogPath = flag.String("catalog", "", "JSON catalog file to serve books from, instead of the built-in examples")
+var loanPeriod = flag.Duration("loan-period", 21*24*time.Hour, "time books are lent for, and by which renewals extend loans")
+var maxRenewals = flag.Int("max-renewals", 2, "number of times a loan may be renewed")
+var coverDir = flag.String("covers", "covers", "directory to store uploaded cover images in")
+var holdPeriod = flag.Duration("hold-period", 7*24*time.Hour, "time a returned copy is set aside for the next hold")
 
 func init() {
 	logger = logrus.StandardLogger()
@@ -43,10 +53,64 @@ func init() {
 func main() {
 	flag.Parse()
 
+	if flag.Arg(0) == "import" {
+		err := runImport(flag.Args()[1:])
+		if err != nil {
+			logger.Fatal(err)
+		}
+		return
+	}
+
+	tag, err := language.Parse(*locale)
+	if err != nil {
+		logger.Fatalf("Invalid locale %q: %v", *locale, err)
+	}
+
+	books := server.Fixtures()
+	if *catalogPath != "" {
+		books, err = catalog.Load(*catalogPath)
+		if err != nil {
+			logger.Fatalf("Failed to load catalog: %v", err)
+		}
+	}
+
+	authors := &server.MemoryAuthorStore{}
+	err = server.MigrateAuthors(context.Background(), authors, books)
+	if err != nil {
+		logger.Fatalf("Failed to migrate authors: %v", err)
+	}
+
 	gs := grpc.NewServer()
-	library.RegisterBookServiceServer(gs, &server.BookService{})
+	store := server.NewMemoryBookStore(books...)
+	members := &server.MemoryMemberStore{}
+	collections := &server.MemoryCollectionStore{}
+	svc := server.NewBookService(store,
+		server.WithLocale(tag),
+		server.WithMemberStore(members),
+		server.WithCollectionStore(collections),
+		server.WithAuthorStore(authors),
+		server.WithCoverStore(blob.Dir(*coverDir)),
+		server.WithLoanStore(store),
+	)
+	library.RegisterBookServiceServer(gs, svc)
+	library.RegisterMemberServiceServer(gs, server.NewMemberService(members, store,
+		server.WithMemberCollectionStore(collections),
+	))
+	library.RegisterReviewServiceServer(gs, server.NewReviewService(store, members))
+	library.RegisterLendingServiceServer(gs, server.NewLendingService(store, members,
+		server.WithLoanPeriod(*loanPeriod),
+		server.WithMaxRenewals(*maxRenewals),
+		server.WithHoldPeriod(*holdPeriod),
+	))
 	wrappedServer := grpcweb.WrapServer(gs, grpcweb.WithWebsockets(true))
 
+	mux := http.NewServeMux()
+	mux.Handle("/export", svc.ExportHandler())
+	mux.Handle("/covers/", svc.CoverHandler())
+	mux.HandleFunc("/", folderReader(
+		gzipped.FileServer(compiled.Assets).ServeHTTP,
+	))
+
 	httpsSrv := &http.Server{
 		// These interfere with websocket streams, disable for now
 		// ReadTimeout: 5 * time.Second,
@@ -63,9 +127,7 @@ func main() {
 		},
 		Handler: hstsHandler(
 			grpcTrafficSplitter(
-				folderReader(
-					gzipped.FileServer(compiled.Assets).ServeHTTP,
-				),
+				mux.ServeHTTP,
 				wrappedServer,
 			),
 		),
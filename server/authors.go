@@ -0,0 +1,376 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/catalog"
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// authorBooksPageToken is the content of the page
+// tokens handed out by ListAuthorBooks.
+type authorBooksPageToken struct {
+	// Author is the author of the request that created
+	// the token. It may not change between pages.
+	Author string `json:"a"`
+	// Last is the key of the last Book on the previous page.
+	Last listKey `json:"l"`
+}
+
+func (authorBooksPageToken) kind() string { return "author-books" }
+
+func (s *BookService) CreateAuthor(ctx context.Context, req *library.CreateAuthorRequest) (*library.Author, error) {
+	if req.GetAuthor() == nil {
+		return nil, status.Error(codes.InvalidArgument, "An author must be provided")
+	}
+	author := &library.Author{
+		Name:      req.GetAuthor().GetName(),
+		SortName:  req.GetAuthor().GetSortName(),
+		BirthYear: req.GetAuthor().GetBirthYear(),
+		DeathYear: req.GetAuthor().GetDeathYear(),
+	}
+	err := validateAuthor(author)
+	if err != nil {
+		return nil, err
+	}
+	author.Id, err = newID("author")
+	if err != nil {
+		return nil, err
+	}
+	err = s.authors.AddAuthor(ctx, author)
+	if err != nil {
+		return nil, err
+	}
+
+	return author, nil
+}
+
+func (s *BookService) GetAuthor(ctx context.Context, req *library.GetAuthorRequest) (*library.Author, error) {
+	return s.authors.GetAuthor(ctx, req.GetId())
+}
+
+func (s *BookService) UpdateAuthor(ctx context.Context, req *library.UpdateAuthorRequest) (*library.Author, error) {
+	ctx, err := requestActor(ctx, s.members)
+	if err != nil {
+		return nil, err
+	}
+	mask := req.GetUpdateMask()
+	for _, path := range mask.GetPaths() {
+		switch path {
+		case "name", "sort_name", "birth_year", "death_year":
+		case "id":
+			return nil, status.Error(codes.InvalidArgument, "The id of an author can't be changed")
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "Unknown field %q in update mask", path)
+		}
+	}
+
+	src := req.GetAuthor()
+	var renamed bool
+	author, err := s.authors.UpdateAuthor(ctx, src.GetId(), func(a *library.Author) error {
+		if updatesField(mask, "name") {
+			renamed = a.GetName() != src.GetName()
+			a.Name = src.GetName()
+			// Set the sort name from the new name, unless it is also updated
+			a.SortName = ""
+		}
+		if updatesField(mask, "sort_name") {
+			a.SortName = src.GetSortName()
+		}
+		if updatesField(mask, "birth_year") {
+			a.BirthYear = src.GetBirthYear()
+		}
+		if updatesField(mask, "death_year") {
+			a.DeathYear = src.GetDeathYear()
+		}
+		return validateAuthor(a)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !renamed {
+		return author, nil
+	}
+
+	// Update the author names of the Books of the Author
+	books, err := s.store.QueryBooks(ctx, func(bk *library.Book) bool {
+		return hasAuthor(bk, author.GetId())
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, bk := range books {
+		_, err = s.store.UpdateBook(ctx, bk.GetIsbn(), func(bk *library.Book) error {
+			authors, err := s.getAuthors(ctx, bk.GetAuthorIds())
+			if err != nil {
+				return err
+			}
+			setAuthors(bk, authors)
+			return nil
+		})
+		switch status.Code(err) {
+		case codes.OK:
+		case codes.NotFound:
+			// Deleted since the query
+			continue
+		default:
+			return nil, err
+		}
+		s.reindex(ctx, bk.GetIsbn())
+	}
+
+	return author, nil
+}
+
+func (s *BookService) ListAuthorBooks(ctx context.Context, req *library.ListAuthorBooksRequest) (*library.ListAuthorBooksResponse, error) {
+	_, err := s.authors.GetAuthor(ctx, req.GetAuthorId())
+	if err != nil {
+		return nil, err
+	}
+	pageSize, err := parsePageSize(req.GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+
+	var last *listKey
+	if req.GetPageToken() != "" {
+		var token authorBooksPageToken
+		if !decodeToken(s.tokenKey, req.GetPageToken(), &token) {
+			return nil, status.Error(codes.InvalidArgument, "Invalid page token")
+		}
+		if token.Author != req.GetAuthorId() {
+			return nil, status.Error(codes.InvalidArgument, "The filters must not change between pages")
+		}
+		last = &token.Last
+	}
+
+	books, err := s.store.QueryBooks(ctx, func(bk *library.Book) bool {
+		return hasAuthor(bk, req.GetAuthorId()) &&
+			(last == nil || last.less(keyOfPublication(bk)))
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(books, func(i, j int) bool {
+		return keyOfPublication(books[i]).less(keyOfPublication(books[j]))
+	})
+
+	resp := &library.ListAuthorBooksResponse{}
+	if len(books) > pageSize {
+		books = books[:pageSize]
+		resp.NextPageToken, err = encodeToken(s.tokenKey, authorBooksPageToken{
+			Author: req.GetAuthorId(),
+			Last:   keyOfPublication(books[len(books)-1]),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	s.localize(ctx, books...)
+	resp.Books = books
+
+	return resp, nil
+}
+
+// bookAuthors returns the Authors of bk. If byName is set, the Authors
+// are looked up by the names in the deprecated author field of bk, and
+// added to the library if they don't exist. Otherwise, they are looked
+// up by the author IDs of bk, and must exist.
+func (s *BookService) bookAuthors(ctx context.Context, bk *library.Book, byName bool) ([]*library.Author, error) {
+	if !byName {
+		seen := map[string]bool{}
+		for _, id := range bk.GetAuthorIds() {
+			if seen[id] {
+				return nil, status.Errorf(codes.InvalidArgument, "Duplicate author %q", id)
+			}
+			seen[id] = true
+		}
+		authors, err := s.getAuthors(ctx, bk.GetAuthorIds())
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Error(codes.InvalidArgument, status.Convert(err).Message())
+		}
+		return authors, err
+	}
+
+	// Serialize lookups, so concurrent requests
+	// don't add the same Author twice.
+	s.authorsMu.Lock()
+	defer s.authorsMu.Unlock()
+	var authors []*library.Author
+	for _, name := range catalog.SplitAuthors(bk.GetAuthor()) {
+		a, err := findOrAddAuthor(ctx, s.authors, name)
+		if err != nil {
+			return nil, err
+		}
+		authors = append(authors, a)
+	}
+	return authors, nil
+}
+
+// getAuthors returns the Authors with the IDs provided.
+// If any of them don't exist, it returns a NotFound error.
+func (s *BookService) getAuthors(ctx context.Context, ids []string) ([]*library.Author, error) {
+	var authors []*library.Author
+	for _, id := range ids {
+		a, err := s.authors.GetAuthor(ctx, id)
+		switch status.Code(err) {
+		case codes.OK:
+		case codes.NotFound:
+			return nil, status.Errorf(codes.NotFound, "Unknown author %q", id)
+		default:
+			return nil, err
+		}
+		authors = append(authors, a)
+	}
+	return authors, nil
+}
+
+// MigrateAuthors sets the author IDs of books stored before Books
+// referenced Authors, from the names in their author field. Authors
+// are looked up by name in the AuthorStore, and added to it if they
+// don't exist. Books that already have author IDs keep them, adding
+// any of their Authors missing from the store with the name in the
+// same position of the author field, so that catalogs saved with
+// author IDs can be loaded into an empty AuthorStore.
+func MigrateAuthors(ctx context.Context, authors AuthorStore, books []*library.Book) error {
+	for _, bk := range books {
+		names := catalog.SplitAuthors(bk.GetAuthor())
+		if len(bk.GetAuthorIds()) == 0 {
+			for _, name := range names {
+				a, err := findOrAddAuthor(ctx, authors, name)
+				if err != nil {
+					return err
+				}
+				bk.AuthorIds = append(bk.AuthorIds, a.GetId())
+			}
+			bk.Author = strings.Join(names, catalog.AuthorSeparator)
+			continue
+		}
+
+		for i, id := range bk.GetAuthorIds() {
+			_, err := authors.GetAuthor(ctx, id)
+			switch status.Code(err) {
+			case codes.OK:
+				continue
+			case codes.NotFound:
+			default:
+				return err
+			}
+			if i >= len(names) {
+				return fmt.Errorf("book %s: the name of author %s is unknown", bk.GetIsbn(), id)
+			}
+			a := &library.Author{
+				Id:   id,
+				Name: names[i],
+			}
+			err = validateAuthor(a)
+			if err != nil {
+				return fmt.Errorf("book %s: %s", bk.GetIsbn(), status.Convert(err).Message())
+			}
+			err = authors.AddAuthor(ctx, a)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// findOrAddAuthor returns the first Author in the store with
+// the name provided, adding a new Author if there is none.
+func findOrAddAuthor(ctx context.Context, authors AuthorStore, name string) (*library.Author, error) {
+	found, err := authors.QueryAuthors(ctx, func(a *library.Author) bool {
+		return a.GetName() == name
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(found) > 0 {
+		return found[0], nil
+	}
+
+	a := &library.Author{Name: name}
+	err = validateAuthor(a)
+	if err != nil {
+		return nil, err
+	}
+	a.Id, err = newID("author")
+	if err != nil {
+		return nil, err
+	}
+	err = authors.AddAuthor(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// setAuthors sets the authors of bk, and the
+// deprecated author field to their names.
+func setAuthors(bk *library.Book, authors []*library.Author) {
+	bk.AuthorIds = nil
+	var names []string
+	for _, a := range authors {
+		bk.AuthorIds = append(bk.AuthorIds, a.GetId())
+		names = append(names, a.GetName())
+	}
+	bk.Author = strings.Join(names, catalog.AuthorSeparator)
+}
+
+// hasAuthor reports whether the Author with the ID provided is an author of bk.
+func hasAuthor(bk *library.Book, id string) bool {
+	for _, a := range bk.GetAuthorIds() {
+		if a == id {
+			return true
+		}
+	}
+	return false
+}
+
+// validateAuthor returns an InvalidArgument error if a is
+// invalid, and sets its sort name from its name if it is empty.
+func validateAuthor(a *library.Author) error {
+	a.Name = strings.TrimSpace(a.GetName())
+	switch {
+	case a.GetName() == "":
+		return status.Error(codes.InvalidArgument, "The name must not be empty")
+	case strings.Contains(a.GetName(), ";"):
+		// Semicolons separate the names in the author field of Books
+		return status.Error(codes.InvalidArgument, "The name must not contain semicolons")
+	case a.GetBirthYear() != 0 && a.GetDeathYear() != 0 && a.GetDeathYear() < a.GetBirthYear():
+		return status.Error(codes.InvalidArgument, "The death year must not be before the birth year")
+	}
+	if a.GetSortName() == "" {
+		a.SortName = sortName(a.GetName())
+	}
+	return nil
+}
+
+// sortName returns the name of an author as it is ordered in
+// a catalog, with the last name first, for example "Orwell, George".
+func sortName(name string) string {
+	words := strings.Fields(name)
+	if len(words) < 2 {
+		return name
+	}
+	return words[len(words)-1] + ", " + strings.Join(words[:len(words)-1], " ")
+}
+
+// keyOfPublication is the position of bk in a list of
+// Books ordered by publication date, and then by ISBN.
+func keyOfPublication(bk *library.Book) listKey {
+	return listKey{
+		Seconds: bk.GetPublicationDate().GetSeconds(),
+		Nanos:   bk.GetPublicationDate().GetNanos(),
+		ID:      bk.GetIsbn(),
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// AuthorStore is the storage backend for the Authors of the library.
+// Implementations must be safe for concurrent use.
+// Errors returned should be gRPC status errors, as they
+// are passed on to the client unchanged.
+type AuthorStore interface {
+	// GetAuthor returns the Author with the ID provided.
+	// If no such Author exists, it returns a NotFound error.
+	GetAuthor(ctx context.Context, id string) (*library.Author, error)
+	// QueryAuthors returns all Authors for which match returns
+	// true, in the order they were added to the store.
+	QueryAuthors(ctx context.Context, match func(*library.Author) bool) ([]*library.Author, error)
+	// AddAuthor stores the Author provided. If an Author with
+	// the same ID already exists, it returns an AlreadyExists error.
+	AddAuthor(ctx context.Context, author *library.Author) error
+	// UpdateAuthor calls update with the Author with the ID provided
+	// and stores the result, atomically with respect to other writes.
+	// If update returns an error, the Author is left unchanged and the
+	// error is returned. If no such Author exists, it returns a NotFound error.
+	UpdateAuthor(ctx context.Context, id string, update func(*library.Author) error) (*library.Author, error)
+}
+
+// MemoryAuthorStore is an in-memory AuthorStore.
+// The zero value is an empty store ready to use.
+type MemoryAuthorStore struct {
+	mu      sync.RWMutex
+	authors []*library.Author
+	index   map[string]int
+}
+
+// GetAuthor implements AuthorStore.
+func (s *MemoryAuthorStore) GetAuthor(ctx context.Context, id string) (*library.Author, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	i, ok := s.index[id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "Author could not be found")
+	}
+	return cloneAuthor(s.authors[i]), nil
+}
+
+// QueryAuthors implements AuthorStore.
+func (s *MemoryAuthorStore) QueryAuthors(ctx context.Context, match func(*library.Author) bool) ([]*library.Author, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var authors []*library.Author
+	for _, a := range s.authors {
+		if match(a) {
+			authors = append(authors, cloneAuthor(a))
+		}
+	}
+	return authors, nil
+}
+
+// AddAuthor implements AuthorStore.
+func (s *MemoryAuthorStore) AddAuthor(ctx context.Context, author *library.Author) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.index == nil {
+		s.index = map[string]int{}
+	}
+	if _, ok := s.index[author.GetId()]; ok {
+		return status.Errorf(codes.AlreadyExists, "An author with ID %s already exists", author.GetId())
+	}
+	s.index[author.GetId()] = len(s.authors)
+	s.authors = append(s.authors, cloneAuthor(author))
+	return nil
+}
+
+// UpdateAuthor implements AuthorStore.
+func (s *MemoryAuthorStore) UpdateAuthor(ctx context.Context, id string, update func(*library.Author) error) (*library.Author, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	i, ok := s.index[id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "Author could not be found")
+	}
+	a := cloneAuthor(s.authors[i])
+	err := update(a)
+	if err != nil {
+		return nil, err
+	}
+	if a.GetId() != id {
+		return nil, status.Error(codes.InvalidArgument, "The ID of an author can't be changed")
+	}
+	s.authors[i] = cloneAuthor(a)
+	return a, nil
+}
+
+// cloneAuthor returns a deep copy of a, so that callers
+// can't modify the contents of the store.
+func cloneAuthor(a *library.Author) *library.Author {
+	return proto.Clone(a).(*library.Author)
+}
@@ -0,0 +1,94 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+// Package blob stores binary objects, such as images, by key.
+package blob
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/net/context"
+)
+
+// ErrNotFound is returned by Get if no blob with the key exists.
+var ErrNotFound = errors.New("blob not found")
+
+// Store is a store of blobs.
+// Implementations must be safe for concurrent use.
+type Store interface {
+	// Put stores data with the key provided,
+	// replacing any blob with the same key.
+	Put(ctx context.Context, key string, data []byte) error
+	// Get returns the blob with the key provided. If no
+	// such blob exists, it returns ErrNotFound.
+	Get(ctx context.Context, key string) ([]byte, error)
+}
+
+// Dir is a Store keeping each blob in a file in a local
+// directory, which is created when the first blob is put.
+// Keys may only contain letters, digits, dashes and underscores.
+type Dir string
+
+// Put implements Store. Blobs are written atomically,
+// so a blob is never read while it is being written.
+func (d Dir) Put(ctx context.Context, key string, data []byte) error {
+	err := checkKey(key)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(string(d), 0755)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(string(d), key+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	_, err = tmp.Write(data)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), filepath.Join(string(d), key))
+}
+
+// Get implements Store.
+func (d Dir) Get(ctx context.Context, key string) ([]byte, error) {
+	err := checkKey(key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(filepath.Join(string(d), key))
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	}
+	return data, err
+}
+
+// checkKey returns an error if key can't be used as a file name.
+func checkKey(key string) error {
+	if key == "" {
+		return errors.New("empty blob key")
+	}
+	for _, c := range key {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '-', c == '_':
+		default:
+			return fmt.Errorf("invalid blob key %q", key)
+		}
+	}
+	return nil
+}
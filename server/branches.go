@@ -0,0 +1,106 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+func (s *LendingService) CreateBranch(ctx context.Context, req *library.CreateBranchRequest) (*library.Branch, error) {
+	if req.GetBranch().GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "The name must not be empty")
+	}
+	branch := &library.Branch{
+		Name:    req.GetBranch().GetName(),
+		Address: req.GetBranch().GetAddress(),
+	}
+	var err error
+	branch.Id, err = newID("branch")
+	if err != nil {
+		return nil, err
+	}
+	err = s.branches.AddBranch(ctx, branch)
+	if err != nil {
+		return nil, err
+	}
+
+	return branch, nil
+}
+
+func (s *LendingService) GetBranch(ctx context.Context, req *library.GetBranchRequest) (*library.Branch, error) {
+	return s.branches.GetBranch(ctx, req.GetId())
+}
+
+func (s *LendingService) ListBranches(ctx context.Context, req *library.ListBranchesRequest) (*library.ListBranchesResponse, error) {
+	branches, err := s.branches.QueryBranches(ctx, func(*library.Branch) bool { return true })
+	if err != nil {
+		return nil, err
+	}
+	return &library.ListBranchesResponse{Branches: branches}, nil
+}
+
+func (s *LendingService) GetAvailability(ctx context.Context, req *library.GetAvailabilityRequest) (*library.Availability, error) {
+	id, err := requestIsbn(req.GetIsbn(), 0)
+	if err != nil {
+		return nil, err
+	}
+	l, err := s.store.GetLending(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	// Settle the holds read, so the available copies are current.
+	// They are stored by the next write to the Lending instead,
+	// so that reads don't write to the store.
+	err = s.settleHolds(l, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	branches, err := s.branches.QueryBranches(ctx, func(*library.Branch) bool { return true })
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &library.Availability{
+		Isbn:            id,
+		AvailableCopies: l.Book.GetAvailableCopies(),
+	}
+	byID := map[string]*library.BranchAvailability{}
+	for _, b := range branches {
+		ba := &library.BranchAvailability{Branch: b}
+		byID[b.GetId()] = ba
+		resp.Branches = append(resp.Branches, ba)
+	}
+	for _, c := range l.Copies {
+		if ba, ok := byID[c.GetBranchId()]; ok {
+			ba.Copies++
+			if c.GetState() == library.Copy_AVAILABLE {
+				ba.AvailableCopies++
+			}
+		}
+		if ba, ok := byID[c.GetDestinationBranchId()]; ok && c.GetState() == library.Copy_IN_TRANSIT {
+			ba.IncomingCopies++
+		}
+	}
+
+	return resp, nil
+}
+
+// checkBranch returns an InvalidArgument error if
+// no Branch with the ID provided exists.
+func (s *LendingService) checkBranch(ctx context.Context, id string) error {
+	if id == "" {
+		return status.Error(codes.InvalidArgument, "The branch must not be empty")
+	}
+	_, err := s.branches.GetBranch(ctx, id)
+	if status.Code(err) == codes.NotFound {
+		return status.Errorf(codes.InvalidArgument, "Unknown branch %q", id)
+	}
+	return err
+}
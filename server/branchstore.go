@@ -0,0 +1,84 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// BranchStore is the storage backend for the Branches of the library.
+// Implementations must be safe for concurrent use.
+// Errors returned should be gRPC status errors, as they
+// are passed on to the client unchanged.
+type BranchStore interface {
+	// GetBranch returns the Branch with the ID provided.
+	// If no such Branch exists, it returns a NotFound error.
+	GetBranch(ctx context.Context, id string) (*library.Branch, error)
+	// QueryBranches returns all Branches for which match
+	// returns true, in the order they were added to the store.
+	QueryBranches(ctx context.Context, match func(*library.Branch) bool) ([]*library.Branch, error)
+	// AddBranch stores the Branch provided. If a Branch with the
+	// same ID already exists, it returns an AlreadyExists error.
+	AddBranch(ctx context.Context, branch *library.Branch) error
+}
+
+// MemoryBranchStore is an in-memory BranchStore.
+// The zero value is an empty store ready to use.
+type MemoryBranchStore struct {
+	mu       sync.RWMutex
+	branches []*library.Branch
+	index    map[string]int
+}
+
+// GetBranch implements BranchStore.
+func (s *MemoryBranchStore) GetBranch(ctx context.Context, id string) (*library.Branch, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	i, ok := s.index[id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "Branch could not be found")
+	}
+	return cloneBranch(s.branches[i]), nil
+}
+
+// QueryBranches implements BranchStore.
+func (s *MemoryBranchStore) QueryBranches(ctx context.Context, match func(*library.Branch) bool) ([]*library.Branch, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var branches []*library.Branch
+	for _, b := range s.branches {
+		if match(b) {
+			branches = append(branches, cloneBranch(b))
+		}
+	}
+	return branches, nil
+}
+
+// AddBranch implements BranchStore.
+func (s *MemoryBranchStore) AddBranch(ctx context.Context, branch *library.Branch) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.index == nil {
+		s.index = map[string]int{}
+	}
+	if _, ok := s.index[branch.GetId()]; ok {
+		return status.Errorf(codes.AlreadyExists, "A branch with ID %s already exists", branch.GetId())
+	}
+	s.index[branch.GetId()] = len(s.branches)
+	s.branches = append(s.branches, cloneBranch(branch))
+	return nil
+}
+
+// cloneBranch returns a deep copy of b, so that callers
+// can't modify the contents of the store.
+func cloneBranch(b *library.Branch) *library.Branch {
+	return proto.Clone(b).(*library.Branch)
+}
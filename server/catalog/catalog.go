@@ -0,0 +1,171 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+// Package catalog reads and writes books in the
+// file formats used to maintain library catalogs.
+package catalog
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// Format is a catalog file format.
+type Format string
+
+// Supported catalog file formats.
+const (
+	// CSV is a comma separated file with a header row.
+	// See ReadCSV for the columns supported.
+	CSV Format = "csv"
+	// JSON is a JSON array of Books, or a stream of Books,
+	// in the proto3 JSON mapping.
+	JSON Format = "json"
+	// ONIX is an ONIX for Books 3.0 XML message,
+	// using reference tag names.
+	ONIX Format = "onix"
+	// BibTeX is a BibTeX bibliography with a @book entry
+	// per Book. It can only be written.
+	BibTeX Format = "bibtex"
+	// RIS is a RIS citation file with a record per Book.
+	// It can only be written.
+	RIS Format = "ris"
+)
+
+// AuthorSeparator separates the names of the
+// authors in the author field of a Book.
+const AuthorSeparator = "; "
+
+// SplitAuthors returns the names of the authors
+// in the author field of a Book.
+func SplitAuthors(author string) []string {
+	var names []string
+	for _, name := range strings.Split(author, ";") {
+		name = strings.TrimSpace(name)
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// Record is a book read from a catalog file.
+type Record struct {
+	// Pos describes the position of the record
+	// in the file, for use in error messages.
+	Pos string
+	// Book is the book read, if Err is nil.
+	Book *library.Book
+	// Err is the error encountered reading the record, if any.
+	Err error
+}
+
+// ParseFormat parses the name of a Format.
+func ParseFormat(name string) (Format, error) {
+	switch f := Format(strings.ToLower(name)); f {
+	case CSV, JSON, ONIX, BibTeX, RIS:
+		return f, nil
+	}
+	return "", fmt.Errorf("unknown catalog format %q", name)
+}
+
+// FormatFromPath detects the Format of a file from its extension.
+func FormatFromPath(path string) (Format, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return CSV, nil
+	case ".json":
+		return JSON, nil
+	case ".xml", ".onix":
+		return ONIX, nil
+	}
+	return "", fmt.Errorf("can't detect the catalog format of %q", path)
+}
+
+// Read reads the records of a catalog file in format f,
+// calling fn for each record. Errors in individual records are
+// reported in the record, Read only fails if the file as a whole
+// can't be read, or if fn returns an error.
+func Read(r io.Reader, f Format, fn func(Record) error) error {
+	switch f {
+	case CSV:
+		return ReadCSV(r, fn)
+	case JSON:
+		return ReadJSON(r, fn)
+	case ONIX:
+		return ReadONIX(r, fn)
+	}
+	return fmt.Errorf("can't read catalog format %q", f)
+}
+
+// Load reads the Books in the JSON catalog file at path.
+// A missing file is treated as an empty catalog.
+func Load(path string) ([]*library.Book, error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var books []*library.Book
+	err = ReadJSON(f, func(rec Record) error {
+		if rec.Err != nil {
+			return fmt.Errorf("%s: %s: %v", path, rec.Pos, rec.Err)
+		}
+		books = append(books, rec.Book)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return books, nil
+}
+
+// Save writes books to the file at path as a JSON catalog,
+// replacing the file atomically.
+func Save(path string, books []*library.Book) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	bw := bufio.NewWriter(tmp)
+	w, err := NewWriter(bw, JSON)
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+	for _, bk := range books {
+		err = w.Write(bk)
+		if err != nil {
+			tmp.Close()
+			return err
+		}
+	}
+	err = w.Close()
+	if err == nil {
+		err = bw.Flush()
+	}
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,192 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package catalog
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// csvColumns maps the CSV column names supported
+// to functions setting the column value on a Book.
+var csvColumns = map[string]func(bk *library.Book, value string) error{
+	"isbn": func(bk *library.Book, value string) error {
+		bk.Isbn = value
+		return nil
+	},
+	"title": func(bk *library.Book, value string) error {
+		bk.Title = value
+		return nil
+	},
+	"author": func(bk *library.Book, value string) error {
+		bk.Author = value
+		return nil
+	},
+	"book_type": func(bk *library.Book, value string) error {
+		if value == "" {
+			return nil
+		}
+		t, ok := library.BookType_value[strings.ToUpper(value)]
+		if !ok {
+			return fmt.Errorf("unknown book type %q", value)
+		}
+		bk.BookType = library.BookType(t)
+		return nil
+	},
+	"publisher": func(bk *library.Book, value string) error {
+		if value == "" {
+			return nil
+		}
+		if bk.GetSelfPublished() {
+			return errors.New("a self published book can't have a publisher")
+		}
+		bk.PublishingMethod = &library.Book_Publisher{
+			Publisher: &library.Publisher{Name: value},
+		}
+		return nil
+	},
+	"self_published": func(bk *library.Book, value string) error {
+		if value == "" {
+			return nil
+		}
+		selfPublished, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid self_published %q", value)
+		}
+		if !selfPublished {
+			return nil
+		}
+		if bk.GetPublisher() != nil {
+			return errors.New("a self published book can't have a publisher")
+		}
+		bk.PublishingMethod = &library.Book_SelfPublished{SelfPublished: true}
+		return nil
+	},
+	"publication_date": func(bk *library.Book, value string) error {
+		if value == "" {
+			return nil
+		}
+		t, err := parseDate(value)
+		if err != nil {
+			return err
+		}
+		bk.PublicationDate, err = ptypes.TimestampProto(t)
+		return err
+	},
+	"copies": func(bk *library.Book, value string) error {
+		if value == "" {
+			return nil
+		}
+		copies, err := strconv.ParseInt(value, 10, 32)
+		if err != nil || copies < 0 {
+			return fmt.Errorf("invalid copies %q", value)
+		}
+		bk.Copies = int32(copies)
+		return nil
+	},
+	"language_code": func(bk *library.Book, value string) error {
+		bk.LanguageCode = value
+		return nil
+	},
+	"original_isbn": func(bk *library.Book, value string) error {
+		bk.OriginalIsbn = value
+		return nil
+	},
+	"subject_codes": func(bk *library.Book, value string) error {
+		bk.SubjectCodes = nil
+		for _, code := range strings.Split(value, ";") {
+			if code = strings.TrimSpace(code); code != "" {
+				bk.SubjectCodes = append(bk.SubjectCodes, code)
+			}
+		}
+		return nil
+	},
+	"description": func(bk *library.Book, value string) error {
+		bk.Description = value
+		return nil
+	},
+}
+
+// ReadCSV reads Books from a CSV file. The first row must name the
+// columns, which may be any of isbn, title, author, book_type,
+// publisher, self_published, publication_date, copies, language_code,
+// original_isbn, subject_codes and description, in any order.
+// Publication dates are formatted as YYYY-MM-DD or RFC 3339, and
+// multiple authors and subject codes are separated by semicolons.
+func ReadCSV(r io.Reader, fn func(Record) error) error {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	setters := make([]func(*library.Book, string) error, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		setter, ok := csvColumns[name]
+		if !ok {
+			return fmt.Errorf("unknown column %q", name)
+		}
+		setters[i] = setter
+	}
+
+	for row := 2; ; row++ {
+		values, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		}
+
+		rec := Record{Pos: fmt.Sprintf("row %d", row)}
+		if err != nil {
+			// Field count errors only affect the row
+			if _, ok := err.(*csv.ParseError); !ok {
+				return err
+			}
+			rec.Err = err
+		} else {
+			bk := &library.Book{}
+			for i, value := range values {
+				rec.Err = setters[i](bk, strings.TrimSpace(value))
+				if rec.Err != nil {
+					rec.Err = fmt.Errorf("column %q: %v", header[i], rec.Err)
+					break
+				}
+			}
+			if rec.Err == nil {
+				rec.Book = bk
+			}
+		}
+
+		err = fn(rec)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// parseDate parses a date formatted as YYYY-MM-DD or RFC 3339.
+func parseDate(value string) (time.Time, error) {
+	t, err := time.Parse("2006-01-02", value)
+	if err == nil {
+		return t, nil
+	}
+	t, err = time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q", value)
+	}
+	return t, nil
+}
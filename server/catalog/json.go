@@ -0,0 +1,83 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package catalog
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"unicode"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// ReadJSON reads Books in the proto3 JSON mapping. The input may
+// be a JSON array of Books, or a stream of Books separated by whitespace,
+// such as newline delimited JSON. Unknown fields are errors.
+func ReadJSON(r io.Reader, fn func(Record) error) error {
+	br := bufio.NewReader(r)
+	isArray, err := startsWithArray(br)
+	if err != nil {
+		return err
+	}
+
+	dec := json.NewDecoder(br)
+	if isArray {
+		// Consume the opening bracket
+		_, err = dec.Token()
+		if err != nil {
+			return err
+		}
+	}
+
+	u := jsonpb.Unmarshaler{}
+	for n := 1; ; n++ {
+		if isArray && !dec.More() {
+			break
+		}
+		var raw json.RawMessage
+		err := dec.Decode(&raw)
+		if err == io.EOF && !isArray {
+			break
+		}
+		if err != nil {
+			// Syntax errors leave the decoder in an unknown state
+			return fmt.Errorf("book %d: %v", n, err)
+		}
+
+		rec := Record{Pos: fmt.Sprintf("book %d", n)}
+		bk := &library.Book{}
+		rec.Err = u.Unmarshal(bytes.NewReader(raw), bk)
+		if rec.Err == nil {
+			rec.Book = bk
+		}
+		err = fn(rec)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// startsWithArray reports whether the first non-space
+// character of r is the start of a JSON array.
+func startsWithArray(r *bufio.Reader) (bool, error) {
+	for {
+		c, _, err := r.ReadRune()
+		if err == io.EOF {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if !unicode.IsSpace(c) {
+			return c == '[', r.UnreadRune()
+		}
+	}
+}
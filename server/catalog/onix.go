@@ -0,0 +1,216 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package catalog
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// ONIX code list values used when reading products.
+// See https://www.editeur.org/14/Code-Lists/.
+const (
+	onixIDTypeISBN10       = "02"  // List 5
+	onixIDTypeISBN13       = "15"  // List 5
+	onixTitleTypeDistinct  = "01"  // List 15
+	onixRoleAuthor         = "A01" // List 17
+	onixDateRolePublished  = "01"  // List 163
+	onixDateFormatYYYYMMDD = "00"  // List 55
+	onixDateFormatYYYYMM   = "01"  // List 55
+	onixDateFormatYYYY     = "05"  // List 55
+)
+
+// onixProduct is the subset of an ONIX 3.0 Product
+// record that maps to a Book.
+type onixProduct struct {
+	RecordReference    string `xml:"RecordReference"`
+	ProductIdentifiers []struct {
+		ProductIDType string `xml:"ProductIDType"`
+		IDValue       string `xml:"IDValue"`
+	} `xml:"ProductIdentifier"`
+	DescriptiveDetail struct {
+		ProductForm  string `xml:"ProductForm"`
+		TitleDetails []struct {
+			TitleType     string `xml:"TitleType"`
+			TitleElements []struct {
+				TitleText          string `xml:"TitleText"`
+				TitlePrefix        string `xml:"TitlePrefix"`
+				TitleWithoutPrefix string `xml:"TitleWithoutPrefix"`
+				Subtitle           string `xml:"Subtitle"`
+			} `xml:"TitleElement"`
+		} `xml:"TitleDetail"`
+		Contributors []struct {
+			ContributorRoles []string `xml:"ContributorRole"`
+			PersonName       string   `xml:"PersonName"`
+			CorporateName    string   `xml:"CorporateName"`
+		} `xml:"Contributor"`
+	} `xml:"DescriptiveDetail"`
+	PublishingDetail struct {
+		Publishers []struct {
+			PublisherName string `xml:"PublisherName"`
+		} `xml:"Publisher"`
+		PublishingDates []struct {
+			PublishingDateRole string `xml:"PublishingDateRole"`
+			Date               struct {
+				Format string `xml:"dateformat,attr"`
+				Value  string `xml:",chardata"`
+			} `xml:"Date"`
+		} `xml:"PublishingDate"`
+	} `xml:"PublishingDetail"`
+}
+
+// ReadONIX reads Books from the Product records of an ONIX for Books 3.0
+// message using reference tag names. Products are mapped to Books using
+// their ISBN, distinctive title, first author, product form, first publisher
+// and publication date. Products without a publisher are self published.
+// Product forms starting with BB are hardcovers, BC are paperbacks and
+// A are audiobooks. Other product forms are errors.
+func ReadONIX(r io.Reader, fn func(Record) error) error {
+	dec := xml.NewDecoder(r)
+	for n := 1; ; {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok || start.Name.Local != "Product" {
+			continue
+		}
+
+		var p onixProduct
+		err = dec.DecodeElement(&p, &start)
+		if err != nil {
+			return fmt.Errorf("product %d: %v", n, err)
+		}
+
+		rec := Record{Pos: fmt.Sprintf("product %d", n)}
+		if p.RecordReference != "" {
+			rec.Pos += " (" + p.RecordReference + ")"
+		}
+		rec.Book, rec.Err = p.book()
+		err = fn(rec)
+		if err != nil {
+			return err
+		}
+		n++
+	}
+}
+
+func (p *onixProduct) book() (*library.Book, error) {
+	bk := &library.Book{}
+
+	for _, id := range p.ProductIdentifiers {
+		switch id.ProductIDType {
+		case onixIDTypeISBN13:
+			bk.Isbn = id.IDValue
+		case onixIDTypeISBN10:
+			if bk.Isbn == "" {
+				bk.Isbn = id.IDValue
+			}
+		}
+	}
+	if bk.Isbn == "" {
+		return nil, errors.New("no ISBN product identifier")
+	}
+
+	for _, td := range p.DescriptiveDetail.TitleDetails {
+		if td.TitleType != onixTitleTypeDistinct || len(td.TitleElements) == 0 {
+			continue
+		}
+		te := td.TitleElements[0]
+		bk.Title = te.TitleText
+		if bk.Title == "" {
+			bk.Title = strings.TrimSpace(te.TitlePrefix + " " + te.TitleWithoutPrefix)
+		}
+		if te.Subtitle != "" {
+			bk.Title += ": " + te.Subtitle
+		}
+		break
+	}
+
+	var authors []string
+	for _, c := range p.DescriptiveDetail.Contributors {
+		for _, role := range c.ContributorRoles {
+			if role == onixRoleAuthor {
+				name := c.PersonName
+				if name == "" {
+					name = c.CorporateName
+				}
+				authors = append(authors, name)
+				break
+			}
+		}
+	}
+	bk.Author = strings.Join(authors, AuthorSeparator)
+
+	switch form := p.DescriptiveDetail.ProductForm; {
+	case strings.HasPrefix(form, "BB"):
+		bk.BookType = library.BookType_HARDCOVER
+	case strings.HasPrefix(form, "BC"):
+		bk.BookType = library.BookType_PAPERBACK
+	case strings.HasPrefix(form, "A"):
+		bk.BookType = library.BookType_AUDIOBOOK
+	default:
+		return nil, fmt.Errorf("unsupported product form %q", form)
+	}
+
+	bk.PublishingMethod = &library.Book_SelfPublished{SelfPublished: true}
+	for _, pub := range p.PublishingDetail.Publishers {
+		if pub.PublisherName != "" {
+			bk.PublishingMethod = &library.Book_Publisher{
+				Publisher: &library.Publisher{Name: pub.PublisherName},
+			}
+			break
+		}
+	}
+
+	for _, pd := range p.PublishingDetail.PublishingDates {
+		if pd.PublishingDateRole != onixDateRolePublished {
+			continue
+		}
+		t, err := parseONIXDate(pd.Date.Format, strings.TrimSpace(pd.Date.Value))
+		if err != nil {
+			return nil, err
+		}
+		bk.PublicationDate, err = ptypes.TimestampProto(t)
+		if err != nil {
+			return nil, err
+		}
+		break
+	}
+
+	return bk, nil
+}
+
+// parseONIXDate parses an ONIX date in one of
+// the formats of code list 55 that have a day, month or year.
+func parseONIXDate(format, value string) (time.Time, error) {
+	layout := ""
+	switch format {
+	case "", onixDateFormatYYYYMMDD:
+		layout = "20060102"
+	case onixDateFormatYYYYMM:
+		layout = "200601"
+	case onixDateFormatYYYY:
+		layout = "2006"
+	default:
+		return time.Time{}, fmt.Errorf("unsupported date format %q", format)
+	}
+	t, err := time.Parse(layout, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid publication date %q", value)
+	}
+	return t, nil
+}
@@ -0,0 +1,287 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package catalog
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/ptypes"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// Writer writes Books to a file in a catalog format.
+type Writer interface {
+	// Write writes a Book.
+	Write(bk *library.Book) error
+	// Close writes the end of the file and flushes any buffered
+	// data. It does not close the underlying io.Writer.
+	Close() error
+}
+
+// NewWriter returns a Writer writing Books to w in format f.
+func NewWriter(w io.Writer, f Format) (Writer, error) {
+	switch f {
+	case CSV:
+		return newCSVWriter(w), nil
+	case JSON:
+		return &jsonWriter{w: w, m: jsonpb.Marshaler{OrigName: true}}, nil
+	case BibTeX:
+		return &bibtexWriter{w: w}, nil
+	case RIS:
+		return &risWriter{w: w}, nil
+	}
+	return nil, fmt.Errorf("can't write catalog format %q", f)
+}
+
+// csvHeader is the header row written by CSV Writers.
+// It can be read back by ReadCSV.
+var csvHeader = []string{
+	"isbn", "title", "author", "book_type",
+	"publisher", "self_published", "publication_date",
+	"copies", "language_code", "original_isbn",
+	"subject_codes", "description",
+}
+
+type csvWriter struct {
+	w           *csv.Writer
+	wroteHeader bool
+}
+
+func newCSVWriter(w io.Writer) *csvWriter {
+	return &csvWriter{w: csv.NewWriter(w)}
+}
+
+func (c *csvWriter) writeHeader() error {
+	if c.wroteHeader {
+		return nil
+	}
+	c.wroteHeader = true
+	return c.w.Write(csvHeader)
+}
+
+func (c *csvWriter) Write(bk *library.Book) error {
+	err := c.writeHeader()
+	if err != nil {
+		return err
+	}
+	date, err := formatDate(bk)
+	if err != nil {
+		return err
+	}
+	return c.w.Write([]string{
+		bk.GetIsbn(),
+		bk.GetTitle(),
+		bk.GetAuthor(),
+		bk.GetBookType().String(),
+		bk.GetPublisher().GetName(),
+		strconv.FormatBool(bk.GetSelfPublished()),
+		date,
+		strconv.FormatInt(int64(bk.GetCopies()), 10),
+		bk.GetLanguageCode(),
+		bk.GetOriginalIsbn(),
+		strings.Join(bk.GetSubjectCodes(), ";"),
+		bk.GetDescription(),
+	})
+}
+
+func (c *csvWriter) Close() error {
+	err := c.writeHeader()
+	if err != nil {
+		return err
+	}
+	c.w.Flush()
+	return c.w.Error()
+}
+
+type jsonWriter struct {
+	w       io.Writer
+	m       jsonpb.Marshaler
+	started bool
+	buf     bytes.Buffer
+}
+
+func (j *jsonWriter) Write(bk *library.Book) error {
+	var b bytes.Buffer
+	err := j.m.Marshal(&b, bk)
+	if err != nil {
+		return err
+	}
+
+	j.buf.Reset()
+	if j.started {
+		j.buf.WriteString(",\n  ")
+	} else {
+		j.buf.WriteString("[\n  ")
+		j.started = true
+	}
+	err = json.Compact(&j.buf, b.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = j.w.Write(j.buf.Bytes())
+	return err
+}
+
+func (j *jsonWriter) Close() error {
+	end := "\n]\n"
+	if !j.started {
+		end = "[\n]\n"
+	}
+	_, err := io.WriteString(j.w, end)
+	return err
+}
+
+type bibtexWriter struct {
+	w io.Writer
+}
+
+// bibtexEscaper escapes the characters that
+// are special in BibTeX field values.
+var bibtexEscaper = strings.NewReplacer(
+	`\`, `\textbackslash{}`,
+	`{`, `\{`,
+	`}`, `\}`,
+	`&`, `\&`,
+	`%`, `\%`,
+	`$`, `\$`,
+	`#`, `\#`,
+	`_`, `\_`,
+	`~`, `\textasciitilde{}`,
+	`^`, `\textasciicircum{}`,
+)
+
+var bibtexMonths = [...]string{
+	"jan", "feb", "mar", "apr", "may", "jun",
+	"jul", "aug", "sep", "oct", "nov", "dec",
+}
+
+func (b *bibtexWriter) Write(bk *library.Book) error {
+	var buf bytes.Buffer
+	field := func(name, value string) {
+		if value != "" {
+			fmt.Fprintf(&buf, "  %s = {%s},\n", name, bibtexEscaper.Replace(value))
+		}
+	}
+
+	fmt.Fprintf(&buf, "@book{isbn%s,\n", bk.GetIsbn())
+	field("title", bk.GetTitle())
+	field("author", strings.Join(SplitAuthors(bk.GetAuthor()), " and "))
+	field("publisher", bk.GetPublisher().GetName())
+	if bk.GetPublicationDate() != nil {
+		t, err := ptypes.Timestamp(bk.GetPublicationDate())
+		if err != nil {
+			return err
+		}
+		t = t.UTC()
+		fmt.Fprintf(&buf, "  year = %d,\n", t.Year())
+		fmt.Fprintf(&buf, "  month = %s,\n", bibtexMonths[t.Month()-1])
+	}
+	field("isbn", bk.GetIsbn())
+	if bk.GetBookType() == library.BookType_AUDIOBOOK {
+		field("note", "Audiobook")
+	}
+	buf.WriteString("}\n\n")
+
+	_, err := b.w.Write(buf.Bytes())
+	return err
+}
+
+func (b *bibtexWriter) Close() error {
+	return nil
+}
+
+type risWriter struct {
+	w io.Writer
+}
+
+// risEscaper removes line breaks, which would end a RIS tag.
+var risEscaper = strings.NewReplacer("\r\n", " ", "\r", " ", "\n", " ")
+
+func (r *risWriter) Write(bk *library.Book) error {
+	var buf bytes.Buffer
+	tag := func(name, value string) {
+		if value != "" {
+			// Tags end with CRLF as specified by RIS
+			fmt.Fprintf(&buf, "%s  - %s\r\n", name, risEscaper.Replace(value))
+		}
+	}
+
+	typ := "BOOK"
+	if bk.GetBookType() == library.BookType_AUDIOBOOK {
+		typ = "SOUND"
+	}
+	tag("TY", typ)
+	tag("TI", bk.GetTitle())
+	for _, name := range SplitAuthors(bk.GetAuthor()) {
+		tag("AU", name)
+	}
+	tag("PB", bk.GetPublisher().GetName())
+	if bk.GetPublicationDate() != nil {
+		t, err := ptypes.Timestamp(bk.GetPublicationDate())
+		if err != nil {
+			return err
+		}
+		t = t.UTC()
+		tag("PY", strconv.Itoa(t.Year()))
+		tag("DA", t.Format("2006/01/02/"))
+	}
+	tag("SN", bk.GetIsbn())
+	buf.WriteString("ER  - \r\n")
+
+	_, err := r.w.Write(buf.Bytes())
+	return err
+}
+
+func (r *risWriter) Close() error {
+	return nil
+}
+
+// formatDate formats the publication date of bk as YYYY-MM-DD,
+// or returns the empty string if it is not set.
+func formatDate(bk *library.Book) (string, error) {
+	if bk.GetPublicationDate() == nil {
+		return "", nil
+	}
+	t, err := ptypes.Timestamp(bk.GetPublicationDate())
+	if err != nil {
+		return "", err
+	}
+	return t.UTC().Format("2006-01-02"), nil
+}
+
+// ContentType returns the media type of files in format f.
+func (f Format) ContentType() string {
+	switch f {
+	case CSV:
+		return "text/csv; charset=utf-8"
+	case JSON:
+		return "application/json"
+	case ONIX:
+		return "application/xml"
+	case BibTeX:
+		return "application/x-bibtex; charset=utf-8"
+	case RIS:
+		return "application/x-research-info-systems"
+	}
+	return "application/octet-stream"
+}
+
+// Ext returns the file extension of files in format f.
+func (f Format) Ext() string {
+	switch f {
+	case ONIX:
+		return ".xml"
+	case BibTeX:
+		return ".bib"
+	}
+	return "." + string(f)
+}
@@ -0,0 +1,415 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// Metadata keys read by MakeCollection. The owner is a member
+// ID, which is also checked when a Collection is changed.
+const (
+	ownerMetadataKey          = "owner"
+	collectionNameMetadataKey = "collection-name"
+)
+
+// listKey is the position of a resource in a list ordered
+// oldest first, by creation time and then by ID.
+type listKey struct {
+	Seconds int64  `json:"s"`
+	Nanos   int32  `json:"n"`
+	ID      string `json:"i"`
+}
+
+func (k listKey) less(o listKey) bool {
+	if k.Seconds != o.Seconds {
+		return k.Seconds < o.Seconds
+	}
+	if k.Nanos != o.Nanos {
+		return k.Nanos < o.Nanos
+	}
+	return k.ID < o.ID
+}
+
+func keyOfCollection(c *library.Collection) listKey {
+	return listKey{
+		Seconds: c.GetCreateTime().GetSeconds(),
+		Nanos:   c.GetCreateTime().GetNanos(),
+		ID:      c.GetId(),
+	}
+}
+
+// collectionPageToken is the content of the page
+// tokens handed out by ListCollections.
+type collectionPageToken struct {
+	// Owner is the owner of the request that created the token.
+	// It may not change between pages.
+	Owner string `json:"o,omitempty"`
+	// Last is the key of the last Collection on the previous page.
+	Last listKey `json:"l"`
+}
+
+func (collectionPageToken) kind() string { return "collections" }
+
+func (s *BookService) MakeCollection(srv library.BookService_MakeCollectionServer) error {
+	collection := &library.Collection{}
+	cb := collectionBooks{field: "books"}
+	for i := 0; ; i++ {
+		bk, err := srv.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		err = cb.add(srv.Context(), s.store, i, bk)
+		if err != nil {
+			return err
+		}
+	}
+	err := cb.err()
+	if err != nil {
+		return err
+	}
+	collection.Books = cb.books
+
+	md, _ := metadata.FromIncomingContext(srv.Context())
+	collection.Owner = firstMetadataValue(md, ownerMetadataKey)
+	collection.Name = firstMetadataValue(md, collectionNameMetadataKey)
+	collection.CreateTime = ptypes.TimestampNow()
+	collection.Id, err = newID("collection")
+	if err != nil {
+		return err
+	}
+
+	if collection.GetOwner() != "" {
+		// Keep the owner from being deleted until the collection is stored
+		err = s.members.UseMember(srv.Context(), collection.GetOwner(), func(*library.Member) error {
+			return s.collections.AddCollection(srv.Context(), collection)
+		})
+		if status.Code(err) == codes.NotFound {
+			return status.Errorf(codes.InvalidArgument, "The owner %q is not a member", collection.GetOwner())
+		}
+	} else {
+		err = s.collections.AddCollection(srv.Context(), collection)
+	}
+	if err != nil {
+		return err
+	}
+	s.recollect(srv.Context(), collection.GetId())
+	resolved, err := s.resolveCollection(srv.Context(), collection)
+	if err != nil {
+		return err
+	}
+	return srv.SendAndClose(resolved)
+}
+
+func (s *BookService) GetCollection(ctx context.Context, req *library.GetCollectionRequest) (*library.Collection, error) {
+	collection, err := s.collections.GetCollection(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return s.resolveCollection(ctx, collection)
+}
+
+func (s *BookService) ListCollections(ctx context.Context, req *library.ListCollectionsRequest) (*library.ListCollectionsResponse, error) {
+	pageSize, err := parsePageSize(req.GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+
+	var last *listKey
+	if req.GetPageToken() != "" {
+		var token collectionPageToken
+		if !decodeToken(s.tokenKey, req.GetPageToken(), &token) {
+			return nil, status.Error(codes.InvalidArgument, "Invalid page token")
+		}
+		if token.Owner != req.GetOwner() {
+			return nil, status.Error(codes.InvalidArgument, "The owner must not change between pages")
+		}
+		last = &token.Last
+	}
+
+	collections, err := s.collections.QueryCollections(ctx, func(c *library.Collection) bool {
+		return (req.GetOwner() == "" || c.GetOwner() == req.GetOwner()) &&
+			(last == nil || last.less(keyOfCollection(c)))
+	})
+	if err != nil {
+		return nil, err
+	}
+	sortCollections(collections)
+
+	resp := &library.ListCollectionsResponse{}
+	if len(collections) > pageSize {
+		collections = collections[:pageSize]
+		resp.NextPageToken, err = encodeToken(s.tokenKey, collectionPageToken{
+			Owner: req.GetOwner(),
+			Last:  keyOfCollection(collections[len(collections)-1]),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, c := range collections {
+		resolved, err := s.resolveCollection(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+		resp.Collections = append(resp.Collections, resolved)
+	}
+
+	return resp, nil
+}
+
+func (s *BookService) UpdateCollection(ctx context.Context, req *library.UpdateCollectionRequest) (*library.Collection, error) {
+	mask := req.GetUpdateMask()
+	for _, path := range mask.GetPaths() {
+		switch path {
+		case "name", "books":
+		case "id", "owner", "create_time":
+			return nil, status.Errorf(codes.InvalidArgument, "The %s of a collection can't be changed", path)
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "Unknown field %q in update mask", path)
+		}
+	}
+
+	cb := collectionBooks{field: "collection.books"}
+	if updatesField(mask, "books") {
+		for i, bk := range req.GetCollection().GetBooks() {
+			err := cb.add(ctx, s.store, i, bk)
+			if err != nil {
+				return nil, err
+			}
+		}
+		err := cb.err()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	owner, err := requestOwner(ctx, s.members)
+	if err != nil {
+		return nil, err
+	}
+	collection, err := s.collections.UpdateCollection(ctx, req.GetCollection().GetId(), func(c *library.Collection) error {
+		err := checkOwner(c, owner)
+		if err != nil {
+			return err
+		}
+		if updatesField(mask, "name") {
+			c.Name = req.GetCollection().GetName()
+		}
+		if updatesField(mask, "books") {
+			c.Books = cb.books
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.recollect(ctx, collection.GetId())
+
+	return s.resolveCollection(ctx, collection)
+}
+
+func (s *BookService) DeleteCollection(ctx context.Context, req *library.DeleteCollectionRequest) (*library.Collection, error) {
+	// The owner of a Collection never changes,
+	// so it can be checked before the delete.
+	collection, err := s.collections.GetCollection(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	owner, err := requestOwner(ctx, s.members)
+	if err != nil {
+		return nil, err
+	}
+	err = checkOwner(collection, owner)
+	if err != nil {
+		return nil, err
+	}
+	collection, err = s.collections.DeleteCollection(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	s.recollect(ctx, collection.GetId())
+	return s.resolveCollection(ctx, collection)
+}
+
+// collectionBooks resolves the Books added to a Collection to their
+// canonical ISBNs, dropping duplicates, and collects a violation for
+// each ISBN that is invalid or not in the library.
+type collectionBooks struct {
+	// field is the path of the Books in the request,
+	// used in the field of violations.
+	field      string
+	books      []*library.Book
+	seen       map[string]bool
+	violations []*errdetails.BadRequest_FieldViolation
+	badIsbns   []string
+}
+
+// add adds bk, the ith Book added, unless it has already
+// been added. Only errors reading the store are returned.
+func (cb *collectionBooks) add(ctx context.Context, store BookStore, i int, bk *library.Book) error {
+	id, err := requestIsbn(bk.GetIsbn(), bk.GetLegacyIsbn())
+	if err == nil {
+		_, err = store.GetBook(ctx, id)
+	}
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.InvalidArgument, codes.NotFound:
+		// Report the ISBN field the client sent, as requestIsbn
+		// only reads the legacy ISBN if the ISBN is empty.
+		field, sent := "isbn", bk.GetIsbn()
+		if sent == "" && bk.GetLegacyIsbn() != 0 {
+			field, sent = "legacy_isbn", strconv.FormatInt(bk.GetLegacyIsbn(), 10)
+		}
+		description := status.Convert(err).Message()
+		if sent != "" {
+			description = sent + ": " + description
+		}
+		cb.violations = append(cb.violations, &errdetails.BadRequest_FieldViolation{
+			Field:       fmt.Sprintf("%s[%d].%s", cb.field, i, field),
+			Description: description,
+		})
+		cb.badIsbns = append(cb.badIsbns, sent)
+		return nil
+	default:
+		return err
+	}
+
+	// Drop duplicates, keeping the first entry
+	if cb.seen[id] {
+		return nil
+	}
+	if cb.seen == nil {
+		cb.seen = map[string]bool{}
+	}
+	cb.seen[id] = true
+	cb.books = append(cb.books, &library.Book{Isbn: id})
+	return nil
+}
+
+// err returns an InvalidArgument error with a BadRequest detail
+// listing every bad ISBN added, or nil if there were none.
+func (cb *collectionBooks) err() error {
+	if len(cb.violations) == 0 {
+		return nil
+	}
+	st, err := status.New(codes.InvalidArgument,
+		"Unknown or invalid ISBNs: "+strings.Join(cb.badIsbns, ", "),
+	).WithDetails(&errdetails.BadRequest{FieldViolations: cb.violations})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to add error details: %v", err)
+	}
+	return st.Err()
+}
+
+// requestOwner returns the member ID in the owner metadata
+// of the request, if any. It returns an Unauthenticated error
+// if the ID is not that of a member in members.
+//
+// This is not authentication: the metadata is set by the client,
+// which may name any member. It only keeps collections from being
+// changed in the name of owners that don't exist.
+func requestOwner(ctx context.Context, members MemberStore) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	owner := firstMetadataValue(md, ownerMetadataKey)
+	if owner == "" {
+		return "", nil
+	}
+	_, err := members.GetMember(ctx, owner)
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.NotFound:
+		return "", status.Errorf(codes.Unauthenticated, "Unknown owner %q", owner)
+	default:
+		return "", err
+	}
+	return owner, nil
+}
+
+// checkOwner returns a PermissionDenied error if c has an owner
+// other than owner. Collections without an owner may be changed
+// by anyone.
+func checkOwner(c *library.Collection, owner string) error {
+	if c.GetOwner() != "" && c.GetOwner() != owner {
+		return status.Error(codes.PermissionDenied, "Only the owner can change the collection")
+	}
+	return nil
+}
+
+// resolveCollection returns a copy of the stored Collection
+// with the current version of its Books from the BookStore.
+// Books that are no longer in the library only have their ISBN set.
+func (s *BookService) resolveCollection(ctx context.Context, collection *library.Collection) (*library.Collection, error) {
+	resolved := cloneCollection(collection)
+	for i, bk := range resolved.GetBooks() {
+		stored, err := s.store.GetBook(ctx, bk.GetIsbn())
+		switch status.Code(err) {
+		case codes.OK:
+			s.localize(ctx, stored)
+			resolved.Books[i] = stored
+		case codes.NotFound:
+		default:
+			return nil, err
+		}
+	}
+	return resolved, nil
+}
+
+// updatesField reports whether mask updates the field at path.
+// An empty mask updates all fields.
+func updatesField(mask *field_mask.FieldMask, path string) bool {
+	if len(mask.GetPaths()) == 0 {
+		return true
+	}
+	for _, p := range mask.GetPaths() {
+		if p == path {
+			return true
+		}
+	}
+	return false
+}
+
+// sortCollections sorts collections oldest first.
+func sortCollections(collections []*library.Collection) {
+	sort.Slice(collections, func(i, j int) bool {
+		return keyOfCollection(collections[i]).less(keyOfCollection(collections[j]))
+	})
+}
+
+// newID returns a random ID for a new resource of the kind named.
+func newID(kind string) (string, error) {
+	b := make([]byte, 8)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to generate %s ID: %v", kind, err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// firstMetadataValue returns the first value of key in md, if any.
+func firstMetadataValue(md metadata.MD, key string) string {
+	if vs := md[key]; len(vs) > 0 {
+		return vs[0]
+	}
+	return ""
+}
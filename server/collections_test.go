@@ -0,0 +1,196 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// makeCollectionStream sends books to MakeCollection
+// and records the Collection it responds with.
+type makeCollectionStream struct {
+	testServerStream
+	books      []*library.Book
+	collection *library.Collection
+}
+
+func (s *makeCollectionStream) Recv() (*library.Book, error) {
+	if len(s.books) == 0 {
+		return nil, io.EOF
+	}
+	bk := s.books[0]
+	s.books = s.books[1:]
+	return bk, nil
+}
+
+func (s *makeCollectionStream) SendAndClose(c *library.Collection) error {
+	s.collection = c
+	return nil
+}
+
+// makeCollection calls MakeCollection with the books
+// provided and the request metadata in pairs.
+func makeCollection(s *BookService, books []*library.Book, pairs ...string) (*library.Collection, error) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(pairs...))
+	stream := &makeCollectionStream{
+		testServerStream: testServerStream{ctx: ctx},
+		books:            books,
+	}
+	err := s.MakeCollection(stream)
+	return stream.collection, err
+}
+
+func TestMakeCollection(t *testing.T) {
+	s, _ := newTestBookService()
+	collection, err := makeCollection(s, []*library.Book{
+		{Isbn: "0-14-000972-8"},
+		{LegacyIsbn: 60929871},
+		// The same book as the first
+		{Isbn: "9780140009729"},
+	}, "collection-name", "Dystopias")
+	if err != nil {
+		t.Fatalf("MakeCollection returned error: %v", err)
+	}
+	if collection.GetId() == "" || collection.GetName() != "Dystopias" {
+		t.Errorf("MakeCollection returned ID %q and name %q, want an ID and Dystopias", collection.GetId(), collection.GetName())
+	}
+	want := []string{"Nineteen Eighty-Four", "Brave New World"}
+	if got := bookTitles(collection.GetBooks()); !equalStrings(got, want) {
+		t.Errorf("MakeCollection returned books %q, want %q", got, want)
+	}
+
+	stored, err := s.GetCollection(context.Background(), &library.GetCollectionRequest{Id: collection.GetId()})
+	if err != nil {
+		t.Fatalf("GetCollection returned error: %v", err)
+	}
+	if got := bookTitles(stored.GetBooks()); !equalStrings(got, want) {
+		t.Errorf("GetCollection returned books %q, want %q", got, want)
+	}
+}
+
+// fieldViolations returns the field violations
+// of the BadRequest details of err.
+func fieldViolations(err error) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+	for _, d := range status.Convert(err).Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			violations = append(violations, br.GetFieldViolations()...)
+		}
+	}
+	return violations
+}
+
+func TestMakeCollectionBadRequest(t *testing.T) {
+	s, _ := newTestBookService()
+	_, err := makeCollection(s, []*library.Book{
+		{Isbn: "9780140009729"},
+		{Isbn: "0140009729"},
+		{},
+		{Isbn: "9780306406157"},
+		{LegacyIsbn: 9780306406157},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("MakeCollection returned error %v, want InvalidArgument", err)
+	}
+
+	violations := fieldViolations(err)
+	want := []struct {
+		field, sent string
+	}{
+		{"books[1].isbn", "0140009729"},
+		{"books[2].isbn", ""},
+		{"books[3].isbn", "9780306406157"},
+		{"books[4].legacy_isbn", "9780306406157"},
+	}
+	if len(violations) != len(want) {
+		t.Fatalf("MakeCollection returned violations %v, want %d", violations, len(want))
+	}
+	for i, v := range violations {
+		if v.GetField() != want[i].field || v.GetDescription() == "" || !strings.HasPrefix(v.GetDescription(), want[i].sent) {
+			t.Errorf("violation %d is %v, want %s describing %q", i, v, want[i].field, want[i].sent)
+		}
+	}
+
+	// No collection is stored
+	resp, err := s.ListCollections(context.Background(), &library.ListCollectionsRequest{})
+	if err != nil {
+		t.Fatalf("ListCollections returned error: %v", err)
+	}
+	if len(resp.GetCollections()) != 0 {
+		t.Errorf("ListCollections returned %d collections, want none", len(resp.GetCollections()))
+	}
+}
+
+func TestMakeCollectionOwner(t *testing.T) {
+	ctx := context.Background()
+	members := &MemoryMemberStore{}
+	s, _ := newTestBookService(WithMemberStore(members))
+	m := &library.Member{Id: "member", CardNumber: "1", Name: "Member"}
+	err := members.AddMember(ctx, m)
+	if err != nil {
+		t.Fatalf("AddMember returned error: %v", err)
+	}
+
+	_, err = makeCollection(s, []*library.Book{{Isbn: "9780140009729"}}, "owner", "stranger")
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("MakeCollection by a non-member returned error %v, want InvalidArgument", err)
+	}
+	collection, err := makeCollection(s, []*library.Book{{Isbn: "9780140009729"}}, "owner", "member")
+	if err != nil {
+		t.Fatalf("MakeCollection returned error: %v", err)
+	}
+
+	// Only the owner may change the collection
+	update := &library.UpdateCollectionRequest{
+		Collection: &library.Collection{Id: collection.GetId(), Name: "Renamed"},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"name"}},
+	}
+	_, err = s.UpdateCollection(ctx, update)
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("UpdateCollection without the owner returned error %v, want PermissionDenied", err)
+	}
+	strangerCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("owner", "stranger"))
+	_, err = s.UpdateCollection(strangerCtx, update)
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("UpdateCollection by a non-member returned error %v, want Unauthenticated", err)
+	}
+	_, err = s.DeleteCollection(strangerCtx, &library.DeleteCollectionRequest{Id: collection.GetId()})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("DeleteCollection by a non-member returned error %v, want Unauthenticated", err)
+	}
+	ownerCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("owner", "member"))
+	updated, err := s.UpdateCollection(ownerCtx, update)
+	if err != nil {
+		t.Fatalf("UpdateCollection returned error: %v", err)
+	}
+	if updated.GetName() != "Renamed" || updated.GetOwner() != "member" {
+		t.Errorf("UpdateCollection returned name %q and owner %q, want Renamed and member", updated.GetName(), updated.GetOwner())
+	}
+	_, err = s.UpdateCollection(ownerCtx, &library.UpdateCollectionRequest{
+		Collection: &library.Collection{Id: collection.GetId(), Books: []*library.Book{{LegacyIsbn: 1234}}},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"books"}},
+	})
+	if v := fieldViolations(err); len(v) != 1 || v[0].GetField() != "collection.books[0].legacy_isbn" {
+		t.Errorf("UpdateCollection with a bad legacy ISBN returned violations %v, want collection.books[0].legacy_isbn", v)
+	}
+	_, err = s.DeleteCollection(ctx, &library.DeleteCollectionRequest{Id: collection.GetId()})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("DeleteCollection without the owner returned error %v, want PermissionDenied", err)
+	}
+	_, err = s.DeleteCollection(ownerCtx, &library.DeleteCollectionRequest{Id: collection.GetId()})
+	if err != nil {
+		t.Errorf("DeleteCollection returned error: %v", err)
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// CollectionStore is the storage backend for the Collections
+// of the BookService. Collections reference Books by ISBN,
+// so only the Isbn of the Books in a Collection needs to be stored.
+// Implementations must be safe for concurrent use.
+// Errors returned should be gRPC status errors, as they
+// are passed on to the client unchanged.
+type CollectionStore interface {
+	// GetCollection returns the Collection with the ID provided.
+	// If no such Collection exists, it returns a NotFound error.
+	GetCollection(ctx context.Context, id string) (*library.Collection, error)
+	// QueryCollections returns all Collections for which match
+	// returns true, in the order they were added to the store.
+	QueryCollections(ctx context.Context, match func(*library.Collection) bool) ([]*library.Collection, error)
+	// AddCollection stores the Collection provided. If a Collection with
+	// the same ID already exists, it returns an AlreadyExists error.
+	AddCollection(ctx context.Context, collection *library.Collection) error
+	// UpdateCollection calls update with the Collection with the ID
+	// provided and stores the result, atomically with respect to other
+	// writes. If update returns an error, the Collection is left unchanged
+	// and the error is returned. If no such Collection exists,
+	// it returns a NotFound error.
+	UpdateCollection(ctx context.Context, id string, update func(*library.Collection) error) (*library.Collection, error)
+	// DeleteCollection removes the Collection with the ID provided
+	// and returns it. If no such Collection exists, it returns
+	// a NotFound error.
+	DeleteCollection(ctx context.Context, id string) (*library.Collection, error)
+}
+
+// MemoryCollectionStore is an in-memory CollectionStore.
+// The zero value is an empty store ready to use.
+type MemoryCollectionStore struct {
+	mu          sync.RWMutex
+	collections []*library.Collection
+	index       map[string]int
+}
+
+// GetCollection implements CollectionStore.
+func (s *MemoryCollectionStore) GetCollection(ctx context.Context, id string) (*library.Collection, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	i, ok := s.index[id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "Collection could not be found")
+	}
+	return cloneCollection(s.collections[i]), nil
+}
+
+// QueryCollections implements CollectionStore.
+func (s *MemoryCollectionStore) QueryCollections(ctx context.Context, match func(*library.Collection) bool) ([]*library.Collection, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var cs []*library.Collection
+	for _, c := range s.collections {
+		if match(c) {
+			cs = append(cs, cloneCollection(c))
+		}
+	}
+	return cs, nil
+}
+
+// AddCollection implements CollectionStore.
+func (s *MemoryCollectionStore) AddCollection(ctx context.Context, collection *library.Collection) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.index == nil {
+		s.index = map[string]int{}
+	}
+	if _, ok := s.index[collection.GetId()]; ok {
+		return status.Errorf(codes.AlreadyExists, "A collection with ID %s already exists", collection.GetId())
+	}
+	s.index[collection.GetId()] = len(s.collections)
+	s.collections = append(s.collections, cloneCollection(collection))
+	return nil
+}
+
+// UpdateCollection implements CollectionStore.
+func (s *MemoryCollectionStore) UpdateCollection(ctx context.Context, id string, update func(*library.Collection) error) (*library.Collection, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	i, ok := s.index[id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "Collection could not be found")
+	}
+	c := cloneCollection(s.collections[i])
+	err := update(c)
+	if err != nil {
+		return nil, err
+	}
+	if c.GetId() != id {
+		return nil, status.Error(codes.InvalidArgument, "The ID of a collection can't be changed")
+	}
+	s.collections[i] = cloneCollection(c)
+	return c, nil
+}
+
+// DeleteCollection implements CollectionStore.
+func (s *MemoryCollectionStore) DeleteCollection(ctx context.Context, id string) (*library.Collection, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	i, ok := s.index[id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "Collection could not be found")
+	}
+	c := s.collections[i]
+	s.collections = append(s.collections[:i], s.collections[i+1:]...)
+	delete(s.index, id)
+	for j := i; j < len(s.collections); j++ {
+		s.index[s.collections[j].GetId()] = j
+	}
+	return c, nil
+}
+
+// cloneCollection returns a deep copy of c, so that callers
+// can't modify the contents of the store.
+func cloneCollection(c *library.Collection) *library.Collection {
+	return proto.Clone(c).(*library.Collection)
+}
@@ -0,0 +1,182 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// maxBarcodeLength is the maximum length of the barcode of a Copy.
+const maxBarcodeLength = 32
+
+func (s *LendingService) AddCopy(ctx context.Context, req *library.AddCopyRequest) (*library.Copy, error) {
+	ctx, err := requestActor(ctx, s.members)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetCopy() == nil {
+		return nil, status.Error(codes.InvalidArgument, "A copy must be provided")
+	}
+	err = validateBarcode(req.GetCopy().GetBarcode())
+	if err != nil {
+		return nil, err
+	}
+	id, err := requestIsbn(req.GetCopy().GetIsbn(), 0)
+	if err != nil {
+		return nil, err
+	}
+	err = s.checkBranch(ctx, req.GetCopy().GetBranchId())
+	if err != nil {
+		return nil, err
+	}
+
+	c := &library.Copy{
+		Barcode:  req.GetCopy().GetBarcode(),
+		Isbn:     id,
+		BranchId: req.GetCopy().GetBranchId(),
+		State:    library.Copy_AVAILABLE,
+	}
+	_, err = s.store.UpdateLending(ctx, id, func(l *Lending) error {
+		l.Copies = append(l.Copies, c)
+		l.Book.Copies++
+		l.Book.AvailableCopies++
+		// Offer the new copy to the next hold
+		return s.settleHolds(l, time.Now())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (s *LendingService) GetCopy(ctx context.Context, req *library.GetCopyRequest) (*library.Copy, error) {
+	return s.store.GetCopy(ctx, req.GetBarcode())
+}
+
+func (s *LendingService) TransferCopy(ctx context.Context, req *library.TransferCopyRequest) (*library.Copy, error) {
+	ctx, err := requestActor(ctx, s.members)
+	if err != nil {
+		return nil, err
+	}
+	err = s.checkBranch(ctx, req.GetBranchId())
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	transferTime, err := ptypes.TimestampProto(now)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "invalid transfer time: %v", err)
+	}
+
+	return s.updateCopy(ctx, req.GetBarcode(), func(l *Lending, c *library.Copy) error {
+		err := s.settleHolds(l, now)
+		if err != nil {
+			return err
+		}
+		switch {
+		case c.GetState() == library.Copy_RESERVED:
+			return status.Error(codes.FailedPrecondition, "The copy is set aside for a hold")
+		case c.GetState() != library.Copy_AVAILABLE:
+			return status.Error(codes.FailedPrecondition, "Only available copies can be transferred")
+		case c.GetBranchId() == req.GetBranchId():
+			return status.Error(codes.FailedPrecondition, "The copy is already at the branch")
+		case l.Book.GetAvailableCopies() <= 0:
+			return status.Error(codes.FailedPrecondition, "The copy is set aside for a hold")
+		}
+		c.State = library.Copy_IN_TRANSIT
+		c.DestinationBranchId = req.GetBranchId()
+		c.TransferTime = transferTime
+		l.Book.AvailableCopies--
+		return nil
+	})
+}
+
+func (s *LendingService) ReceiveCopy(ctx context.Context, req *library.ReceiveCopyRequest) (*library.Copy, error) {
+	ctx, err := requestActor(ctx, s.members)
+	if err != nil {
+		return nil, err
+	}
+	return s.updateCopy(ctx, req.GetBarcode(), func(l *Lending, c *library.Copy) error {
+		if c.GetState() != library.Copy_IN_TRANSIT {
+			return status.Error(codes.FailedPrecondition, "The copy is not in transit")
+		}
+		c.State = library.Copy_AVAILABLE
+		c.BranchId = c.GetDestinationBranchId()
+		c.DestinationBranchId = ""
+		c.TransferTime = nil
+		l.Book.AvailableCopies++
+		// Offer the copy to the next hold
+		return s.settleHolds(l, time.Now())
+	})
+}
+
+// updateCopy calls update with the Lending of the Book the Copy with
+// the barcode provided is of, and the Copy, and stores the result.
+func (s *LendingService) updateCopy(ctx context.Context, barcode string, update func(*Lending, *library.Copy) error) (*library.Copy, error) {
+	c, err := s.store.GetCopy(ctx, barcode)
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.store.UpdateLending(ctx, c.GetIsbn(), func(l *Lending) error {
+		cp := findCopy(l, barcode)
+		if cp == nil {
+			return status.Error(codes.NotFound, "Copy could not be found")
+		}
+		c = cp
+		return update(l, cp)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// findCopy returns the Copy of l with the barcode
+// provided, or nil if there is no such Copy.
+func findCopy(l *Lending, barcode string) *library.Copy {
+	for _, c := range l.Copies {
+		if c.GetBarcode() == barcode {
+			return c
+		}
+	}
+	return nil
+}
+
+// availableBarcode returns the barcode of the first available
+// Copy of l, or the empty string if no Copy is available.
+func availableBarcode(l *Lending) string {
+	for _, c := range l.Copies {
+		if c.GetState() == library.Copy_AVAILABLE {
+			return c.GetBarcode()
+		}
+	}
+	return ""
+}
+
+// validateBarcode returns an InvalidArgument error
+// if barcode can't be used to identify a Copy.
+func validateBarcode(barcode string) error {
+	switch {
+	case barcode == "":
+		return status.Error(codes.InvalidArgument, "The barcode must not be empty")
+	case len(barcode) > maxBarcodeLength:
+		return status.Errorf(codes.InvalidArgument, "The barcode must be at most %d characters", maxBarcodeLength)
+	}
+	for _, r := range barcode {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-':
+		default:
+			return status.Error(codes.InvalidArgument, "The barcode may only contain letters, digits and dashes")
+		}
+	}
+	return nil
+}
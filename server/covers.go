@@ -0,0 +1,260 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif" // Accept GIF covers
+	"image/jpeg"
+	_ "image/png" // Accept PNG covers
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/blob"
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+const (
+	// maxCoverSize is the maximum size of a cover image, in bytes.
+	maxCoverSize = 10 << 20
+	// maxCoverPixels is the maximum number of pixels of a cover image,
+	// limiting the memory used to decode it for a thumbnail.
+	maxCoverPixels = 25 << 20
+	// coverPath is the path CoverHandler is served at.
+	coverPath = "/covers/"
+)
+
+// thumbnailWidths are the widths, in pixels, of the
+// thumbnails that can be requested from CoverHandler.
+var thumbnailWidths = map[int]bool{64: true, 128: true, 256: true, 512: true}
+
+func (s *BookService) UploadCover(srv library.BookService_UploadCoverServer) error {
+	ctx, err := requestActor(srv.Context(), s.members)
+	if err != nil {
+		return err
+	}
+	first, err := srv.Recv()
+	if err == io.EOF {
+		return status.Error(codes.InvalidArgument, "A cover must be provided")
+	}
+	if err != nil {
+		return err
+	}
+	id, err := requestIsbn(first.GetIsbn(), 0)
+	if err != nil {
+		return err
+	}
+	// Fail before the image is uploaded and stored if the book
+	// doesn't exist or has changed, so no cover is stored for it.
+	// The etag is checked again when the book is updated, and
+	// covers stored for a book changed in between are reused
+	// when the same image is uploaded again.
+	bk, err := s.store.GetBook(srv.Context(), id)
+	if err != nil {
+		return err
+	}
+	err = checkEtag(bk, first.GetEtag())
+	if err != nil {
+		return err
+	}
+
+	data := append([]byte(nil), first.GetData()...)
+	for {
+		chunk, err := srv.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if len(data)+len(chunk.GetData()) > maxCoverSize {
+			return status.Errorf(codes.InvalidArgument, "The cover must be at most %d MiB", maxCoverSize>>20)
+		}
+		data = append(data, chunk.GetData()...)
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || cfg.Width < 1 || cfg.Height < 1 {
+		return status.Error(codes.InvalidArgument, "The cover must be a JPEG, PNG or GIF image")
+	}
+	if cfg.Width*cfg.Height > maxCoverPixels {
+		return status.Errorf(codes.InvalidArgument, "The cover must be at most %d pixels", maxCoverPixels)
+	}
+
+	// Covers are stored by the hash of their content, so their
+	// URLs change with the image and can be cached forever.
+	sum := sha256.Sum256(data)
+	key := hex.EncodeToString(sum[:])
+	err = s.covers.Put(srv.Context(), key, data)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to store cover: %v", err)
+	}
+
+	bk, err = s.store.UpdateBook(ctx, id, func(bk *library.Book) error {
+		err := checkEtag(bk, first.GetEtag())
+		if err != nil {
+			return err
+		}
+		bk.CoverUrl = coverPath + key
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	s.reindex(ctx, id)
+	s.localize(ctx, bk)
+
+	return srv.SendAndClose(bk)
+}
+
+// CoverHandler returns an http.Handler serving the cover images
+// uploaded with UploadCover, at the cover URLs of the Books. The width
+// parameter selects a thumbnail of the cover, which is one of 64, 128,
+// 256 or 512 pixels wide. Thumbnails are JPEG images, generated on
+// first use. Cover URLs change with the image, so responses may be
+// cached forever, and are validated with ETags.
+func (s *BookService) CoverHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		key := strings.TrimPrefix(r.URL.Path, coverPath)
+		if !isCoverKey(key) {
+			http.Error(w, "Cover could not be found", http.StatusNotFound)
+			return
+		}
+		var width int
+		if v := r.URL.Query().Get("width"); v != "" {
+			var err error
+			width, err = strconv.Atoi(v)
+			if err != nil || !thumbnailWidths[width] {
+				http.Error(w, "The width must be one of 64, 128, 256 or 512", http.StatusBadRequest)
+				return
+			}
+		}
+
+		data, err := s.coverImage(r.Context(), key, width)
+		if err == blob.ErrNotFound {
+			http.Error(w, "Cover could not be found", http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			http.Error(w, "Cover could not be read", http.StatusInternalServerError)
+			return
+		}
+
+		etag := key
+		if width != 0 {
+			etag = fmt.Sprintf("%s-w%d", key, width)
+		}
+		w.Header().Set("ETag", strconv.Quote(etag))
+		w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+		w.Header().Set("Content-Type", http.DetectContentType(data))
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		// Handles If-None-Match, HEAD and range requests
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(data))
+	})
+}
+
+// coverImage returns the cover with the key provided, or a
+// thumbnail of it if width is set. Thumbnails are generated
+// on first use, and kept in the cover store.
+func (s *BookService) coverImage(ctx context.Context, key string, width int) ([]byte, error) {
+	if width == 0 {
+		return s.covers.Get(ctx, key)
+	}
+
+	thumbKey := fmt.Sprintf("%s-w%d", key, width)
+	data, err := s.covers.Get(ctx, thumbKey)
+	if err != blob.ErrNotFound {
+		return data, err
+	}
+
+	data, err = s.covers.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	err = jpeg.Encode(&buf, thumbnail(img, width), &jpeg.Options{Quality: 85})
+	if err != nil {
+		return nil, err
+	}
+	// Serve the thumbnail even if it can't be kept
+	_ = s.covers.Put(ctx, thumbKey, buf.Bytes())
+
+	return buf.Bytes(), nil
+}
+
+// thumbnail returns img scaled down to width pixels wide, keeping its
+// aspect ratio, on a white background. Each pixel of the thumbnail is
+// the average of the pixels of img it covers. Images narrower than
+// width are not scaled up.
+func thumbnail(img image.Image, width int) image.Image {
+	b := img.Bounds()
+	if b.Dx() < width {
+		width = b.Dx()
+	}
+	height := b.Dy() * width / b.Dx()
+	if height < 1 {
+		height = 1
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		y0 := b.Min.Y + y*b.Dy()/height
+		y1 := b.Min.Y + (y+1)*b.Dy()/height
+		for x := 0; x < width; x++ {
+			x0 := b.Min.X + x*b.Dx()/width
+			x1 := b.Min.X + (x+1)*b.Dx()/width
+
+			var r, g, bl, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					cr, cg, cb, ca := img.At(sx, sy).RGBA()
+					r, g, bl, a = r+uint64(cr), g+uint64(cg), bl+uint64(cb), a+uint64(ca)
+					n++
+				}
+			}
+			// Colors are alpha-premultiplied, so compositing
+			// over white adds the transparent part as white.
+			white := n*0xffff - a
+			dst.SetRGBA(x, y, color.RGBA{
+				R: uint8((r + white) / n >> 8),
+				G: uint8((g + white) / n >> 8),
+				B: uint8((bl + white) / n >> 8),
+				A: 0xff,
+			})
+		}
+	}
+	return dst
+}
+
+// isCoverKey reports whether key is the hex encoded
+// SHA-256 hash of an image, as used in cover URLs.
+func isCoverKey(key string) bool {
+	if len(key) != 2*sha256.Size {
+		return false
+	}
+	_, err := hex.DecodeString(key)
+	return err == nil && strings.ToLower(key) == key
+}
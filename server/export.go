@@ -0,0 +1,464 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"bufio"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/catalog"
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// exportChunkSize is the size of the data sent in each ExportChunk.
+// Books are rendered into a buffer of this size, so the file is
+// sent as it is rendered rather than held in memory in full.
+const exportChunkSize = 32 * 1024
+
+// exportBatchSize is the number of Books read from the
+// store at a time while they are exported.
+const exportBatchSize = 100
+
+var exportFormats = map[library.ExportFormat]catalog.Format{
+	library.ExportFormat_CSV:    catalog.CSV,
+	library.ExportFormat_JSON:   catalog.JSON,
+	library.ExportFormat_BIBTEX: catalog.BibTeX,
+	library.ExportFormat_RIS:    catalog.RIS,
+}
+
+func (s *BookService) ExportCollection(req *library.ExportCollectionRequest, stream library.BookService_ExportCollectionServer) error {
+	format, err := exportFormat(req.GetFormat())
+	if err != nil {
+		return err
+	}
+	books, err := s.exportBooks(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+	localize := func(bk *library.Book) {
+		s.localize(stream.Context(), bk)
+	}
+	return export(stream.Context(), format, books, localize, stream.Send)
+}
+
+// exportFormat returns the catalog format of an export format.
+func exportFormat(f library.ExportFormat) (catalog.Format, error) {
+	format, ok := exportFormats[f]
+	if !ok {
+		return "", status.Errorf(codes.InvalidArgument, "Unknown export format %v", f)
+	}
+	return format, nil
+}
+
+// bookSource calls write with each Book to export in turn, reading
+// them from the library as they are written. It stops at, and
+// returns, the first error returned by write or reading a Book.
+type bookSource func(write func(*library.Book) error) error
+
+// exportBooks returns the source of the Books selected by req, from
+// the library. Errors in the request are returned by exportBooks.
+func (s *BookService) exportBooks(ctx context.Context, req *library.ExportCollectionRequest) (bookSource, error) {
+	switch src := req.GetSource().(type) {
+	case *library.ExportCollectionRequest_CollectionId:
+		collection, err := s.collections.GetCollection(ctx, src.CollectionId)
+		if err != nil {
+			return nil, err
+		}
+		return s.isbnSource(ctx, collectionIsbns(collection), false), nil
+	case *library.ExportCollectionRequest_Query:
+		return s.querySource(ctx, src.Query)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "A collection ID or query must be set")
+	}
+}
+
+// isbnSource returns the source of the Books with the ISBNs provided.
+// Books that are not in the library are left out, unless required
+// is set, in which case the source returns a NotFound error.
+func (s *BookService) isbnSource(ctx context.Context, isbns []string, required bool) bookSource {
+	return func(write func(*library.Book) error) error {
+		for _, id := range isbns {
+			bk, err := s.store.GetBook(ctx, id)
+			if status.Code(err) == codes.NotFound && !required {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			err = write(bk)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// exportKey is what is known of a Book exported by
+// a query until it is read from the library again.
+type exportKey struct {
+	isbn   string
+	workID string
+	key    sortKey
+}
+
+// querySource returns the source of the Books matching the query, in
+// the order requested, or else in the order they were first added to the
+// library. Books are scanned from the store one batch at a time. Unordered
+// Books are written as they are scanned. Otherwise only the sort keys of
+// the Books are kept, and the Books are read again as they are written.
+func (s *BookService) querySource(ctx context.Context, query *library.QueryBooksRequest) (bookSource, error) {
+	match, t, err := s.queryMatch(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	// seen holds the works an edition of which has been written
+	seen := map[string]bool{}
+	collapsed := func(workID string) bool {
+		if !query.GetCollapseEditions() || workID == "" {
+			return false
+		}
+		if seen[workID] {
+			return true
+		}
+		seen[workID] = true
+		return false
+	}
+	// scan calls fn with each batch of matching Books
+	scan := func(fn func([]*library.Book) error) error {
+		cursor := 0
+		for {
+			books, next, err := s.store.ScanBooks(ctx, t, cursor, exportBatchSize, match)
+			if err != nil {
+				return err
+			}
+			err = fn(books)
+			if err != nil || next == 0 {
+				return err
+			}
+			cursor = next
+		}
+	}
+
+	if query.GetOrderBy() == "" {
+		return func(write func(*library.Book) error) error {
+			return scan(func(books []*library.Book) error {
+				for _, bk := range books {
+					if collapsed(bk.GetWorkId()) {
+						continue
+					}
+					err := write(bk)
+					if err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+		}, nil
+	}
+
+	order, err := parseBookOrder(query.GetOrderBy(), s.locale)
+	if err != nil {
+		return nil, err
+	}
+	err = order.loadSortNames(ctx, s.authors)
+	if err != nil {
+		return nil, err
+	}
+	var keys []exportKey
+	err = scan(func(books []*library.Book) error {
+		for _, bk := range books {
+			keys = append(keys, exportKey{isbn: bk.GetIsbn(), workID: bk.GetWorkId(), key: order.key(bk)})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return order.less(keys[i].key, keys[j].key)
+	})
+
+	return func(write func(*library.Book) error) error {
+		for _, k := range keys {
+			if query.GetCollapseEditions() && seen[k.workID] {
+				continue
+			}
+			var bk *library.Book
+			var err error
+			if t.IsZero() {
+				bk, err = s.store.GetBook(ctx, k.isbn)
+			} else {
+				bk, err = s.getBookAsOf(ctx, k.isbn, query.GetAsOf())
+			}
+			if status.Code(err) == codes.NotFound {
+				// Deleted since it was scanned
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if !match(bk) || collapsed(bk.GetWorkId()) {
+				// Changed since it was scanned
+				continue
+			}
+			err = write(bk)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}, nil
+}
+
+// export renders books in format, localizing each with localize
+// and calling send for each chunk. The first chunk is sent
+// before any error reading or rendering the Books.
+func export(ctx context.Context, format catalog.Format, books bookSource, localize func(*library.Book), send func(*library.ExportChunk) error) error {
+	cw := &chunkWriter{
+		send: send,
+		first: &library.ExportChunk{
+			ContentType: format.ContentType(),
+			Filename:    "books" + format.Ext(),
+		},
+	}
+	bw := bufio.NewWriterSize(cw, exportChunkSize)
+	w, err := catalog.NewWriter(bw, format)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	err = books(func(bk *library.Book) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		localize(bk)
+		return w.Write(bk)
+	})
+	if ctx.Err() != nil {
+		// The client has gone away
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	err = w.Close()
+	if err != nil {
+		return err
+	}
+	err = bw.Flush()
+	if err != nil {
+		return err
+	}
+
+	return cw.Close()
+}
+
+// chunkWriter is an io.Writer sending each write as an ExportChunk.
+type chunkWriter struct {
+	send func(*library.ExportChunk) error
+	// first holds the metadata of the first chunk,
+	// until it has been sent.
+	first *library.ExportChunk
+}
+
+func (c *chunkWriter) Write(p []byte) (int, error) {
+	chunk := &library.ExportChunk{}
+	if c.first != nil {
+		chunk, c.first = c.first, nil
+	}
+	// The chunk is sent before Write returns,
+	// so p does not need to be copied.
+	chunk.Data = p
+	err := c.send(chunk)
+	if err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Close sends the first chunk, if the file was empty.
+func (c *chunkWriter) Close() error {
+	if c.first == nil {
+		return nil
+	}
+	_, err := c.Write(nil)
+	return err
+}
+
+// ExportHandler returns an http.Handler serving exports as file downloads.
+// The format parameter is one of csv, json, bibtex or ris, and defaults to csv.
+// Books are selected with repeated isbn parameters, the collection_id
+// parameter, or else with the author_prefix, book_type, publisher, self_published, published_after,
+// published_before and order_by parameters, as in QueryBooks.
+// Times are formatted as RFC 3339.
+func (s *BookService) ExportHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		format, books, err := s.exportRequest(r)
+		if err != nil {
+			writeStatusError(w, err)
+			return
+		}
+		localize := func(bk *library.Book) {
+			localizeBooks(r.Header.Get("Accept-Language"), bk)
+		}
+
+		started := false
+		err = export(r.Context(), format, books, localize, func(chunk *library.ExportChunk) error {
+			if !started {
+				started = true
+				w.Header().Set("Content-Type", chunk.GetContentType())
+				w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", chunk.GetFilename()))
+				w.Header().Set("X-Content-Type-Options", "nosniff")
+				w.WriteHeader(http.StatusOK)
+			}
+			_, err := w.Write(chunk.GetData())
+			if err != nil {
+				return err
+			}
+			if f, ok := w.(http.Flusher); ok {
+				f.Flush()
+			}
+			return nil
+		})
+		if err != nil {
+			if !started {
+				writeStatusError(w, err)
+				return
+			}
+			// Abort the response so the download is not
+			// mistaken for a complete file.
+			panic(http.ErrAbortHandler)
+		}
+	})
+}
+
+// exportRequest parses the query parameters of an export download,
+// and returns the format and the source of the Books to export.
+func (s *BookService) exportRequest(r *http.Request) (catalog.Format, bookSource, error) {
+	params := r.URL.Query()
+	req := &library.ExportCollectionRequest{}
+
+	if f := params.Get("format"); f != "" {
+		v, ok := library.ExportFormat_value[strings.ToUpper(f)]
+		if !ok {
+			return "", nil, status.Errorf(codes.InvalidArgument, "Unknown export format %q", f)
+		}
+		req.Format = library.ExportFormat(v)
+	}
+	format, err := exportFormat(req.GetFormat())
+	if err != nil {
+		return "", nil, err
+	}
+
+	if values := params["isbn"]; len(values) > 0 {
+		isbns := make([]string, 0, len(values))
+		for _, v := range values {
+			id, err := requestIsbn(v, 0)
+			if err != nil {
+				return "", nil, err
+			}
+			// Check the Book exists, so a missing
+			// Book is reported before the download.
+			_, err = s.store.GetBook(r.Context(), id)
+			if err != nil {
+				return "", nil, err
+			}
+			isbns = append(isbns, id)
+		}
+		return format, s.isbnSource(r.Context(), isbns, true), nil
+	}
+
+	if id := params.Get("collection_id"); id != "" {
+		req.Source = &library.ExportCollectionRequest_CollectionId{CollectionId: id}
+		books, err := s.exportBooks(r.Context(), req)
+		return format, books, err
+	}
+
+	query := &library.QueryBooksRequest{
+		AuthorPrefix: params.Get("author_prefix"),
+		Publisher:    params.Get("publisher"),
+		OrderBy:      params.Get("order_by"),
+	}
+	for _, v := range params["book_type"] {
+		t, ok := library.BookType_value[strings.ToUpper(v)]
+		if !ok {
+			return "", nil, status.Errorf(codes.InvalidArgument, "Unknown book type %q", v)
+		}
+		query.BookTypes = append(query.BookTypes, library.BookType(t))
+	}
+	if v := params.Get("self_published"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return "", nil, status.Errorf(codes.InvalidArgument, "Invalid self_published %q", v)
+		}
+		query.SelfPublished = &wrappers.BoolValue{Value: b}
+	}
+	query.PublishedAfter, err = timeParam(params, "published_after")
+	if err != nil {
+		return "", nil, err
+	}
+	query.PublishedBefore, err = timeParam(params, "published_before")
+	if err != nil {
+		return "", nil, err
+	}
+	req.Source = &library.ExportCollectionRequest_Query{Query: query}
+	books, err := s.exportBooks(r.Context(), req)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return format, books, nil
+}
+
+// timeParam parses the RFC 3339 time in the named parameter, if set.
+func timeParam(params url.Values, name string) (*timestamp.Timestamp, error) {
+	v := params.Get(name)
+	if v == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid %s %q", name, v)
+	}
+	ts, err := ptypes.TimestampProto(t)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid %s %q", name, v)
+	}
+	return ts, nil
+}
+
+// writeStatusError writes a gRPC status error as an HTTP error response.
+func writeStatusError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	code := http.StatusInternalServerError
+	switch st.Code() {
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		code = http.StatusBadRequest
+	case codes.NotFound:
+		code = http.StatusNotFound
+	}
+	http.Error(w, st.Message(), code)
+}
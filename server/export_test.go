@@ -0,0 +1,307 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/catalog"
+	"github.com/johanbrandhorst/grpcweb-example/server/isbn"
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// exportStream collects the chunks sent by ExportCollection.
+type exportStream struct {
+	testServerStream
+	chunks []*library.ExportChunk
+}
+
+func (s *exportStream) Send(c *library.ExportChunk) error {
+	// The data is only valid until Send returns
+	c.Data = append([]byte(nil), c.GetData()...)
+	s.chunks = append(s.chunks, c)
+	return nil
+}
+
+// data returns the exported file.
+func (s *exportStream) data() []byte {
+	var buf bytes.Buffer
+	for _, c := range s.chunks {
+		buf.Write(c.GetData())
+	}
+	return buf.Bytes()
+}
+
+// readTitles returns the titles of the Books in
+// data, a catalog file in the format provided.
+func readTitles(t *testing.T, data []byte, format catalog.Format) []string {
+	t.Helper()
+	var titles []string
+	err := catalog.Read(bytes.NewReader(data), format, func(rec catalog.Record) error {
+		if rec.Err != nil {
+			return rec.Err
+		}
+		titles = append(titles, rec.Book.GetTitle())
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("failed to read exported %s: %v", format, err)
+	}
+	return titles
+}
+
+// testIsbn returns the nth of a sequence of valid ISBN-13s.
+func testIsbn(n int) string {
+	for d := 0; d < 10; d++ {
+		s, err := isbn.Parse(fmt.Sprintf("979%09d%d", n, d))
+		if err == nil {
+			return s
+		}
+	}
+	panic("no check digit for ISBN")
+}
+
+func TestExportCollection(t *testing.T) {
+	ctx := context.Background()
+	s, store := newTestBookService()
+	collection := &library.Collection{
+		Id: "collection",
+		Books: []*library.Book{
+			{Isbn: "9781501107733"},
+			{Isbn: "9780140009729"},
+			{Isbn: "9780060929879"},
+		},
+	}
+	err := s.collections.AddCollection(ctx, collection)
+	if err != nil {
+		t.Fatalf("AddCollection returned error: %v", err)
+	}
+	// Books deleted from the library are left out
+	_, err = store.DeleteBook(ctx, "9780140009729", nil)
+	if err != nil {
+		t.Fatalf("DeleteBook returned error: %v", err)
+	}
+
+	stream := &exportStream{testServerStream: testServerStream{ctx: ctx}}
+	err = s.ExportCollection(&library.ExportCollectionRequest{
+		Source: &library.ExportCollectionRequest_CollectionId{CollectionId: "collection"},
+		Format: library.ExportFormat_CSV,
+	}, stream)
+	if err != nil {
+		t.Fatalf("ExportCollection returned error: %v", err)
+	}
+	if len(stream.chunks) == 0 {
+		t.Fatal("ExportCollection sent no chunks")
+	}
+	first := stream.chunks[0]
+	if first.GetContentType() != "text/csv; charset=utf-8" || first.GetFilename() != "books.csv" {
+		t.Errorf("first chunk has content type %q and filename %q, want text/csv; charset=utf-8 and books.csv",
+			first.GetContentType(), first.GetFilename())
+	}
+	want := []string{"Still Alice", "Brave New World"}
+	if got := readTitles(t, stream.data(), catalog.CSV); !equalStrings(got, want) {
+		t.Errorf("exported %q, want %q", got, want)
+	}
+
+	err = s.ExportCollection(&library.ExportCollectionRequest{
+		Source: &library.ExportCollectionRequest_CollectionId{CollectionId: "missing"},
+	}, &exportStream{testServerStream: testServerStream{ctx: ctx}})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("ExportCollection of a missing collection returned error %v, want NotFound", err)
+	}
+	err = s.ExportCollection(&library.ExportCollectionRequest{}, &exportStream{testServerStream: testServerStream{ctx: ctx}})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ExportCollection without a source returned error %v, want InvalidArgument", err)
+	}
+}
+
+func TestExportCollectionChunks(t *testing.T) {
+	ctx := context.Background()
+	s, store := newTestBookService()
+	for i := 0; i < 500; i++ {
+		err := store.AddBook(ctx, &library.Book{
+			Isbn:   testIsbn(i),
+			Title:  fmt.Sprintf("Volume %03d", i),
+			Author: "Anonymous",
+		})
+		if err != nil {
+			t.Fatalf("AddBook returned error: %v", err)
+		}
+	}
+
+	stream := &exportStream{testServerStream: testServerStream{ctx: ctx}}
+	err := s.ExportCollection(&library.ExportCollectionRequest{
+		Source: &library.ExportCollectionRequest_Query{Query: &library.QueryBooksRequest{
+			AuthorPrefix: "Anon",
+			OrderBy:      "title desc",
+		}},
+		Format: library.ExportFormat_JSON,
+	}, stream)
+	if err != nil {
+		t.Fatalf("ExportCollection returned error: %v", err)
+	}
+	if len(stream.chunks) < 2 {
+		t.Errorf("ExportCollection sent %d chunks, want the file split into several", len(stream.chunks))
+	}
+	for i, c := range stream.chunks {
+		if len(c.GetData()) > exportChunkSize {
+			t.Errorf("chunk %d has %d bytes, want at most %d", i, len(c.GetData()), exportChunkSize)
+		}
+		if i > 0 && (c.GetContentType() != "" || c.GetFilename() != "") {
+			t.Errorf("chunk %d has content type %q and filename %q, want them only in the first chunk",
+				i, c.GetContentType(), c.GetFilename())
+		}
+	}
+	titles := readTitles(t, stream.data(), catalog.JSON)
+	if len(titles) != 500 || titles[0] != "Volume 499" || titles[499] != "Volume 000" {
+		t.Errorf("exported %d books from %q to %q, want 500 from Volume 499 to Volume 000",
+			len(titles), titles[0], titles[len(titles)-1])
+	}
+}
+
+func TestExportCollectionQuery(t *testing.T) {
+	ctx := context.Background()
+	s, store := newTestBookService()
+	// More Books than are scanned in one batch, two editions of each work
+	const n = 2*exportBatchSize + 50
+	for i := 0; i < n; i++ {
+		err := store.AddBook(ctx, &library.Book{
+			Isbn:   testIsbn(i),
+			Title:  fmt.Sprintf("Volume %03d", n-1-i),
+			Author: "Anonymous",
+			WorkId: fmt.Sprintf("work-%d", i/2),
+		})
+		if err != nil {
+			t.Fatalf("AddBook returned error: %v", err)
+		}
+	}
+
+	for _, tt := range []struct {
+		orderBy string
+		first   string
+		last    string
+	}{
+		{orderBy: "", first: "Volume 249", last: "Volume 001"},
+		{orderBy: "title", first: "Volume 000", last: "Volume 248"},
+	} {
+		books, err := s.exportBooks(ctx, &library.ExportCollectionRequest{
+			Source: &library.ExportCollectionRequest_Query{Query: &library.QueryBooksRequest{
+				AuthorPrefix:     "Anon",
+				OrderBy:          tt.orderBy,
+				CollapseEditions: true,
+			}},
+		})
+		if err != nil {
+			t.Fatalf("exportBooks(%q) returned error: %v", tt.orderBy, err)
+		}
+		// Books are read as they are exported
+		setAuthor(t, store, "Someone", testIsbn(2), testIsbn(3))
+		var titles []string
+		err = books(func(bk *library.Book) error {
+			titles = append(titles, bk.GetTitle())
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("exporting %q returned error: %v", tt.orderBy, err)
+		}
+		if len(titles) != n/2-1 || titles[0] != tt.first || titles[len(titles)-1] != tt.last {
+			t.Errorf("exported %d books ordered by %q from %q to %q, want %d from %q to %q",
+				len(titles), tt.orderBy, titles[0], titles[len(titles)-1], n/2-1, tt.first, tt.last)
+		}
+		setAuthor(t, store, "Anonymous", testIsbn(2), testIsbn(3))
+	}
+}
+
+// setAuthor sets the author of the Books with the ISBNs provided.
+func setAuthor(t *testing.T, store BookStore, author string, isbns ...string) {
+	t.Helper()
+	for _, id := range isbns {
+		_, err := store.UpdateBook(context.Background(), id, func(bk *library.Book) error {
+			bk.Author = author
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("UpdateBook returned error: %v", err)
+		}
+	}
+}
+
+func TestExportHandler(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestBookService()
+	err := s.collections.AddCollection(ctx, &library.Collection{
+		Id:    "collection",
+		Books: []*library.Book{{Isbn: "9780140008388"}, {Isbn: "9780060929879"}},
+	})
+	if err != nil {
+		t.Fatalf("AddCollection returned error: %v", err)
+	}
+	h := s.ExportHandler()
+
+	tests := []struct {
+		target     string
+		wantCode   int
+		wantTitles []string
+	}{
+		{
+			target:     "/export?collection_id=collection&format=json",
+			wantCode:   http.StatusOK,
+			wantTitles: []string{"Animal Farm", "Brave New World"},
+		},
+		{
+			target:     "/export?isbn=0140009728&isbn=9781501107733",
+			wantCode:   http.StatusOK,
+			wantTitles: []string{"Nineteen Eighty-Four", "Still Alice"},
+		},
+		{
+			target:     "/export?author_prefix=George&order_by=title",
+			wantCode:   http.StatusOK,
+			wantTitles: []string{"Animal Farm", "Nineteen Eighty-Four"},
+		},
+		{target: "/export?format=docx", wantCode: http.StatusBadRequest},
+		{target: "/export?isbn=0140009729", wantCode: http.StatusBadRequest},
+		{target: "/export?published_after=yesterday", wantCode: http.StatusBadRequest},
+		{target: "/export?collection_id=missing", wantCode: http.StatusNotFound},
+		{target: "/export?isbn=9780306406157", wantCode: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.target, nil))
+		if w.Code != tt.wantCode {
+			t.Errorf("GET %s returned status %d, want %d", tt.target, w.Code, tt.wantCode)
+			continue
+		}
+		if w.Code != http.StatusOK {
+			continue
+		}
+		_, params, err := mime.ParseMediaType(w.Header().Get("Content-Disposition"))
+		if err != nil {
+			t.Errorf("GET %s returned invalid Content-Disposition: %v", tt.target, err)
+			continue
+		}
+		format, err := catalog.FormatFromPath(params["filename"])
+		if err != nil {
+			t.Errorf("GET %s returned unexpected filename: %v", tt.target, err)
+			continue
+		}
+		if got := readTitles(t, w.Body.Bytes(), format); !equalStrings(got, tt.wantTitles) {
+			t.Errorf("GET %s exported %q, want %q", tt.target, got, tt.wantTitles)
+		}
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/export", nil))
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("POST /export returned status %d, want %d", w.Code, http.StatusMethodNotAllowed)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// bookFieldSetters maps the field mask paths accepted by
+// UpdateBook to functions copying that field from src to dst.
+var bookFieldSetters = map[string]func(dst, src *library.Book){
+	"title": func(dst, src *library.Book) {
+		dst.Title = src.GetTitle()
+	},
+	"author_ids": func(dst, src *library.Book) {
+		dst.AuthorIds = src.GetAuthorIds()
+	},
+	"author": func(dst, src *library.Book) {
+		dst.Author = src.GetAuthor()
+	},
+	"book_type": func(dst, src *library.Book) {
+		dst.BookType = src.GetBookType()
+	},
+	"self_published": func(dst, src *library.Book) {
+		dst.PublishingMethod = &library.Book_SelfPublished{
+			SelfPublished: src.GetSelfPublished(),
+		}
+	},
+	"publisher": func(dst, src *library.Book) {
+		if src.GetPublisher() == nil {
+			dst.PublishingMethod = nil
+			return
+		}
+		dst.PublishingMethod = &library.Book_Publisher{
+			Publisher: src.GetPublisher(),
+		}
+	},
+	"publication_date": func(dst, src *library.Book) {
+		dst.PublicationDate = src.GetPublicationDate()
+	},
+	"copies": func(dst, src *library.Book) {
+		dst.Copies = src.GetCopies()
+	},
+	"work_id": func(dst, src *library.Book) {
+		dst.WorkId = src.GetWorkId()
+	},
+	"subject_codes": func(dst, src *library.Book) {
+		dst.SubjectCodes = src.GetSubjectCodes()
+	},
+	"language_code": func(dst, src *library.Book) {
+		dst.LanguageCode = src.GetLanguageCode()
+	},
+	"original_isbn": func(dst, src *library.Book) {
+		dst.OriginalIsbn = src.GetOriginalIsbn()
+	},
+	"description": func(dst, src *library.Book) {
+		dst.Description = src.GetDescription()
+	},
+	"localizations": func(dst, src *library.Book) {
+		dst.Localizations = src.GetLocalizations()
+	},
+}
+
+// validateBookMask returns an InvalidArgument error if
+// mask contains paths that can't be used to update a Book.
+func validateBookMask(mask *field_mask.FieldMask) error {
+	for _, path := range mask.GetPaths() {
+		switch path {
+		case "isbn", "legacy_isbn", "isbn10":
+			return status.Error(codes.InvalidArgument, "The ISBN of a book can't be changed")
+		case "available_copies", "average_rating", "review_count", "cover_url", "tags", "localized":
+			return status.Errorf(codes.InvalidArgument, "The %s of a book is set by the server", path)
+		}
+		if _, ok := bookFieldSetters[path]; !ok {
+			return status.Errorf(codes.InvalidArgument, "Unknown field %q in update mask", path)
+		}
+	}
+	return nil
+}
+
+// applyBookMask copies the fields in mask from src to dst. An empty
+// mask copies all fields except the ISBNs and the fields set by the
+// server. The mask must have been checked with validateBookMask.
+func applyBookMask(dst, src *library.Book, mask *field_mask.FieldMask) {
+	if len(mask.GetPaths()) == 0 {
+		bk := cloneBook(src)
+		bk.Isbn, bk.LegacyIsbn, bk.Isbn10 = dst.GetIsbn(), dst.GetLegacyIsbn(), dst.GetIsbn10()
+		bk.Etag, bk.DeleteTime = dst.GetEtag(), dst.GetDeleteTime()
+		bk.AvailableCopies = dst.GetAvailableCopies()
+		bk.AverageRating, bk.ReviewCount = dst.GetAverageRating(), dst.GetReviewCount()
+		bk.CoverUrl, bk.Tags, bk.Localized = dst.GetCoverUrl(), dst.GetTags(), dst.GetLocalized()
+		*dst = *bk
+		return
+	}
+	for _, path := range mask.GetPaths() {
+		bookFieldSetters[path](dst, src)
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/isbn"
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// parseBookFilter parses a filter expression into a predicate on books.
+// A filter is a list of restrictions joined by AND, each of the form
+// `field op value`. Valid fields are isbn, title, author, publisher,
+// book_type and self_published. ISBNs are compared in their canonical
+// ISBN-13 form. The = and != operators compare exactly,
+// while : matches string fields containing the value, ignoring case.
+// Values containing spaces must be double quoted.
+// An empty filter matches all books.
+func parseBookFilter(filter string) (func(*library.Book) bool, error) {
+	toks, err := lexFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var matchers []func(*library.Book) bool
+	for len(toks) > 0 {
+		if len(matchers) > 0 {
+			if toks[0] != "AND" {
+				return nil, status.Errorf(codes.InvalidArgument, "Expected AND in filter, found %q", toks[0])
+			}
+			toks = toks[1:]
+		}
+		if len(toks) < 3 {
+			return nil, status.Errorf(codes.InvalidArgument, "Incomplete restriction in filter %q", filter)
+		}
+		m, err := parseRestriction(toks[0], toks[1], toks[2])
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, m)
+		toks = toks[3:]
+	}
+
+	return func(bk *library.Book) bool {
+		for _, m := range matchers {
+			if !m(bk) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+// bookStringFields maps the string fields that can be
+// filtered on to functions extracting them from a book.
+var bookStringFields = map[string]func(*library.Book) string{
+	"title": (*library.Book).GetTitle,
+	"author": (*library.Book).GetAuthor,
+	"publisher": func(bk *library.Book) string {
+		return bk.GetPublisher().GetName()
+	},
+	"book_type": func(bk *library.Book) string {
+		return bk.GetBookType().String()
+	},
+	"self_published": func(bk *library.Book) string {
+		return strconv.FormatBool(bk.GetSelfPublished())
+	},
+	"isbn": (*library.Book).GetIsbn,
+}
+
+func parseRestriction(field, op, value string) (func(*library.Book) bool, error) {
+	get, ok := bookStringFields[field]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "Can't filter on unknown field %q", field)
+	}
+	switch field {
+	case "book_type":
+		if _, ok := library.BookType_value[value]; !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "Unknown book type %q", value)
+		}
+	case "self_published":
+		if _, err := strconv.ParseBool(value); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid boolean %q", value)
+		}
+	case "isbn":
+		if op != ":" {
+			canonical, err := isbn.Parse(value)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "Invalid ISBN %q: %v", value, err)
+			}
+			value = canonical
+		}
+	}
+
+	switch op {
+	case "=":
+		return func(bk *library.Book) bool {
+			return get(bk) == value
+		}, nil
+	case "!=":
+		return func(bk *library.Book) bool {
+			return get(bk) != value
+		}, nil
+	case ":":
+		value = strings.ToLower(value)
+		return func(bk *library.Book) bool {
+			return strings.Contains(strings.ToLower(get(bk)), value)
+		}, nil
+	}
+	return nil, status.Errorf(codes.InvalidArgument, "Unknown operator %q in filter", op)
+}
+
+// lexFilter splits a filter expression into
+// identifiers, operators and (unquoted) values.
+func lexFilter(filter string) ([]string, error) {
+	var toks []string
+	for i := 0; i < len(filter); {
+		switch c := filter[i]; {
+		case c == ' ' || c == '\t':
+			i++
+		case c == '=' || c == ':':
+			toks = append(toks, string(c))
+			i++
+		case strings.HasPrefix(filter[i:], "!="):
+			toks = append(toks, "!=")
+			i += 2
+		case c == '"':
+			end := i + 1
+			for ; end < len(filter) && filter[end] != '"'; end++ {
+				if filter[end] == '\\' {
+					end++
+				}
+			}
+			if end >= len(filter) {
+				return nil, status.Error(codes.InvalidArgument, "Unterminated string in filter")
+			}
+			s, err := strconv.Unquote(filter[i : end+1])
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "Invalid string in filter: %v", err)
+			}
+			toks = append(toks, s)
+			i = end + 1
+		default:
+			end := i
+			for end < len(filter) && isFilterIdentByte(filter[end]) {
+				end++
+			}
+			if end == i {
+				return nil, status.Errorf(codes.InvalidArgument, "Unexpected character %q in filter", c)
+			}
+			toks = append(toks, filter[i:end])
+			i = end
+		}
+	}
+	return toks, nil
+}
+
+// isFilterIdentByte reports whether c may be part of an unquoted
+// identifier or value. All non-ASCII bytes are accepted, so that
+// unquoted values may contain any letters.
+func isFilterIdentByte(c byte) bool {
+	return c >= utf8.RuneSelf || c == '_' || c == '-' || c == '.' ||
+		unicode.IsLetter(rune(c)) || unicode.IsDigit(rune(c))
+}
@@ -0,0 +1,91 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+func TestParseBookFilter(t *testing.T) {
+	hobbit := &library.Book{
+		Isbn:     "9780306406157",
+		Title:    "The Hobbit",
+		Author:   "J. R. R. Tolkien",
+		BookType: library.BookType_PAPERBACK,
+		PublishingMethod: &library.Book_Publisher{
+			Publisher: &library.Publisher{Name: "Allen & Unwin"},
+		},
+	}
+	selfPublished := &library.Book{
+		Isbn:             "9780804429573",
+		Title:            "Les Misérables",
+		Author:           "Victor Hugo",
+		PublishingMethod: &library.Book_SelfPublished{SelfPublished: true},
+	}
+
+	tests := []struct {
+		filter string
+		want   []bool
+	}{
+		{filter: "", want: []bool{true, true}},
+		{filter: `title = "The Hobbit"`, want: []bool{true, false}},
+		{filter: `title != "The Hobbit"`, want: []bool{false, true}},
+		{filter: "title = hobbit", want: []bool{false, false}},
+		{filter: "title:HOBBIT", want: []bool{true, false}},
+		{filter: "title : misérables", want: []bool{false, true}},
+		{filter: `author:"r. r."`, want: []bool{true, false}},
+		{filter: `publisher = "Allen & Unwin"`, want: []bool{true, false}},
+		{filter: "book_type = HARDCOVER", want: []bool{false, true}},
+		{filter: "self_published = true", want: []bool{false, true}},
+		{filter: "isbn = 0-306-40615-2", want: []bool{true, false}},
+		{filter: "isbn = 080442957X", want: []bool{false, true}},
+		{filter: "isbn : 0804", want: []bool{false, true}},
+		{filter: "title:the AND author:tolkien", want: []bool{true, false}},
+		{filter: "title:s AND book_type != PAPERBACK", want: []bool{false, true}},
+	}
+	for _, tt := range tests {
+		match, err := parseBookFilter(tt.filter)
+		if err != nil {
+			t.Errorf("parseBookFilter(%q) returned error: %v", tt.filter, err)
+			continue
+		}
+		for i, bk := range []*library.Book{hobbit, selfPublished} {
+			if got := match(bk); got != tt.want[i] {
+				t.Errorf("parseBookFilter(%q) matched %q: %t, want %t", tt.filter, bk.GetTitle(), got, tt.want[i])
+			}
+		}
+	}
+}
+
+func TestParseBookFilterErrors(t *testing.T) {
+	tests := []struct {
+		filter  string
+		wantMsg string
+	}{
+		{filter: "title", wantMsg: `Incomplete restriction in filter "title"`},
+		{filter: "title =", wantMsg: `Incomplete restriction in filter "title ="`},
+		{filter: "title:a author:b", wantMsg: `Expected AND in filter, found "author"`},
+		{filter: "title:a AND", wantMsg: `Incomplete restriction in filter "title:a AND"`},
+		{filter: "rating = 5", wantMsg: `Can't filter on unknown field "rating"`},
+		{filter: "title < a", wantMsg: `Unexpected character '<' in filter`},
+		{filter: "title title a", wantMsg: `Unknown operator "title" in filter`},
+		{filter: `title = "The Hobbit`, wantMsg: "Unterminated string in filter"},
+		{filter: `title = "\q"`, wantMsg: "Invalid string in filter: invalid syntax"},
+		{filter: "book_type = EBOOK", wantMsg: `Unknown book type "EBOOK"`},
+		{filter: "self_published = maybe", wantMsg: `Invalid boolean "maybe"`},
+		{filter: "isbn = 0306406153", wantMsg: `Invalid ISBN "0306406153": invalid ISBN check digit`},
+	}
+	for _, tt := range tests {
+		_, err := parseBookFilter(tt.filter)
+		st, _ := status.FromError(err)
+		if st.Code() != codes.InvalidArgument || st.Message() != tt.wantMsg {
+			t.Errorf("parseBookFilter(%q) returned error %v, want InvalidArgument %q", tt.filter, err, tt.wantMsg)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// Fixtures returns the books the example library
+// is seeded with. A new slice is returned on every call.
+func Fixtures() []*library.Book {
+	return []*library.Book{
+		&library.Book{
+			Isbn:       "9780060929879",
+			LegacyIsbn: 60929871,
+			Isbn10:     "0060929871",
+			Title:      "Brave New World",
+			Author:     "Aldous Huxley",
+			BookType:   library.BookType_HARDCOVER,
+			PublishingMethod: &library.Book_Publisher{
+				Publisher: &library.Publisher{
+					Name: "Chatto & Windus",
+				},
+			},
+			PublicationDate: &timestamp.Timestamp{
+				Seconds: time.Date(1932, time.January, 1, 0, 0, 0, 0, time.UTC).Unix(),
+			},
+			Copies:          2,
+			AvailableCopies: 2,
+			LanguageCode:    "en",
+			Localizations: []*library.Localization{
+				{LanguageCode: "fr", Title: "Le Meilleur des mondes"},
+				{LanguageCode: "de", Title: "Schöne neue Welt"},
+			},
+		},
+		&library.Book{
+			Isbn:       "9780140009729",
+			LegacyIsbn: 140009728,
+			Isbn10:     "0140009728",
+			Title:      "Nineteen Eighty-Four",
+			Author:     "George Orwell",
+			BookType:   library.BookType_PAPERBACK,
+			PublishingMethod: &library.Book_Publisher{
+				Publisher: &library.Publisher{
+					Name: "Secker & Warburg",
+				},
+			},
+			PublicationDate: &timestamp.Timestamp{
+				Seconds: time.Date(1949, time.June, 8, 0, 0, 0, 0, time.UTC).Unix(),
+			},
+			Copies:          2,
+			AvailableCopies: 2,
+			LanguageCode:    "en",
+		},
+		&library.Book{
+			Isbn:       "9780140301694",
+			LegacyIsbn: 9780140301694,
+			Isbn10:     "0140301690",
+			Title:      "Alice's Adventures in Wonderland",
+			Author:     "Lewis Carroll",
+			BookType:   library.BookType_AUDIOBOOK,
+			PublishingMethod: &library.Book_Publisher{
+				Publisher: &library.Publisher{
+					Name: "Macmillan",
+				},
+			},
+			PublicationDate: &timestamp.Timestamp{
+				Seconds: time.Date(1865, time.November, 26, 0, 0, 0, 0, time.UTC).Unix(),
+			},
+			Copies:          2,
+			AvailableCopies: 2,
+			LanguageCode:    "en",
+			Localizations: []*library.Localization{
+				{LanguageCode: "fr", Title: "Alice au pays des merveilles"},
+				{LanguageCode: "de", Title: "Alices Abenteuer im Wunderland"},
+			},
+		},
+		&library.Book{
+			Isbn:       "9780140008388",
+			LegacyIsbn: 140008381,
+			Isbn10:     "0140008381",
+			Title:      "Animal Farm",
+			Author:     "George Orwell",
+			BookType:   library.BookType_HARDCOVER,
+			PublishingMethod: &library.Book_Publisher{
+				Publisher: &library.Publisher{
+					Name: "Secker & Warburg",
+				},
+			},
+			PublicationDate: &timestamp.Timestamp{
+				Seconds: time.Date(1945, time.August, 17, 0, 0, 0, 0, time.UTC).Unix(),
+			},
+			Copies:          2,
+			AvailableCopies: 2,
+			LanguageCode:    "en",
+			Localizations: []*library.Localization{
+				{LanguageCode: "fr", Title: "La Ferme des animaux"},
+				{LanguageCode: "de", Title: "Farm der Tiere"},
+			},
+		},
+		&library.Book{
+			Isbn:       "9781501107733",
+			LegacyIsbn: 1501107739,
+			Isbn10:     "1501107739",
+			Title:      "Still Alice",
+			Author:     "Lisa Genova",
+			BookType:   library.BookType_PAPERBACK,
+			PublishingMethod: &library.Book_SelfPublished{
+				SelfPublished: true,
+			},
+			PublicationDate: &timestamp.Timestamp{
+				Seconds: time.Date(2007, time.January, 1, 0, 0, 0, 0, time.UTC).Unix(),
+			},
+			Copies:          2,
+			AvailableCopies: 2,
+			LanguageCode:    "en",
+		},
+	}
+}
@@ -0,0 +1,218 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// userMetadataKey is the request metadata key identifying
+// the user making a change, for the revision history.
+const userMetadataKey = "user"
+
+type actorKey struct{}
+
+// WithActor returns a copy of ctx recording actor as the
+// user making changes with it. BookStores record the
+// actor of each change in the revision history.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the actor recorded
+// in ctx with WithActor, if any.
+func ActorFromContext(ctx context.Context) string {
+	actor, _ := ctx.Value(actorKey{}).(string)
+	return actor
+}
+
+// requestActor records the user in the request metadata
+// of ctx as the actor of the changes made with it. The user
+// must be the ID of a member in members, else an Unauthenticated
+// error is returned.
+//
+// This is not authentication: the metadata is set by the client,
+// which may name any member. It only keeps users that don't
+// exist out of the revision history.
+func requestActor(ctx context.Context, members MemberStore) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	user := firstMetadataValue(md, userMetadataKey)
+	if user == "" {
+		return ctx, nil
+	}
+	_, err := members.GetMember(ctx, user)
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.NotFound:
+		return nil, status.Errorf(codes.Unauthenticated, "Unknown user %q", user)
+	default:
+		return nil, err
+	}
+	return WithActor(ctx, user), nil
+}
+
+// revisionPageToken is the content of the page
+// tokens handed out by ListBookRevisions.
+type revisionPageToken struct {
+	// Isbn is the ISBN of the request that created the token.
+	// It may not change between pages.
+	Isbn string `json:"i"`
+	// Before is the revision of the last change on the previous page.
+	Before int64 `json:"b"`
+}
+
+func (revisionPageToken) kind() string { return "revisions" }
+
+func (s *BookService) RestoreBook(ctx context.Context, req *library.RestoreBookRequest) (*library.Book, error) {
+	ctx, err := requestActor(ctx, s.members)
+	if err != nil {
+		return nil, err
+	}
+	id, err := requestIsbn(req.GetIsbn(), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	bk, err := s.store.RestoreBook(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.reindex(ctx, bk.GetIsbn())
+	s.localize(ctx, bk)
+
+	return bk, nil
+}
+
+func (s *BookService) ListBookRevisions(ctx context.Context, req *library.ListBookRevisionsRequest) (*library.ListBookRevisionsResponse, error) {
+	id, err := requestIsbn(req.GetIsbn(), 0)
+	if err != nil {
+		return nil, err
+	}
+	pageSize, err := parsePageSize(req.GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+
+	var before int64
+	if req.GetPageToken() != "" {
+		var token revisionPageToken
+		if !decodeToken(s.tokenKey, req.GetPageToken(), &token) {
+			return nil, status.Error(codes.InvalidArgument, "Invalid page token")
+		}
+		if token.Isbn != id {
+			return nil, status.Error(codes.InvalidArgument, "The ISBN must not change between pages")
+		}
+		before = token.Before
+	}
+
+	history, err := s.store.BookHistory(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	revisions, err := bookRevisions(history)
+	if err != nil {
+		return nil, err
+	}
+
+	// Newest first
+	resp := &library.ListBookRevisionsResponse{}
+	for i := len(revisions) - 1; i >= 0; i-- {
+		if before != 0 && history[i].Revision >= before {
+			continue
+		}
+		if len(resp.Revisions) == pageSize {
+			resp.NextPageToken, err = encodeToken(s.tokenKey, revisionPageToken{
+				Isbn:   id,
+				Before: history[i+1].Revision,
+			})
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+		s.localize(ctx, revisions[i].GetBook())
+		resp.Revisions = append(resp.Revisions, revisions[i])
+	}
+
+	return resp, nil
+}
+
+// bookRevisions converts the history of a Book, oldest first,
+// to the revisions returned by ListBookRevisions.
+func bookRevisions(history []BookChange) ([]*library.BookRevision, error) {
+	revisions := make([]*library.BookRevision, len(history))
+	for i, c := range history {
+		changeTime, err := ptypes.TimestampProto(c.Time)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "invalid change time: %v", err)
+		}
+		rev := &library.BookRevision{
+			ChangeTime: changeTime,
+			User:       c.Actor,
+			Book:       c.New,
+		}
+		switch {
+		case c.New == nil:
+			rev.ChangeType = library.BookRevision_DELETED
+			rev.Book = c.Old
+			rev.Book.DeleteTime = changeTime
+		case c.Old != nil:
+			rev.ChangeType = library.BookRevision_UPDATED
+			rev.ChangedFields = changedBookFields(c.Old, c.New)
+		case i > 0:
+			rev.ChangeType = library.BookRevision_RESTORED
+		default:
+			rev.ChangeType = library.BookRevision_CREATED
+		}
+		rev.Etag = rev.GetBook().GetEtag()
+		revisions[i] = rev
+	}
+	return revisions, nil
+}
+
+// changedBookFields returns the paths of the fields,
+// as used in update masks, that differ between a and b.
+func changedBookFields(a, b *library.Book) []string {
+	var paths []string
+	for path, set := range bookFieldSetters {
+		var fa, fb library.Book
+		set(&fa, a)
+		set(&fb, b)
+		if !proto.Equal(&fa, &fb) {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// getBookAsOf returns the Book with the ISBN provided
+// as it was at the time asOf.
+func (s *BookService) getBookAsOf(ctx context.Context, isbn string, asOf *timestamp.Timestamp) (*library.Book, error) {
+	t, err := ptypes.Timestamp(asOf)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid as_of time")
+	}
+	history, err := s.store.BookHistory(ctx, isbn)
+	if err != nil {
+		return nil, err
+	}
+	i := sort.Search(len(history), func(i int) bool {
+		return history[i].Time.After(t)
+	})
+	if i == 0 || history[i-1].New == nil {
+		return nil, status.Error(codes.NotFound, "Book could not be found at that time")
+	}
+	return history[i-1].New, nil
+}
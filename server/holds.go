@@ -0,0 +1,388 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// holdPageToken is the content of the page
+// tokens handed out by ListHolds.
+type holdPageToken struct {
+	// Member, Isbn and IncludeClosed are the filters of the request
+	// that created the token. They may not change between pages.
+	Member        string `json:"m,omitempty"`
+	Isbn          string `json:"i,omitempty"`
+	IncludeClosed bool   `json:"c,omitempty"`
+	// Last is the key of the last Hold on the previous page.
+	Last listKey `json:"l"`
+}
+
+func (holdPageToken) kind() string { return "holds" }
+
+func (s *LendingService) PlaceHold(ctx context.Context, req *library.PlaceHoldRequest) (*library.Hold, error) {
+	ctx, err := requestActor(ctx, s.members)
+	if err != nil {
+		return nil, err
+	}
+	id, err := requestIsbn(req.GetIsbn(), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	hold := &library.Hold{
+		Isbn:   id,
+		Member: req.GetMember(),
+		State:  library.Hold_WAITING,
+	}
+	hold.CreateTime, err = ptypes.TimestampProto(now)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "invalid create time: %v", err)
+	}
+	hold.Id, err = newID("hold")
+	if err != nil {
+		return nil, err
+	}
+
+	err = useActiveMember(ctx, s.members, req.GetMember(), func(*library.Member) error {
+		_, err := s.store.UpdateLending(ctx, id, func(l *Lending) error {
+			err := s.settleHolds(l, now)
+			if err != nil {
+				return err
+			}
+			switch {
+			case findHold(l, req.GetMember(), library.Hold_WAITING) != nil,
+				findHold(l, req.GetMember(), library.Hold_READY) != nil:
+				return status.Error(codes.AlreadyExists, "The member already has a hold on the book")
+			case l.Book.GetCopies() == 0:
+				return status.Error(codes.FailedPrecondition, "The library has no copies of the book to lend")
+			case l.Book.GetAvailableCopies() > 0:
+				return status.Error(codes.FailedPrecondition, "Copies of the book are available, check one out instead")
+			}
+			l.Holds = append(l.Holds, hold)
+			// Set the position of the new hold
+			return s.settleHolds(l, now)
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return hold, nil
+}
+
+func (s *LendingService) CancelHold(ctx context.Context, req *library.CancelHoldRequest) (*library.Hold, error) {
+	ctx, err := requestActor(ctx, s.members)
+	if err != nil {
+		return nil, err
+	}
+	hold, err := s.store.GetHold(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	_, err = s.store.UpdateLending(ctx, hold.GetIsbn(), func(l *Lending) error {
+		err := s.settleHolds(l, now)
+		if err != nil {
+			return err
+		}
+		for _, h := range l.Holds {
+			if h.GetId() != req.GetId() {
+				continue
+			}
+			hold = h
+			switch h.GetState() {
+			case library.Hold_WAITING:
+			case library.Hold_READY:
+				// Release the copy set aside
+				releaseCopy(l, h)
+				l.Book.AvailableCopies++
+			default:
+				return status.Error(codes.FailedPrecondition, "The hold is no longer waiting or ready")
+			}
+			h.State = library.Hold_CANCELLED
+			// Move up the queue, or offer the copy to the next hold
+			return s.settleHolds(l, now)
+		}
+		return status.Error(codes.NotFound, "Hold could not be found")
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return hold, nil
+}
+
+func (s *LendingService) ListHolds(ctx context.Context, req *library.ListHoldsRequest) (*library.ListHoldsResponse, error) {
+	var id string
+	if req.GetIsbn() != "" {
+		var err error
+		id, err = requestIsbn(req.GetIsbn(), 0)
+		if err != nil {
+			return nil, err
+		}
+	}
+	pageSize, err := parsePageSize(req.GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+
+	var last *listKey
+	if req.GetPageToken() != "" {
+		var token holdPageToken
+		if !decodeToken(s.tokenKey, req.GetPageToken(), &token) {
+			return nil, status.Error(codes.InvalidArgument, "Invalid page token")
+		}
+		if token.Member != req.GetMember() || token.Isbn != id || token.IncludeClosed != req.GetIncludeClosed() {
+			return nil, status.Error(codes.InvalidArgument, "The filters must not change between pages")
+		}
+		last = &token.Last
+	}
+
+	_, err = s.expireHolds(ctx, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	holds, err := s.store.QueryHolds(ctx, func(h *library.Hold) bool {
+		return (req.GetMember() == "" || h.GetMember() == req.GetMember()) &&
+			(id == "" || h.GetIsbn() == id) &&
+			(req.GetIncludeClosed() || isOpenHold(h)) &&
+			(last == nil || last.less(keyOfHold(h)))
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(holds, func(i, j int) bool {
+		return keyOfHold(holds[i]).less(keyOfHold(holds[j]))
+	})
+
+	resp := &library.ListHoldsResponse{}
+	if len(holds) > pageSize {
+		holds = holds[:pageSize]
+		resp.NextPageToken, err = encodeToken(s.tokenKey, holdPageToken{
+			Member:        req.GetMember(),
+			Isbn:          id,
+			IncludeClosed: req.GetIncludeClosed(),
+			Last:          keyOfHold(holds[len(holds)-1]),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	resp.Holds = holds
+
+	return resp, nil
+}
+
+func (s *LendingService) WatchHolds(req *library.WatchHoldsRequest, stream library.LendingService_WatchHoldsServer) error {
+	ctx := stream.Context()
+	_, err := activeMember(ctx, s.members, req.GetMember())
+	if err != nil {
+		return err
+	}
+
+	// sent holds the last version of each Hold sent
+	sent := map[string]*library.Hold{}
+	for first := true; ; first = false {
+		// Get the channel before reading, so no change is missed
+		changed, err := s.store.LendingChanged(ctx)
+		if err != nil {
+			return err
+		}
+		next, err := s.expireHolds(ctx, time.Now())
+		if err != nil {
+			return err
+		}
+		holds, err := s.store.QueryHolds(ctx, func(h *library.Hold) bool {
+			return h.GetMember() == req.GetMember()
+		})
+		if err != nil {
+			return err
+		}
+		for _, h := range holds {
+			prev, ok := sent[h.GetId()]
+			if (first && !isOpenHold(h)) || (ok && proto.Equal(prev, h)) {
+				continue
+			}
+			err = stream.Send(h)
+			if err != nil {
+				return err
+			}
+			sent[h.GetId()] = h
+		}
+
+		// Wake up when the next ready hold expires, as the copy
+		// set aside may then be offered to this member.
+		var timer *time.Timer
+		var expired <-chan time.Time
+		if !next.IsZero() {
+			timer = time.NewTimer(time.Until(next))
+			expired = timer.C
+		}
+		select {
+		case <-changed:
+		case <-expired:
+		case <-ctx.Done():
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+	}
+}
+
+// settleHolds expires the ready Holds of l whose copy has been set aside
+// for too long, sets aside the available copies for the first waiting
+// Holds, and numbers the waiting Holds in the order they were placed.
+func (s *LendingService) settleHolds(l *Lending, now time.Time) error {
+	for _, h := range l.Holds {
+		if h.GetState() != library.Hold_READY {
+			continue
+		}
+		expire, err := ptypes.Timestamp(h.GetExpireTime())
+		if err != nil {
+			return status.Errorf(codes.Internal, "invalid expire time: %v", err)
+		}
+		if !expire.After(now) {
+			h.State = library.Hold_EXPIRED
+			releaseCopy(l, h)
+			l.Book.AvailableCopies++
+		}
+	}
+
+	var position int32
+	for _, h := range l.Holds {
+		h.Position = 0
+		if h.GetState() != library.Hold_WAITING {
+			continue
+		}
+		if l.Book.GetAvailableCopies() <= 0 {
+			position++
+			h.Position = position
+			continue
+		}
+
+		var err error
+		h.ReadyTime, err = ptypes.TimestampProto(now)
+		if err != nil {
+			return status.Errorf(codes.Internal, "invalid ready time: %v", err)
+		}
+		h.ExpireTime, err = ptypes.TimestampProto(now.Add(s.holdPeriod))
+		if err != nil {
+			return status.Errorf(codes.Internal, "invalid expire time: %v", err)
+		}
+		h.State = library.Hold_READY
+		// Set aside a barcoded copy, so it isn't counted as
+		// available at its branch. Books may also have copies
+		// without a barcode, which are set aside by count alone.
+		h.Barcode = availableBarcode(l)
+		if c := findCopy(l, h.GetBarcode()); c != nil {
+			c.State = library.Copy_RESERVED
+		}
+		l.Book.AvailableCopies--
+	}
+
+	return nil
+}
+
+// releaseCopy makes the Copy set aside for the ready Hold h
+// available again, if it is still set aside.
+func releaseCopy(l *Lending, h *library.Hold) {
+	if h.GetBarcode() == "" {
+		return
+	}
+	if c := findCopy(l, h.GetBarcode()); c != nil && c.GetState() == library.Copy_RESERVED {
+		c.State = library.Copy_AVAILABLE
+	}
+}
+
+// expireHolds settles the Holds of every Book with a ready Hold
+// that has expired, and returns the time the next ready Hold
+// expires, or the zero time if there are no ready Holds.
+func (s *LendingService) expireHolds(ctx context.Context, now time.Time) (time.Time, error) {
+	ready, err := s.store.QueryHolds(ctx, func(h *library.Hold) bool {
+		return h.GetState() == library.Hold_READY
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var next time.Time
+	expired := map[string]bool{}
+	for _, h := range ready {
+		expire, err := ptypes.Timestamp(h.GetExpireTime())
+		if err != nil {
+			return time.Time{}, status.Errorf(codes.Internal, "invalid expire time: %v", err)
+		}
+		if !expire.After(now) {
+			expired[h.GetIsbn()] = true
+			continue
+		}
+		if next.IsZero() || expire.Before(next) {
+			next = expire
+		}
+	}
+
+	for isbn := range expired {
+		l, err := s.store.UpdateLending(ctx, isbn, func(l *Lending) error {
+			return s.settleHolds(l, now)
+		})
+		if err != nil {
+			return time.Time{}, err
+		}
+		// Copies may have been set aside for the next holds
+		for _, h := range l.Holds {
+			if h.GetState() != library.Hold_READY {
+				continue
+			}
+			expire, err := ptypes.Timestamp(h.GetExpireTime())
+			if err != nil {
+				return time.Time{}, status.Errorf(codes.Internal, "invalid expire time: %v", err)
+			}
+			if next.IsZero() || expire.Before(next) {
+				next = expire
+			}
+		}
+	}
+
+	return next, nil
+}
+
+// findHold returns the first Hold of l in the state provided,
+// placed by member, or by anyone if member is empty.
+func findHold(l *Lending, member string, state library.Hold_State) *library.Hold {
+	for _, h := range l.Holds {
+		if h.GetState() == state && (member == "" || h.GetMember() == member) {
+			return h
+		}
+	}
+	return nil
+}
+
+// isOpenHold reports whether h is waiting or ready.
+func isOpenHold(h *library.Hold) bool {
+	return h.GetState() == library.Hold_WAITING || h.GetState() == library.Hold_READY
+}
+
+func keyOfHold(h *library.Hold) listKey {
+	return listKey{
+		Seconds: h.GetCreateTime().GetSeconds(),
+		Nanos:   h.GetCreateTime().GetNanos(),
+		ID:      h.GetId(),
+	}
+}
@@ -0,0 +1,396 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+func TestSettleHolds(t *testing.T) {
+	const holdPeriod = 72 * time.Hour
+	now := time.Date(2017, 10, 1, 12, 0, 0, 0, time.UTC)
+	expireAt := func(t time.Time) *library.Hold {
+		ts, _ := ptypes.TimestampProto(t)
+		return &library.Hold{State: library.Hold_READY, ExpireTime: ts}
+	}
+	waiting := func() *library.Hold {
+		return &library.Hold{State: library.Hold_WAITING}
+	}
+
+	tests := []struct {
+		name      string
+		available int32
+		holds     []*library.Hold
+		// wantStates and wantPositions are the states
+		// and positions of the holds after settling.
+		wantStates    []library.Hold_State
+		wantPositions []int32
+		wantAvailable int32
+	}{
+		{
+			name:          "waiting in order placed",
+			available:     0,
+			holds:         []*library.Hold{waiting(), waiting(), waiting()},
+			wantStates:    []library.Hold_State{library.Hold_WAITING, library.Hold_WAITING, library.Hold_WAITING},
+			wantPositions: []int32{1, 2, 3},
+			wantAvailable: 0,
+		},
+		{
+			name:      "closed holds skipped",
+			available: 0,
+			holds: []*library.Hold{
+				{State: library.Hold_CANCELLED, Position: 1},
+				waiting(),
+				{State: library.Hold_FULFILLED},
+				waiting(),
+			},
+			wantStates:    []library.Hold_State{library.Hold_CANCELLED, library.Hold_WAITING, library.Hold_FULFILLED, library.Hold_WAITING},
+			wantPositions: []int32{0, 1, 0, 2},
+			wantAvailable: 0,
+		},
+		{
+			name:          "first holds made ready",
+			available:     2,
+			holds:         []*library.Hold{waiting(), waiting(), waiting()},
+			wantStates:    []library.Hold_State{library.Hold_READY, library.Hold_READY, library.Hold_WAITING},
+			wantPositions: []int32{0, 0, 1},
+			wantAvailable: 0,
+		},
+		{
+			name:          "ready hold kept",
+			available:     0,
+			holds:         []*library.Hold{expireAt(now.Add(time.Second)), waiting()},
+			wantStates:    []library.Hold_State{library.Hold_READY, library.Hold_WAITING},
+			wantPositions: []int32{0, 1},
+			wantAvailable: 0,
+		},
+		{
+			name:          "expired hold passed on",
+			available:     0,
+			holds:         []*library.Hold{expireAt(now), waiting(), waiting()},
+			wantStates:    []library.Hold_State{library.Hold_EXPIRED, library.Hold_READY, library.Hold_WAITING},
+			wantPositions: []int32{0, 0, 1},
+			wantAvailable: 0,
+		},
+		{
+			name:          "expired hold without waiting holds",
+			available:     1,
+			holds:         []*library.Hold{expireAt(now.Add(-time.Hour))},
+			wantStates:    []library.Hold_State{library.Hold_EXPIRED},
+			wantPositions: []int32{0},
+			wantAvailable: 2,
+		},
+	}
+	for _, tt := range tests {
+		s := &LendingService{holdPeriod: holdPeriod}
+		l := &Lending{
+			Book:  &library.Book{AvailableCopies: tt.available},
+			Holds: tt.holds,
+		}
+		if err := s.settleHolds(l, now); err != nil {
+			t.Errorf("%s: settleHolds returned error: %v", tt.name, err)
+			continue
+		}
+		for i, h := range l.Holds {
+			if h.GetState() != tt.wantStates[i] || h.GetPosition() != tt.wantPositions[i] {
+				t.Errorf("%s: hold %d is %v at position %d, want %v at position %d",
+					tt.name, i, h.GetState(), h.GetPosition(), tt.wantStates[i], tt.wantPositions[i])
+			}
+		}
+		if got := l.Book.GetAvailableCopies(); got != tt.wantAvailable {
+			t.Errorf("%s: %d available copies, want %d", tt.name, got, tt.wantAvailable)
+		}
+	}
+}
+
+func TestSettleHoldsReadyTimes(t *testing.T) {
+	const holdPeriod = 72 * time.Hour
+	now := time.Date(2017, 10, 1, 12, 0, 0, 0, time.UTC)
+	s := &LendingService{holdPeriod: holdPeriod}
+	l := &Lending{
+		Book:  &library.Book{AvailableCopies: 1},
+		Holds: []*library.Hold{{State: library.Hold_WAITING}},
+	}
+	if err := s.settleHolds(l, now); err != nil {
+		t.Fatalf("settleHolds returned error: %v", err)
+	}
+
+	h := l.Holds[0]
+	ready, err := ptypes.Timestamp(h.GetReadyTime())
+	if err != nil || !ready.Equal(now) {
+		t.Errorf("ready time is %v, want %v", h.GetReadyTime(), now)
+	}
+	expire, err := ptypes.Timestamp(h.GetExpireTime())
+	if err != nil || !expire.Equal(now.Add(holdPeriod)) {
+		t.Errorf("expire time is %v, want %v", h.GetExpireTime(), now.Add(holdPeriod))
+	}
+
+	// The hold expires once the hold period has passed
+	if err := s.settleHolds(l, now.Add(holdPeriod-time.Nanosecond)); err != nil {
+		t.Fatalf("settleHolds returned error: %v", err)
+	}
+	if h.GetState() != library.Hold_READY {
+		t.Errorf("hold is %v before the hold period passed, want %v", h.GetState(), library.Hold_READY)
+	}
+	if err := s.settleHolds(l, now.Add(holdPeriod)); err != nil {
+		t.Fatalf("settleHolds returned error: %v", err)
+	}
+	if h.GetState() != library.Hold_EXPIRED {
+		t.Errorf("hold is %v after the hold period passed, want %v", h.GetState(), library.Hold_EXPIRED)
+	}
+}
+
+// holdsByMember returns the Holds on the Book
+// with the ISBN provided, keyed by member.
+func holdsByMember(t *testing.T, store LoanStore, isbn string) map[string]*library.Hold {
+	t.Helper()
+	holds, err := store.QueryHolds(context.Background(), func(h *library.Hold) bool {
+		return h.GetIsbn() == isbn
+	})
+	if err != nil {
+		t.Fatalf("QueryHolds returned error: %v", err)
+	}
+	byMember := map[string]*library.Hold{}
+	for _, h := range holds {
+		byMember[h.GetMember()] = h
+	}
+	return byMember
+}
+
+func TestHoldQueue(t *testing.T) {
+	ctx := context.Background()
+	s, store, members := newTestLendingService()
+	for _, id := range []string{"alice", "bob", "carol", "dave"} {
+		addMember(t, members, id, 5)
+	}
+	const isbn = "9780140009729"
+
+	_, err := s.PlaceHold(ctx, &library.PlaceHoldRequest{Isbn: isbn, Member: "carol"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("PlaceHold on an available book returned error %v, want FailedPrecondition", err)
+	}
+	alice, err := s.Checkout(ctx, &library.CheckoutRequest{Isbn: isbn, Member: "alice"})
+	if err != nil {
+		t.Fatalf("Checkout returned error: %v", err)
+	}
+	bob, err := s.Checkout(ctx, &library.CheckoutRequest{Isbn: isbn, Member: "bob"})
+	if err != nil {
+		t.Fatalf("Checkout returned error: %v", err)
+	}
+
+	for i, id := range []string{"carol", "dave"} {
+		h, err := s.PlaceHold(ctx, &library.PlaceHoldRequest{Isbn: isbn, Member: id})
+		if err != nil {
+			t.Fatalf("PlaceHold returned error: %v", err)
+		}
+		if h.GetState() != library.Hold_WAITING || h.GetPosition() != int32(i+1) {
+			t.Errorf("PlaceHold by %s returned %v at position %d, want WAITING at position %d", id, h.GetState(), h.GetPosition(), i+1)
+		}
+	}
+	_, err = s.PlaceHold(ctx, &library.PlaceHoldRequest{Isbn: isbn, Member: "carol"})
+	if status.Code(err) != codes.AlreadyExists {
+		t.Errorf("second PlaceHold returned error %v, want AlreadyExists", err)
+	}
+	_, err = s.Renew(ctx, &library.RenewRequest{Id: alice.GetId()})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Renew with waiting holds returned error %v, want FailedPrecondition", err)
+	}
+
+	// The returned copy is set aside for the first hold
+	_, err = s.Return(ctx, &library.ReturnRequest{Id: bob.GetId()})
+	if err != nil {
+		t.Fatalf("Return returned error: %v", err)
+	}
+	holds := holdsByMember(t, store, isbn)
+	if holds["carol"].GetState() != library.Hold_READY || holds["carol"].GetExpireTime() == nil {
+		t.Errorf("hold of carol is %v after Return, want READY with an expire time", holds["carol"])
+	}
+	if holds["dave"].GetState() != library.Hold_WAITING || holds["dave"].GetPosition() != 1 {
+		t.Errorf("hold of dave is %v at position %d after Return, want WAITING at position 1", holds["dave"].GetState(), holds["dave"].GetPosition())
+	}
+	if got := availableCopies(t, store, isbn); got != 0 {
+		t.Errorf("%d copies available with a ready hold, want 0", got)
+	}
+	_, err = s.Checkout(ctx, &library.CheckoutRequest{Isbn: isbn, Member: "dave"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Checkout of a copy set aside for another member returned error %v, want FailedPrecondition", err)
+	}
+	_, err = s.Checkout(ctx, &library.CheckoutRequest{Isbn: isbn, Member: "carol"})
+	if err != nil {
+		t.Fatalf("Checkout by the ready hold returned error: %v", err)
+	}
+	if got := holdsByMember(t, store, isbn)["carol"].GetState(); got != library.Hold_FULFILLED {
+		t.Errorf("hold of carol is %v after Checkout, want FULFILLED", got)
+	}
+
+	resp, err := s.ListHolds(ctx, &library.ListHoldsRequest{Isbn: isbn})
+	if err != nil {
+		t.Fatalf("ListHolds returned error: %v", err)
+	}
+	if len(resp.GetHolds()) != 1 || resp.GetHolds()[0].GetMember() != "dave" {
+		t.Errorf("ListHolds returned %v, want the open hold of dave", resp.GetHolds())
+	}
+	dave := resp.GetHolds()[0]
+	cancelled, err := s.CancelHold(ctx, &library.CancelHoldRequest{Id: dave.GetId()})
+	if err != nil {
+		t.Fatalf("CancelHold returned error: %v", err)
+	}
+	if cancelled.GetState() != library.Hold_CANCELLED {
+		t.Errorf("CancelHold returned %v, want CANCELLED", cancelled.GetState())
+	}
+	_, err = s.CancelHold(ctx, &library.CancelHoldRequest{Id: dave.GetId()})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("second CancelHold returned error %v, want FailedPrecondition", err)
+	}
+
+	// Without waiting holds, loans can be renewed again
+	_, err = s.Renew(ctx, &library.RenewRequest{Id: alice.GetId()})
+	if err != nil {
+		t.Errorf("Renew returned error: %v", err)
+	}
+}
+
+func TestCancelReadyHold(t *testing.T) {
+	ctx := context.Background()
+	s, store, members := newTestLendingService()
+	for _, id := range []string{"alice", "bob", "carol"} {
+		addMember(t, members, id, 5)
+	}
+	const isbn = "9780140008388"
+	var loans []*library.Loan
+	for _, id := range []string{"alice", "bob"} {
+		loan, err := s.Checkout(ctx, &library.CheckoutRequest{Isbn: isbn, Member: id})
+		if err != nil {
+			t.Fatalf("Checkout returned error: %v", err)
+		}
+		loans = append(loans, loan)
+	}
+	hold, err := s.PlaceHold(ctx, &library.PlaceHoldRequest{Isbn: isbn, Member: "carol"})
+	if err != nil {
+		t.Fatalf("PlaceHold returned error: %v", err)
+	}
+	_, err = s.Return(ctx, &library.ReturnRequest{Id: loans[0].GetId()})
+	if err != nil {
+		t.Fatalf("Return returned error: %v", err)
+	}
+	if got := availableCopies(t, store, isbn); got != 0 {
+		t.Errorf("%d copies available with a ready hold, want 0", got)
+	}
+
+	// Cancelling the ready hold releases the copy set aside
+	_, err = s.CancelHold(ctx, &library.CancelHoldRequest{Id: hold.GetId()})
+	if err != nil {
+		t.Fatalf("CancelHold returned error: %v", err)
+	}
+	if got := availableCopies(t, store, isbn); got != 1 {
+		t.Errorf("%d copies available after CancelHold, want 1", got)
+	}
+}
+
+func TestReadyHoldReservesCopy(t *testing.T) {
+	ctx := context.Background()
+	s, _, members := newTestLendingService()
+	for _, id := range []string{"alice", "bob", "carol", "dave"} {
+		addMember(t, members, id, 5)
+	}
+	const isbn = "9780140008388"
+	var branches []*library.Branch
+	for _, name := range []string{"Central", "East"} {
+		b, err := s.CreateBranch(ctx, &library.CreateBranchRequest{Branch: &library.Branch{Name: name}})
+		if err != nil {
+			t.Fatalf("CreateBranch returned error: %v", err)
+		}
+		branches = append(branches, b)
+	}
+	branch := branches[0]
+	branchAvailable := func() int32 {
+		t.Helper()
+		a, err := s.GetAvailability(ctx, &library.GetAvailabilityRequest{Isbn: isbn})
+		if err != nil {
+			t.Fatalf("GetAvailability returned error: %v", err)
+		}
+		return a.GetBranches()[0].GetAvailableCopies()
+	}
+	copyState := func() library.Copy_State {
+		t.Helper()
+		c, err := s.GetCopy(ctx, &library.GetCopyRequest{Barcode: "c-1"})
+		if err != nil {
+			t.Fatalf("GetCopy returned error: %v", err)
+		}
+		return c.GetState()
+	}
+
+	// Lend the copies without a barcode, and queue carol
+	for _, id := range []string{"alice", "bob"} {
+		_, err := s.Checkout(ctx, &library.CheckoutRequest{Isbn: isbn, Member: id})
+		if err != nil {
+			t.Fatalf("Checkout returned error: %v", err)
+		}
+	}
+	_, err := s.PlaceHold(ctx, &library.PlaceHoldRequest{Isbn: isbn, Member: "carol"})
+	if err != nil {
+		t.Fatalf("PlaceHold returned error: %v", err)
+	}
+
+	// The new copy is set aside for carol, and isn't available at its branch
+	_, err = s.AddCopy(ctx, &library.AddCopyRequest{Copy: &library.Copy{Barcode: "c-1", Isbn: isbn, BranchId: branch.GetId()}})
+	if err != nil {
+		t.Fatalf("AddCopy returned error: %v", err)
+	}
+	if h := holdsByMember(t, s.store, isbn)["carol"]; h.GetState() != library.Hold_READY || h.GetBarcode() != "c-1" {
+		t.Errorf("hold of carol is %v after AddCopy, want READY with barcode c-1", h)
+	}
+	if got := copyState(); got != library.Copy_RESERVED {
+		t.Errorf("copy is %v with a ready hold, want RESERVED", got)
+	}
+	if got := branchAvailable(); got != 0 {
+		t.Errorf("%d copies available at the branch with a ready hold, want 0", got)
+	}
+	_, err = s.Checkout(ctx, &library.CheckoutRequest{Isbn: isbn, Member: "dave", Barcode: "c-1"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Checkout of the copy set aside for carol returned error %v, want FailedPrecondition", err)
+	}
+	_, err = s.TransferCopy(ctx, &library.TransferCopyRequest{Barcode: "c-1", BranchId: branches[1].GetId()})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("TransferCopy of the copy set aside for carol returned error %v, want FailedPrecondition", err)
+	}
+	loan, err := s.Checkout(ctx, &library.CheckoutRequest{Isbn: isbn, Member: "carol"})
+	if err != nil {
+		t.Fatalf("Checkout by the ready hold returned error: %v", err)
+	}
+	if loan.GetBarcode() != "c-1" {
+		t.Errorf("Checkout by the ready hold lent barcode %q, want c-1", loan.GetBarcode())
+	}
+
+	// Cancelling a ready hold makes the copy available at its branch
+	hold, err := s.PlaceHold(ctx, &library.PlaceHoldRequest{Isbn: isbn, Member: "dave"})
+	if err != nil {
+		t.Fatalf("PlaceHold returned error: %v", err)
+	}
+	_, err = s.Return(ctx, &library.ReturnRequest{Id: loan.GetId(), BranchId: branch.GetId()})
+	if err != nil {
+		t.Fatalf("Return returned error: %v", err)
+	}
+	if got := copyState(); got != library.Copy_RESERVED {
+		t.Errorf("copy is %v after Return with a waiting hold, want RESERVED", got)
+	}
+	_, err = s.CancelHold(ctx, &library.CancelHoldRequest{Id: hold.GetId()})
+	if err != nil {
+		t.Fatalf("CancelHold returned error: %v", err)
+	}
+	if got := copyState(); got != library.Copy_AVAILABLE {
+		t.Errorf("copy is %v after CancelHold, want AVAILABLE", got)
+	}
+	if got := branchAvailable(); got != 1 {
+		t.Errorf("%d copies available at the branch after CancelHold, want 1", got)
+	}
+}
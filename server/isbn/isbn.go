@@ -0,0 +1,131 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+// Package isbn validates and converts International Standard Book Numbers.
+// ISBNs are canonicalized to their 13 digit form, without hyphens.
+package isbn
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrChecksum is returned when the check digit of an ISBN is wrong.
+var ErrChecksum = errors.New("invalid ISBN check digit")
+
+// Parse parses an ISBN-10 or ISBN-13, optionally containing
+// hyphens or spaces, and returns the canonical ISBN-13.
+func Parse(s string) (string, error) {
+	digits := strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, s)
+
+	switch len(digits) {
+	case 10:
+		if !isDigits(digits[:9]) {
+			return "", fmt.Errorf("invalid ISBN-10 %q", s)
+		}
+		check := digits[9]
+		if check == 'x' {
+			check = 'X'
+		}
+		if check != checkDigit10(digits[:9]) {
+			return "", ErrChecksum
+		}
+		isbn13 := "978" + digits[:9]
+		return isbn13 + string(checkDigit13(isbn13)), nil
+	case 13:
+		if !isDigits(digits) {
+			return "", fmt.Errorf("invalid ISBN-13 %q", s)
+		}
+		if !strings.HasPrefix(digits, "978") && !strings.HasPrefix(digits, "979") {
+			return "", fmt.Errorf("invalid ISBN-13 prefix in %q", s)
+		}
+		if digits[12] != checkDigit13(digits[:12]) {
+			return "", ErrChecksum
+		}
+		return digits, nil
+	}
+
+	return "", fmt.Errorf("invalid ISBN %q: must have 10 or 13 digits", s)
+}
+
+// To10 returns the ISBN-10 form of the canonical ISBN-13 provided.
+// Only ISBN-13s starting with 978 have an ISBN-10 form.
+func To10(isbn13 string) (string, bool) {
+	if len(isbn13) != 13 || !strings.HasPrefix(isbn13, "978") {
+		return "", false
+	}
+	body := isbn13[3:12]
+	return body + string(checkDigit10(body)), true
+}
+
+// FromLegacy converts a numeric ISBN, as stored before ISBNs
+// were strings, to the canonical ISBN-13. Numbers of up to
+// 10 digits are read as ISBN-10s with their leading zeros dropped.
+func FromLegacy(n int64) (string, error) {
+	if n <= 0 {
+		return "", fmt.Errorf("invalid ISBN %d", n)
+	}
+	s := strconv.FormatInt(n, 10)
+	if len(s) <= 10 {
+		return Parse(fmt.Sprintf("%010d", n))
+	}
+	return Parse(fmt.Sprintf("%013d", n))
+}
+
+// Legacy converts a canonical ISBN-13 to its numeric form.
+// ISBN-10s are preferred, for compatibility with the numbers
+// stored before ISBNs were strings. ISBN-10s with the check
+// digit X can't be represented, so the ISBN-13 is used instead.
+func Legacy(isbn13 string) int64 {
+	s := isbn13
+	if isbn10, ok := To10(isbn13); ok && isDigits(isbn10) {
+		s = isbn10
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+// checkDigit10 returns the ISBN-10 check digit of the 9 digits provided.
+func checkDigit10(digits string) byte {
+	sum := 0
+	for i := 0; i < 9; i++ {
+		sum += (10 - i) * int(digits[i]-'0')
+	}
+	check := (11 - sum%11) % 11
+	if check == 10 {
+		return 'X'
+	}
+	return byte('0' + check)
+}
+
+// checkDigit13 returns the ISBN-13 check digit of the 12 digits provided.
+func checkDigit13(digits string) byte {
+	sum := 0
+	for i := 0; i < 12; i++ {
+		weight := 1
+		if i%2 == 1 {
+			weight = 3
+		}
+		sum += weight * int(digits[i]-'0')
+	}
+	return byte('0' + (10-sum%10)%10)
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,108 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package isbn
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "0306406152", want: "9780306406157"},
+		{in: "0-306-40615-2", want: "9780306406157"},
+		{in: "0 306 40615 2", want: "9780306406157"},
+		{in: "080442957X", want: "9780804429573"},
+		{in: "080442957x", want: "9780804429573"},
+		{in: "9780306406157", want: "9780306406157"},
+		{in: "978-0-306-40615-7", want: "9780306406157"},
+		{in: "9791234567896", want: "9791234567896"},
+		{in: "0306406153", wantErr: true},
+		{in: "9780306406158", wantErr: true},
+		{in: "030640615X", wantErr: true},
+		{in: "03064061A2", wantErr: true},
+		{in: "978030640615A", wantErr: true},
+		{in: "9770306406157", wantErr: true},
+		{in: "030640615", wantErr: true},
+		{in: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.in)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("Parse(%q) = %q, want error", tt.in, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Parse(%q) returned error: %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Parse(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseChecksum(t *testing.T) {
+	for _, in := range []string{"0306406153", "9780306406158"} {
+		if _, err := Parse(in); err != ErrChecksum {
+			t.Errorf("Parse(%q) returned error %v, want %v", in, err, ErrChecksum)
+		}
+	}
+}
+
+func TestTo10(t *testing.T) {
+	tests := []struct {
+		in     string
+		want   string
+		wantOK bool
+	}{
+		{in: "9780306406157", want: "0306406152", wantOK: true},
+		{in: "9780804429573", want: "080442957X", wantOK: true},
+		{in: "9791234567896"},
+		{in: "978030640615"},
+	}
+	for _, tt := range tests {
+		got, ok := To10(tt.in)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("To10(%q) = %q, %t, want %q, %t", tt.in, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestLegacy(t *testing.T) {
+	tests := []struct {
+		isbn13 string
+		legacy int64
+	}{
+		{isbn13: "9780306406157", legacy: 306406152},
+		{isbn13: "9781566199094", legacy: 1566199093},
+		// ISBN-10s ending in X can't be represented as numbers
+		{isbn13: "9780804429573", legacy: 9780804429573},
+		{isbn13: "9791234567896", legacy: 9791234567896},
+	}
+	for _, tt := range tests {
+		if got := Legacy(tt.isbn13); got != tt.legacy {
+			t.Errorf("Legacy(%q) = %d, want %d", tt.isbn13, got, tt.legacy)
+		}
+		got, err := FromLegacy(tt.legacy)
+		if err != nil {
+			t.Errorf("FromLegacy(%d) returned error: %v", tt.legacy, err)
+			continue
+		}
+		if got != tt.isbn13 {
+			t.Errorf("FromLegacy(%d) = %q, want %q", tt.legacy, got, tt.isbn13)
+		}
+	}
+}
+
+func TestFromLegacyInvalid(t *testing.T) {
+	for _, n := range []int64{0, -1, 306406153, 9780306406158} {
+		if got, err := FromLegacy(n); err == nil {
+			t.Errorf("FromLegacy(%d) = %q, want error", n, got)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"golang.org/x/net/context"
+	"golang.org/x/text/language"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// acceptLanguageMetadataKey is the request metadata key of the
+// languages the user reads, as forwarded by grpc-web from the
+// Accept-Language header of the browser.
+const acceptLanguageMetadataKey = "accept-language"
+
+// localize sets the localized title and description of books, in
+// the language the user making the request reads best. It must be
+// called on every Book returned to clients.
+func (s *BookService) localize(ctx context.Context, books ...*library.Book) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	localizeBooks(firstMetadataValue(md, acceptLanguageMetadataKey), books...)
+}
+
+// localizeBooks sets the localized title and description of books,
+// in the language best matching acceptLanguage, the value of an
+// Accept-Language header. Nil Books are skipped.
+func localizeBooks(acceptLanguage string, books ...*library.Book) {
+	// An invalid header is treated like a missing one
+	prefs, _, _ := language.ParseAcceptLanguage(acceptLanguage)
+	for _, bk := range books {
+		if bk != nil {
+			localizeBook(bk, prefs)
+		}
+	}
+}
+
+// localizeBook sets the localized title and description of bk from
+// the localization best matching prefs, the languages the user reads,
+// most preferred first. The title and description of bk are used if
+// no localization matches. Localizations without a description fall
+// back to the description of bk.
+func localizeBook(bk *library.Book, prefs []language.Tag) {
+	bk.Localized = &library.Localization{
+		LanguageCode: bk.GetLanguageCode(),
+		Title:        bk.GetTitle(),
+		Description:  bk.GetDescription(),
+	}
+	if len(prefs) == 0 || len(bk.GetLocalizations()) == 0 {
+		return
+	}
+
+	// The language of the book comes first, so it is
+	// preferred over localizations that match as well.
+	tags := []language.Tag{language.Make(bk.GetLanguageCode())}
+	for _, l := range bk.GetLocalizations() {
+		tags = append(tags, language.Make(l.GetLanguageCode()))
+	}
+	_, i, confidence := language.NewMatcher(tags).Match(prefs...)
+	if confidence == language.No || i == 0 {
+		return
+	}
+	l := bk.GetLocalizations()[i-1]
+	bk.Localized.LanguageCode = l.GetLanguageCode()
+	bk.Localized.Title = l.GetTitle()
+	if l.GetDescription() != "" {
+		bk.Localized.Description = l.GetDescription()
+	}
+}
+
+// canonicalizeLanguages validates the language codes of bk and its
+// localizations, and replaces them with their canonical form.
+func canonicalizeLanguages(bk *library.Book) error {
+	var err error
+	if bk.GetLanguageCode() != "" {
+		bk.LanguageCode, err = canonicalLanguage(bk.GetLanguageCode())
+		if err != nil {
+			return err
+		}
+	}
+
+	seen := map[string]bool{bk.GetLanguageCode(): true}
+	for _, l := range bk.GetLocalizations() {
+		if l.GetLanguageCode() == "" {
+			return status.Error(codes.InvalidArgument, "The language code of a localization must not be empty")
+		}
+		l.LanguageCode, err = canonicalLanguage(l.GetLanguageCode())
+		if err != nil {
+			return err
+		}
+		switch {
+		case l.GetTitle() == "":
+			return status.Errorf(codes.InvalidArgument, "The %s title must not be empty", l.GetLanguageCode())
+		case l.GetLanguageCode() == bk.GetLanguageCode():
+			return status.Errorf(codes.InvalidArgument, "The %s localization is in the language of the book", l.GetLanguageCode())
+		case seen[l.GetLanguageCode()]:
+			return status.Errorf(codes.InvalidArgument, "The book has more than one %s localization", l.GetLanguageCode())
+		}
+		seen[l.GetLanguageCode()] = true
+	}
+	return nil
+}
+
+// canonicalLanguage returns the canonical form of the BCP 47
+// language code provided, or an InvalidArgument error if it
+// isn't a valid code.
+func canonicalLanguage(code string) (string, error) {
+	tag, err := language.Parse(code)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "Invalid language code %q", code)
+	}
+	return tag.String(), nil
+}
+
+// checkOriginal canonicalizes the original ISBN of bk, and returns
+// an InvalidArgument error if no Book with that ISBN exists,
+// or it is the ISBN of bk. The caller must hold s.originalsMu
+// until bk is stored, so that the original can't be deleted
+// in between.
+func (s *BookService) checkOriginal(ctx context.Context, bk *library.Book) error {
+	id, err := requestIsbn(bk.GetOriginalIsbn(), 0)
+	if err != nil {
+		return err
+	}
+	if id == bk.GetIsbn() {
+		return status.Error(codes.InvalidArgument, "A book can't be a translation of itself")
+	}
+	_, err = s.store.GetBook(ctx, id)
+	if status.Code(err) == codes.NotFound {
+		return status.Errorf(codes.InvalidArgument, "Unknown original book %q", id)
+	}
+	if err != nil {
+		return err
+	}
+	bk.OriginalIsbn = id
+	return nil
+}
@@ -0,0 +1,361 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+const (
+	defaultLoanPeriod  = 21 * 24 * time.Hour
+	defaultMaxRenewals = 2
+	defaultHoldPeriod  = 7 * 24 * time.Hour
+)
+
+// LendingService implements library.LendingServiceServer.
+type LendingService struct {
+	store       LoanStore
+	members     MemberStore
+	branches    BranchStore
+	tokenKey    []byte
+	loanPeriod  time.Duration
+	maxRenewals int
+	holdPeriod  time.Duration
+
+	// checkoutMu serializes checkouts, so that members
+	// can't check out more books than their borrowing limit.
+	checkoutMu sync.Mutex
+}
+
+// NewLendingService returns a LendingService backed by the LoanStore
+// provided, lending to the Members in the MemberStore.
+func NewLendingService(store LoanStore, members MemberStore, opts ...LendingOption) *LendingService {
+	s := &LendingService{
+		store:       store,
+		members:     members,
+		branches:    &MemoryBranchStore{},
+		loanPeriod:  defaultLoanPeriod,
+		maxRenewals: defaultMaxRenewals,
+		holdPeriod:  defaultHoldPeriod,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.tokenKey == nil {
+		s.tokenKey = newTokenKey()
+	}
+	return s
+}
+
+// loanPageToken is the content of the page
+// tokens handed out by ListLoans.
+type loanPageToken struct {
+	// Member, Isbn and IncludeReturned are the filters of the request
+	// that created the token. They may not change between pages.
+	Member          string `json:"m,omitempty"`
+	Isbn            string `json:"i,omitempty"`
+	IncludeReturned bool   `json:"r,omitempty"`
+	// Last is the key of the last Loan on the previous page.
+	Last listKey `json:"l"`
+}
+
+func (loanPageToken) kind() string { return "loans" }
+
+func (s *LendingService) Checkout(ctx context.Context, req *library.CheckoutRequest) (*library.Loan, error) {
+	ctx, err := requestActor(ctx, s.members)
+	if err != nil {
+		return nil, err
+	}
+	var id string
+	if req.GetIsbn() != "" || req.GetBarcode() == "" {
+		id, err = requestIsbn(req.GetIsbn(), 0)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if req.GetBarcode() != "" {
+		c, err := s.store.GetCopy(ctx, req.GetBarcode())
+		if err != nil {
+			return nil, err
+		}
+		if id != "" && c.GetIsbn() != id {
+			return nil, status.Error(codes.InvalidArgument, "The copy is not of the book")
+		}
+		id = c.GetIsbn()
+	}
+	var loan *library.Loan
+	err = useActiveMember(ctx, s.members, req.GetMember(), func(member *library.Member) error {
+		var err error
+		loan, err = s.checkout(ctx, member, id, req)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return loan, nil
+}
+
+// checkout lends the Book with the ISBN provided to the
+// member, as requested by req, and returns the Loan.
+func (s *LendingService) checkout(ctx context.Context, member *library.Member, id string, req *library.CheckoutRequest) (*library.Loan, error) {
+	// Hold the lock until the loan is stored, so concurrent
+	// checkouts by the member can't all pass the limit.
+	s.checkoutMu.Lock()
+	defer s.checkoutMu.Unlock()
+	onLoan, err := s.store.QueryLoans(ctx, func(l *library.Loan) bool {
+		return l.GetMember() == member.GetId() && l.GetReturnTime() == nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(onLoan) >= int(member.GetBorrowingLimit()) {
+		return nil, status.Errorf(codes.FailedPrecondition, "The member has reached their borrowing limit of %d books", member.GetBorrowingLimit())
+	}
+
+	now := time.Now()
+	loan := &library.Loan{
+		Isbn:   id,
+		Member: req.GetMember(),
+	}
+	loan.CheckoutTime, err = ptypes.TimestampProto(now)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "invalid checkout time: %v", err)
+	}
+	loan.DueTime, err = ptypes.TimestampProto(now.Add(s.loanPeriod))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "invalid due time: %v", err)
+	}
+	loan.Id, err = newID("loan")
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.store.UpdateLending(ctx, id, func(l *Lending) error {
+		err := s.settleHolds(l, now)
+		if err != nil {
+			return err
+		}
+		hold := findHold(l, req.GetMember(), library.Hold_READY)
+		barcode := req.GetBarcode()
+		if barcode == "" && hold != nil {
+			// Lend the copy set aside for the member
+			barcode = hold.GetBarcode()
+		}
+		if barcode == "" {
+			// Lend an available barcoded copy, so the copies of each
+			// branch stay in step with the available copies of the book.
+			// Books may also have copies without a barcode.
+			barcode = availableBarcode(l)
+			if barcode == "" && len(l.Copies) > 0 && int(l.Book.GetCopies()) <= len(l.Copies) {
+				return status.Error(codes.FailedPrecondition, "No copies of the book are available")
+			}
+		}
+		if barcode != "" {
+			c := findCopy(l, barcode)
+			if c == nil {
+				return status.Error(codes.NotFound, "Copy could not be found")
+			}
+			switch c.GetState() {
+			case library.Copy_AVAILABLE:
+			case library.Copy_RESERVED:
+				if hold.GetBarcode() != barcode {
+					return status.Error(codes.FailedPrecondition, "The copy is set aside for a hold")
+				}
+			default:
+				return status.Error(codes.FailedPrecondition, "The copy is on loan or in transit")
+			}
+			c.State = library.Copy_ON_LOAN
+			loan.Barcode = barcode
+		}
+		// Check out the copy set aside for the member, if any.
+		// Members may check out another copy instead, which
+		// makes the copy set aside available at its branch.
+		if hold != nil {
+			releaseCopy(l, hold)
+			hold.State = library.Hold_FULFILLED
+		} else if l.Book.GetAvailableCopies() > 0 {
+			l.Book.AvailableCopies--
+		} else {
+			return status.Error(codes.FailedPrecondition, "No copies of the book are available")
+		}
+		l.Loans = append(l.Loans, loan)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return loan, nil
+}
+
+func (s *LendingService) Return(ctx context.Context, req *library.ReturnRequest) (*library.Loan, error) {
+	ctx, err := requestActor(ctx, s.members)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetBranchId() != "" {
+		err := s.checkBranch(ctx, req.GetBranchId())
+		if err != nil {
+			return nil, err
+		}
+	}
+	now := time.Now()
+	returnTime, err := ptypes.TimestampProto(now)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "invalid return time: %v", err)
+	}
+	return s.updateLoan(ctx, req.GetId(), func(l *Lending, loan *library.Loan) error {
+		if loan.GetReturnTime() != nil {
+			return status.Error(codes.FailedPrecondition, "The loan has already been returned")
+		}
+		loan.ReturnTime = returnTime
+		c := findCopy(l, loan.GetBarcode())
+		if c == nil && req.GetBranchId() != "" {
+			return status.Error(codes.InvalidArgument, "Only copies with a barcode can be returned to a branch")
+		}
+		if c != nil {
+			c.State = library.Copy_AVAILABLE
+			if req.GetBranchId() != "" {
+				c.BranchId = req.GetBranchId()
+			}
+		}
+		l.Book.AvailableCopies++
+		// Offer the copy to the next hold
+		return s.settleHolds(l, now)
+	})
+}
+
+func (s *LendingService) Renew(ctx context.Context, req *library.RenewRequest) (*library.Loan, error) {
+	ctx, err := requestActor(ctx, s.members)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	return s.updateLoan(ctx, req.GetId(), func(l *Lending, loan *library.Loan) error {
+		if loan.GetReturnTime() != nil {
+			return status.Error(codes.FailedPrecondition, "A returned loan can't be renewed")
+		}
+		if int(loan.GetRenewals()) >= s.maxRenewals {
+			return status.Errorf(codes.FailedPrecondition, "The loan has already been renewed the maximum of %d times", s.maxRenewals)
+		}
+		err := s.settleHolds(l, now)
+		if err != nil {
+			return err
+		}
+		if findHold(l, "", library.Hold_WAITING) != nil {
+			return status.Error(codes.FailedPrecondition, "Other members are waiting for the book")
+		}
+		due, err := ptypes.Timestamp(loan.GetDueTime())
+		if err != nil {
+			return status.Errorf(codes.Internal, "invalid due time: %v", err)
+		}
+		// Overdue loans are renewed from now
+		if due.Before(now) {
+			due = now
+		}
+		loan.DueTime, err = ptypes.TimestampProto(due.Add(s.loanPeriod))
+		if err != nil {
+			return status.Errorf(codes.Internal, "invalid due time: %v", err)
+		}
+		loan.Renewals++
+		return nil
+	})
+}
+
+func (s *LendingService) ListLoans(ctx context.Context, req *library.ListLoansRequest) (*library.ListLoansResponse, error) {
+	var id string
+	if req.GetIsbn() != "" {
+		var err error
+		id, err = requestIsbn(req.GetIsbn(), 0)
+		if err != nil {
+			return nil, err
+		}
+	}
+	pageSize, err := parsePageSize(req.GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+
+	var last *listKey
+	if req.GetPageToken() != "" {
+		var token loanPageToken
+		if !decodeToken(s.tokenKey, req.GetPageToken(), &token) {
+			return nil, status.Error(codes.InvalidArgument, "Invalid page token")
+		}
+		if token.Member != req.GetMember() || token.Isbn != id || token.IncludeReturned != req.GetIncludeReturned() {
+			return nil, status.Error(codes.InvalidArgument, "The filters must not change between pages")
+		}
+		last = &token.Last
+	}
+
+	loans, err := s.store.QueryLoans(ctx, func(l *library.Loan) bool {
+		return (req.GetMember() == "" || l.GetMember() == req.GetMember()) &&
+			(id == "" || l.GetIsbn() == id) &&
+			(req.GetIncludeReturned() || l.GetReturnTime() == nil) &&
+			(last == nil || last.less(keyOfLoan(l)))
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(loans, func(i, j int) bool {
+		return keyOfLoan(loans[i]).less(keyOfLoan(loans[j]))
+	})
+
+	resp := &library.ListLoansResponse{}
+	if len(loans) > pageSize {
+		loans = loans[:pageSize]
+		resp.NextPageToken, err = encodeToken(s.tokenKey, loanPageToken{
+			Member:          req.GetMember(),
+			Isbn:            id,
+			IncludeReturned: req.GetIncludeReturned(),
+			Last:            keyOfLoan(loans[len(loans)-1]),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	resp.Loans = loans
+
+	return resp, nil
+}
+
+func keyOfLoan(l *library.Loan) listKey {
+	return listKey{
+		Seconds: l.GetCheckoutTime().GetSeconds(),
+		Nanos:   l.GetCheckoutTime().GetNanos(),
+		ID:      l.GetId(),
+	}
+}
+
+// updateLoan calls update with the Lending of the Book lent by
+// the Loan with the ID provided, and the Loan, and stores the result.
+func (s *LendingService) updateLoan(ctx context.Context, id string, update func(*Lending, *library.Loan) error) (*library.Loan, error) {
+	loan, err := s.store.GetLoan(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.store.UpdateLending(ctx, loan.GetIsbn(), func(l *Lending) error {
+		for _, ln := range l.Loans {
+			if ln.GetId() == id {
+				loan = ln
+				return update(l, ln)
+			}
+		}
+		return status.Error(codes.NotFound, "Loan could not be found")
+	})
+	if err != nil {
+		return nil, err
+	}
+	return loan, nil
+}
@@ -0,0 +1,243 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/net/context"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// newTestLendingService returns a LendingService lending the Fixtures
+// from the MemoryBookStore returned to the members added with addMember.
+func newTestLendingService(opts ...LendingOption) (*LendingService, *MemoryBookStore, *MemoryMemberStore) {
+	store := NewMemoryBookStore(Fixtures()...)
+	members := &MemoryMemberStore{}
+	return NewLendingService(store, members, opts...), store, members
+}
+
+// addMember adds an active member with the ID
+// and borrowing limit provided to members.
+func addMember(t *testing.T, members MemberStore, id string, limit int32) {
+	t.Helper()
+	err := members.AddMember(context.Background(), &library.Member{
+		Id:             id,
+		CardNumber:     id,
+		Name:           id,
+		BorrowingLimit: limit,
+		State:          library.Member_ACTIVE,
+	})
+	if err != nil {
+		t.Fatalf("AddMember returned error: %v", err)
+	}
+}
+
+// availableCopies returns the number of available copies of the Book.
+func availableCopies(t *testing.T, store BookStore, isbn string) int32 {
+	t.Helper()
+	bk, err := store.GetBook(context.Background(), isbn)
+	if err != nil {
+		t.Fatalf("GetBook returned error: %v", err)
+	}
+	return bk.GetAvailableCopies()
+}
+
+func TestCheckoutReturn(t *testing.T) {
+	ctx := context.Background()
+	const loanPeriod = 14 * 24 * time.Hour
+	s, store, members := newTestLendingService(WithLoanPeriod(loanPeriod))
+	addMember(t, members, "alice", 2)
+	addMember(t, members, "bob", 5)
+	const isbn = "9780140009729"
+
+	before := time.Now()
+	loan, err := s.Checkout(ctx, &library.CheckoutRequest{Isbn: isbn, Member: "alice"})
+	if err != nil {
+		t.Fatalf("Checkout returned error: %v", err)
+	}
+	if loan.GetId() == "" || loan.GetIsbn() != isbn || loan.GetMember() != "alice" {
+		t.Errorf("Checkout returned loan %v, want a loan of %s to alice", loan, isbn)
+	}
+	due, err := ptypes.Timestamp(loan.GetDueTime())
+	if err != nil || due.Before(before.Add(loanPeriod)) || due.After(time.Now().Add(loanPeriod)) {
+		t.Errorf("Checkout returned due time %v, want a loan period of %v", loan.GetDueTime(), loanPeriod)
+	}
+	if got := availableCopies(t, store, isbn); got != 1 {
+		t.Errorf("%d copies available after Checkout, want 1", got)
+	}
+
+	_, err = s.Checkout(ctx, &library.CheckoutRequest{Isbn: isbn, Member: "bob"})
+	if err != nil {
+		t.Fatalf("Checkout returned error: %v", err)
+	}
+	_, err = s.Checkout(ctx, &library.CheckoutRequest{Isbn: isbn, Member: "bob"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Checkout of an unavailable book returned error %v, want FailedPrecondition", err)
+	}
+	_, err = s.Checkout(ctx, &library.CheckoutRequest{Isbn: isbn, Member: "nobody"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Checkout by an unknown member returned error %v, want NotFound", err)
+	}
+
+	returned, err := s.Return(ctx, &library.ReturnRequest{Id: loan.GetId()})
+	if err != nil {
+		t.Fatalf("Return returned error: %v", err)
+	}
+	if returned.GetReturnTime() == nil {
+		t.Error("Return returned a loan without a return time")
+	}
+	if got := availableCopies(t, store, isbn); got != 1 {
+		t.Errorf("%d copies available after Return, want 1", got)
+	}
+	_, err = s.Return(ctx, &library.ReturnRequest{Id: loan.GetId()})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("second Return returned error %v, want FailedPrecondition", err)
+	}
+
+	resp, err := s.ListLoans(ctx, &library.ListLoansRequest{Member: "alice", IncludeReturned: true})
+	if err != nil {
+		t.Fatalf("ListLoans returned error: %v", err)
+	}
+	if len(resp.GetLoans()) != 1 || resp.GetLoans()[0].GetReturnTime() == nil {
+		t.Errorf("ListLoans returned %v, want the returned loan", resp.GetLoans())
+	}
+}
+
+func TestCheckoutBorrowingLimit(t *testing.T) {
+	ctx := context.Background()
+	s, _, members := newTestLendingService()
+	addMember(t, members, "alice", 2)
+
+	for _, isbn := range []string{"9780140009729", "9780140008388"} {
+		_, err := s.Checkout(ctx, &library.CheckoutRequest{Isbn: isbn, Member: "alice"})
+		if err != nil {
+			t.Fatalf("Checkout of %s returned error: %v", isbn, err)
+		}
+	}
+	_, err := s.Checkout(ctx, &library.CheckoutRequest{Isbn: "9780060929879", Member: "alice"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Checkout over the borrowing limit returned error %v, want FailedPrecondition", err)
+	}
+
+	_, err = members.UpdateMember(ctx, "alice", func(m *library.Member) error {
+		m.State = library.Member_SUSPENDED
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("UpdateMember returned error: %v", err)
+	}
+	_, err = s.Checkout(ctx, &library.CheckoutRequest{Isbn: "9780060929879", Member: "alice"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Checkout by a suspended member returned error %v, want FailedPrecondition", err)
+	}
+}
+
+func TestRenew(t *testing.T) {
+	ctx := context.Background()
+	const loanPeriod = 7 * 24 * time.Hour
+	s, _, members := newTestLendingService(WithLoanPeriod(loanPeriod), WithMaxRenewals(1))
+	addMember(t, members, "alice", 2)
+
+	loan, err := s.Checkout(ctx, &library.CheckoutRequest{Isbn: "9780140009729", Member: "alice"})
+	if err != nil {
+		t.Fatalf("Checkout returned error: %v", err)
+	}
+	renewed, err := s.Renew(ctx, &library.RenewRequest{Id: loan.GetId()})
+	if err != nil {
+		t.Fatalf("Renew returned error: %v", err)
+	}
+	due, _ := ptypes.Timestamp(loan.GetDueTime())
+	renewedDue, _ := ptypes.Timestamp(renewed.GetDueTime())
+	if !renewedDue.Equal(due.Add(loanPeriod)) || renewed.GetRenewals() != 1 {
+		t.Errorf("Renew returned due time %v after %d renewals, want %v after 1", renewedDue, renewed.GetRenewals(), due.Add(loanPeriod))
+	}
+	_, err = s.Renew(ctx, &library.RenewRequest{Id: loan.GetId()})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Renew past the maximum returned error %v, want FailedPrecondition", err)
+	}
+
+	_, err = s.Return(ctx, &library.ReturnRequest{Id: loan.GetId()})
+	if err != nil {
+		t.Fatalf("Return returned error: %v", err)
+	}
+	_, err = s.Renew(ctx, &library.RenewRequest{Id: loan.GetId()})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Renew of a returned loan returned error %v, want FailedPrecondition", err)
+	}
+	_, err = s.Renew(ctx, &library.RenewRequest{Id: "loan-unknown"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Renew of an unknown loan returned error %v, want NotFound", err)
+	}
+}
+
+func TestLendingKeepsEtag(t *testing.T) {
+	ctx := context.Background()
+	s, store, members := newTestLendingService()
+	books := NewBookService(store, WithMemberStore(members), WithLoanStore(store))
+	addMember(t, members, "alice", 5)
+	const isbn = "9780140009729"
+
+	read, err := books.GetBook(ctx, &library.GetBookRequest{Isbn: isbn})
+	if err != nil {
+		t.Fatalf("GetBook returned error: %v", err)
+	}
+	rev, err := store.Revision(ctx)
+	if err != nil {
+		t.Fatalf("Revision returned error: %v", err)
+	}
+	loan, err := s.Checkout(ctx, &library.CheckoutRequest{Isbn: isbn, Member: "alice"})
+	if err != nil {
+		t.Fatalf("Checkout returned error: %v", err)
+	}
+	_, err = s.Renew(ctx, &library.RenewRequest{Id: loan.GetId()})
+	if err != nil {
+		t.Fatalf("Renew returned error: %v", err)
+	}
+
+	lent, err := books.GetBook(ctx, &library.GetBookRequest{Isbn: isbn})
+	if err != nil {
+		t.Fatalf("GetBook returned error: %v", err)
+	}
+	if lent.GetAvailableCopies() != 1 || lent.GetEtag() != read.GetEtag() {
+		t.Errorf("GetBook returned %d available copies and etag %q after Checkout, want 1 and %q",
+			lent.GetAvailableCopies(), lent.GetEtag(), read.GetEtag())
+	}
+	if got, _ := store.Revision(ctx); got != rev {
+		t.Errorf("store is at revision %d after Checkout, want %d", got, rev)
+	}
+	history, err := store.BookHistory(ctx, isbn)
+	if err != nil {
+		t.Fatalf("BookHistory returned error: %v", err)
+	}
+	if len(history) != 1 {
+		t.Errorf("BookHistory returned %d changes after Checkout, want 1", len(history))
+	}
+
+	// Edits with the etag read before the loan still succeed
+	updated, err := books.UpdateBook(ctx, &library.UpdateBookRequest{
+		Book:       &library.Book{Isbn: isbn, Title: "1984", Etag: read.GetEtag()},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"title"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateBook returned error: %v", err)
+	}
+	if updated.GetAvailableCopies() != 1 {
+		t.Errorf("UpdateBook returned %d available copies, want 1", updated.GetAvailableCopies())
+	}
+	_, err = s.Return(ctx, &library.ReturnRequest{Id: loan.GetId()})
+	if err != nil {
+		t.Fatalf("Return returned error: %v", err)
+	}
+	_, err = books.DeleteBook(ctx, &library.DeleteBookRequest{Isbn: isbn, Etag: updated.GetEtag()})
+	if err != nil {
+		t.Errorf("DeleteBook after Return returned error: %v", err)
+	}
+}
@@ -0,0 +1,353 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// Lending is the lending state of a Book: the Book itself, with
+// its available copies, its Loans and Holds, oldest first, and
+// its Copies, in the order they were added.
+type Lending struct {
+	Book   *library.Book
+	Loans  []*library.Loan
+	Holds  []*library.Hold
+	Copies []*library.Copy
+}
+
+// LoanStore is the storage backend for the Loans, Holds and Copies of
+// the LendingService. These change the availability of the Books they
+// are for, so a LoanStore stores them together with the Books of
+// a BookStore, and updates the Lending of a Book in one transaction.
+// Like UpdateBook, changes to only the Copies and AvailableCopies of a
+// Book keep its Etag and are not recorded as changes to the BookStore.
+// Implementations must be safe for concurrent use.
+// Errors returned should be gRPC status errors, as they
+// are passed on to the client unchanged.
+type LoanStore interface {
+	// GetLoan returns the Loan with the ID provided.
+	// If no such Loan exists, it returns a NotFound error.
+	GetLoan(ctx context.Context, id string) (*library.Loan, error)
+	// QueryLoans returns all Loans for which match returns
+	// true, in the order they were added to the store.
+	QueryLoans(ctx context.Context, match func(*library.Loan) bool) ([]*library.Loan, error)
+	// LoansSince returns the Loans added to the store after the first n,
+	// in the order they were added, and the number of Loans in the store,
+	// to pass as n to the next call. Loans are never removed.
+	LoansSince(ctx context.Context, n int) ([]*library.Loan, int, error)
+	// GetHold returns the Hold with the ID provided.
+	// If no such Hold exists, it returns a NotFound error.
+	GetHold(ctx context.Context, id string) (*library.Hold, error)
+	// QueryHolds returns all Holds for which match returns
+	// true, in the order they were added to the store.
+	QueryHolds(ctx context.Context, match func(*library.Hold) bool) ([]*library.Hold, error)
+	// GetCopy returns the Copy with the barcode provided.
+	// If no such Copy exists, it returns a NotFound error.
+	GetCopy(ctx context.Context, barcode string) (*library.Copy, error)
+	// QueryCopies returns all Copies for which match returns
+	// true, in the order they were added to the store.
+	QueryCopies(ctx context.Context, match func(*library.Copy) bool) ([]*library.Copy, error)
+	// GetLending returns the Lending of the Book with the ISBN provided.
+	// If no such Book exists, it returns a NotFound error.
+	GetLending(ctx context.Context, isbn string) (*Lending, error)
+	// UpdateLending calls update with the Lending of the Book with the
+	// ISBN provided and stores the result, atomically with respect to
+	// other writes. Loans, Holds and Copies may be added and changed, but
+	// not removed, and their IDs, barcodes and ISBNs can't be changed. If
+	// update returns an error, nothing is changed and the error is returned.
+	// If no such Book exists, it returns a NotFound error.
+	UpdateLending(ctx context.Context, isbn string, update func(*Lending) error) (*Lending, error)
+	// LendingChanged returns a channel that is closed
+	// the next time UpdateLending changes a Lending.
+	LendingChanged(ctx context.Context) (<-chan struct{}, error)
+}
+
+// GetLoan implements LoanStore.
+func (s *MemoryBookStore) GetLoan(ctx context.Context, id string) (*library.Loan, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	i, ok := s.loanIndex[id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "Loan could not be found")
+	}
+	return cloneLoan(s.loans[i]), nil
+}
+
+// QueryLoans implements LoanStore.
+func (s *MemoryBookStore) QueryLoans(ctx context.Context, match func(*library.Loan) bool) ([]*library.Loan, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var loans []*library.Loan
+	for _, l := range s.loans {
+		if match(l) {
+			loans = append(loans, cloneLoan(l))
+		}
+	}
+	return loans, nil
+}
+
+// LoansSince implements LoanStore.
+func (s *MemoryBookStore) LoansSince(ctx context.Context, n int) ([]*library.Loan, int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var loans []*library.Loan
+	if n < len(s.loans) {
+		for _, l := range s.loans[n:] {
+			loans = append(loans, cloneLoan(l))
+		}
+	}
+	return loans, len(s.loans), nil
+}
+
+// GetHold implements LoanStore.
+func (s *MemoryBookStore) GetHold(ctx context.Context, id string) (*library.Hold, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	i, ok := s.holdIndex[id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "Hold could not be found")
+	}
+	return cloneHold(s.holds[i]), nil
+}
+
+// QueryHolds implements LoanStore.
+func (s *MemoryBookStore) QueryHolds(ctx context.Context, match func(*library.Hold) bool) ([]*library.Hold, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var holds []*library.Hold
+	for _, h := range s.holds {
+		if match(h) {
+			holds = append(holds, cloneHold(h))
+		}
+	}
+	return holds, nil
+}
+
+// GetCopy implements LoanStore.
+func (s *MemoryBookStore) GetCopy(ctx context.Context, barcode string) (*library.Copy, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	i, ok := s.copyIndex[barcode]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "Copy could not be found")
+	}
+	return cloneCopy(s.copies[i]), nil
+}
+
+// QueryCopies implements LoanStore.
+func (s *MemoryBookStore) QueryCopies(ctx context.Context, match func(*library.Copy) bool) ([]*library.Copy, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var copies []*library.Copy
+	for _, c := range s.copies {
+		if match(c) {
+			copies = append(copies, cloneCopy(c))
+		}
+	}
+	return copies, nil
+}
+
+// GetLending implements LoanStore.
+func (s *MemoryBookStore) GetLending(ctx context.Context, isbn string) (*Lending, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	i, ok := s.index[isbn]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "Book could not be found")
+	}
+	l, _, _, _ := s.lending(i)
+	return l, nil
+}
+
+// UpdateLending implements LoanStore.
+func (s *MemoryBookStore) UpdateLending(ctx context.Context, isbn string, update func(*Lending) error) (*Lending, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	i, ok := s.index[isbn]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "Book could not be found")
+	}
+
+	l, loanIdx, holdIdx, copyIdx := s.lending(i)
+	err := update(l)
+	if err != nil {
+		return nil, err
+	}
+	err = s.checkLending(isbn, l, loanIdx, holdIdx, copyIdx)
+	if err != nil {
+		return nil, err
+	}
+
+	changed := false
+	if !proto.Equal(l.Book, s.books[i]) {
+		s.update(ctx, i, l.Book)
+		changed = true
+	}
+	if s.loanIndex == nil {
+		s.loanIndex = map[string]int{}
+	}
+	for j, loan := range l.Loans {
+		if j < len(loanIdx) {
+			if !proto.Equal(loan, s.loans[loanIdx[j]]) {
+				s.loans[loanIdx[j]] = cloneLoan(loan)
+				changed = true
+			}
+			continue
+		}
+		s.loanIndex[loan.GetId()] = len(s.loans)
+		s.loans = append(s.loans, cloneLoan(loan))
+		changed = true
+	}
+	if s.holdIndex == nil {
+		s.holdIndex = map[string]int{}
+	}
+	for j, h := range l.Holds {
+		if j < len(holdIdx) {
+			if !proto.Equal(h, s.holds[holdIdx[j]]) {
+				s.holds[holdIdx[j]] = cloneHold(h)
+				changed = true
+			}
+			continue
+		}
+		s.holdIndex[h.GetId()] = len(s.holds)
+		s.holds = append(s.holds, cloneHold(h))
+		changed = true
+	}
+	if s.copyIndex == nil {
+		s.copyIndex = map[string]int{}
+	}
+	for j, c := range l.Copies {
+		if j < len(copyIdx) {
+			if !proto.Equal(c, s.copies[copyIdx[j]]) {
+				s.copies[copyIdx[j]] = cloneCopy(c)
+				changed = true
+			}
+			continue
+		}
+		s.copyIndex[c.GetBarcode()] = len(s.copies)
+		s.copies = append(s.copies, cloneCopy(c))
+		changed = true
+	}
+	if changed && s.lendingChanged != nil {
+		close(s.lendingChanged)
+		s.lendingChanged = nil
+	}
+
+	return l, nil
+}
+
+// lending returns a copy of the Lending of the Book at index i,
+// and the indexes of its stored Loans, Holds and Copies.
+// The caller must hold s.mu.
+func (s *MemoryBookStore) lending(i int) (l *Lending, loanIdx, holdIdx, copyIdx []int) {
+	isbn := s.books[i].GetIsbn()
+	l = &Lending{Book: cloneBook(s.books[i])}
+	for j, loan := range s.loans {
+		if loan.GetIsbn() == isbn {
+			loanIdx = append(loanIdx, j)
+			l.Loans = append(l.Loans, cloneLoan(loan))
+		}
+	}
+	for j, h := range s.holds {
+		if h.GetIsbn() == isbn {
+			holdIdx = append(holdIdx, j)
+			l.Holds = append(l.Holds, cloneHold(h))
+		}
+	}
+	for j, c := range s.copies {
+		if c.GetIsbn() == isbn {
+			copyIdx = append(copyIdx, j)
+			l.Copies = append(l.Copies, cloneCopy(c))
+		}
+	}
+	return l, loanIdx, holdIdx, copyIdx
+}
+
+// checkLending returns an error if l, the updated Lending of the Book
+// with the ISBN provided, can't be stored. loanIdx, holdIdx and copyIdx
+// are the indexes of the stored Loans, Holds and Copies of the Book.
+// The caller must hold s.mu.
+func (s *MemoryBookStore) checkLending(isbn string, l *Lending, loanIdx, holdIdx, copyIdx []int) error {
+	if l.Book.GetIsbn() != isbn {
+		return status.Error(codes.InvalidArgument, "The ISBN of a book can't be changed")
+	}
+	if len(l.Loans) < len(loanIdx) || len(l.Holds) < len(holdIdx) || len(l.Copies) < len(copyIdx) {
+		return status.Error(codes.InvalidArgument, "Loans, holds and copies can't be removed")
+	}
+	for j, loan := range l.Loans {
+		if loan.GetIsbn() != isbn {
+			return status.Error(codes.InvalidArgument, "The ISBN of a loan can't be changed")
+		}
+		if j < len(loanIdx) {
+			if loan.GetId() != s.loans[loanIdx[j]].GetId() {
+				return status.Error(codes.InvalidArgument, "The ID of a loan can't be changed")
+			}
+		} else if _, ok := s.loanIndex[loan.GetId()]; ok {
+			return status.Errorf(codes.AlreadyExists, "A loan with ID %s already exists", loan.GetId())
+		}
+	}
+	for j, h := range l.Holds {
+		if h.GetIsbn() != isbn {
+			return status.Error(codes.InvalidArgument, "The ISBN of a hold can't be changed")
+		}
+		if j < len(holdIdx) {
+			if h.GetId() != s.holds[holdIdx[j]].GetId() {
+				return status.Error(codes.InvalidArgument, "The ID of a hold can't be changed")
+			}
+		} else if _, ok := s.holdIndex[h.GetId()]; ok {
+			return status.Errorf(codes.AlreadyExists, "A hold with ID %s already exists", h.GetId())
+		}
+	}
+	added := map[string]bool{}
+	for j, c := range l.Copies {
+		if c.GetIsbn() != isbn {
+			return status.Error(codes.InvalidArgument, "The ISBN of a copy can't be changed")
+		}
+		if j < len(copyIdx) {
+			if c.GetBarcode() != s.copies[copyIdx[j]].GetBarcode() {
+				return status.Error(codes.InvalidArgument, "The barcode of a copy can't be changed")
+			}
+			continue
+		}
+		if _, ok := s.copyIndex[c.GetBarcode()]; ok || added[c.GetBarcode()] {
+			return status.Errorf(codes.AlreadyExists, "A copy with barcode %s already exists", c.GetBarcode())
+		}
+		added[c.GetBarcode()] = true
+	}
+	return nil
+}
+
+// LendingChanged implements LoanStore.
+func (s *MemoryBookStore) LendingChanged(ctx context.Context) (<-chan struct{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.lendingChanged == nil {
+		s.lendingChanged = make(chan struct{})
+	}
+	return s.lendingChanged, nil
+}
+
+// cloneLoan returns a deep copy of l, so that callers
+// can't modify the contents of the store.
+func cloneLoan(l *library.Loan) *library.Loan {
+	return proto.Clone(l).(*library.Loan)
+}
+
+// cloneHold returns a deep copy of h, so that callers
+// can't modify the contents of the store.
+func cloneHold(h *library.Hold) *library.Hold {
+	return proto.Clone(h).(*library.Hold)
+}
+
+// cloneCopy returns a deep copy of c, so that callers
+// can't modify the contents of the store.
+func cloneCopy(c *library.Copy) *library.Copy {
+	return proto.Clone(c).(*library.Copy)
+}
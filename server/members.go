@@ -0,0 +1,298 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"crypto/rand"
+	"net/mail"
+
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+const defaultBorrowingLimit = 5
+
+// MemberService implements library.MemberServiceServer.
+type MemberService struct {
+	members     MemberStore
+	loans       LoanStore
+	collections CollectionStore
+	reviews     ReviewStore
+	tags        TagStore
+}
+
+// NewMemberService returns a MemberService backed by the MemberStore
+// provided. The LoanStore is used to check that deleted Members
+// have no Books on loan or open Holds.
+func NewMemberService(members MemberStore, loans LoanStore, opts ...MemberOption) *MemberService {
+	s := &MemberService{
+		members: members,
+		loans:   loans,
+	}
+	if reviews, ok := loans.(ReviewStore); ok {
+		s.reviews = reviews
+	}
+	if tags, ok := loans.(TagStore); ok {
+		s.tags = tags
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+func (s *MemberService) RegisterMember(ctx context.Context, req *library.RegisterMemberRequest) (*library.Member, error) {
+	if req.GetMember() == nil {
+		return nil, status.Error(codes.InvalidArgument, "A member must be provided")
+	}
+	member := &library.Member{
+		Name:           req.GetMember().GetName(),
+		Email:          req.GetMember().GetEmail(),
+		Phone:          req.GetMember().GetPhone(),
+		BorrowingLimit: req.GetMember().GetBorrowingLimit(),
+		State:          library.Member_ACTIVE,
+		CreateTime:     ptypes.TimestampNow(),
+	}
+	if member.GetBorrowingLimit() == 0 {
+		member.BorrowingLimit = defaultBorrowingLimit
+	}
+	err := validateMember(member)
+	if err != nil {
+		return nil, err
+	}
+
+	member.Id, err = newID("member")
+	if err != nil {
+		return nil, err
+	}
+	member.CardNumber, err = newCardNumber()
+	if err != nil {
+		return nil, err
+	}
+	err = s.members.AddMember(ctx, member)
+	if err != nil {
+		return nil, err
+	}
+
+	return member, nil
+}
+
+func (s *MemberService) GetMember(ctx context.Context, req *library.GetMemberRequest) (*library.Member, error) {
+	switch {
+	case req.GetId() != "":
+		return s.members.GetMember(ctx, req.GetId())
+	case req.GetCardNumber() != "":
+		return s.members.GetMemberByCard(ctx, req.GetCardNumber())
+	default:
+		return nil, status.Error(codes.InvalidArgument, "The ID or card number must be set")
+	}
+}
+
+func (s *MemberService) UpdateMember(ctx context.Context, req *library.UpdateMemberRequest) (*library.Member, error) {
+	mask := req.GetUpdateMask()
+	for _, path := range mask.GetPaths() {
+		switch path {
+		case "name", "email", "phone", "borrowing_limit":
+		case "id", "card_number", "create_time":
+			return nil, status.Errorf(codes.InvalidArgument, "The %s of a member can't be changed", path)
+		case "state", "suspension_reason":
+			return nil, status.Error(codes.InvalidArgument, "Members are suspended and reinstated with SuspendMember and ReinstateMember")
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "Unknown field %q in update mask", path)
+		}
+	}
+
+	src := req.GetMember()
+	return s.members.UpdateMember(ctx, src.GetId(), func(m *library.Member) error {
+		if updatesField(mask, "name") {
+			m.Name = src.GetName()
+		}
+		if updatesField(mask, "email") {
+			m.Email = src.GetEmail()
+		}
+		if updatesField(mask, "phone") {
+			m.Phone = src.GetPhone()
+		}
+		if updatesField(mask, "borrowing_limit") {
+			m.BorrowingLimit = src.GetBorrowingLimit()
+			if m.GetBorrowingLimit() == 0 {
+				m.BorrowingLimit = defaultBorrowingLimit
+			}
+		}
+		return validateMember(m)
+	})
+}
+
+func (s *MemberService) SuspendMember(ctx context.Context, req *library.SuspendMemberRequest) (*library.Member, error) {
+	return s.members.UpdateMember(ctx, req.GetId(), func(m *library.Member) error {
+		m.State = library.Member_SUSPENDED
+		m.SuspensionReason = req.GetReason()
+		return nil
+	})
+}
+
+func (s *MemberService) ReinstateMember(ctx context.Context, req *library.ReinstateMemberRequest) (*library.Member, error) {
+	return s.members.UpdateMember(ctx, req.GetId(), func(m *library.Member) error {
+		m.State = library.Member_ACTIVE
+		m.SuspensionReason = ""
+		return nil
+	})
+}
+
+func (s *MemberService) DeleteMember(ctx context.Context, req *library.DeleteMemberRequest) (*library.Member, error) {
+	// The checks are made while no Member is in use,
+	// so nothing can be stored for the Member in between.
+	return s.members.DeleteMember(ctx, req.GetId(), func(*library.Member) error {
+		return s.checkUnused(ctx, req.GetId())
+	})
+}
+
+// checkUnused returns a FailedPrecondition error if anything
+// in the library refers to the Member with the ID provided.
+func (s *MemberService) checkUnused(ctx context.Context, id string) error {
+	loans, err := s.loans.QueryLoans(ctx, func(l *library.Loan) bool {
+		return l.GetMember() == id && l.GetReturnTime() == nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(loans) > 0 {
+		return status.Error(codes.FailedPrecondition, "The member has books on loan")
+	}
+	holds, err := s.loans.QueryHolds(ctx, func(h *library.Hold) bool {
+		return h.GetMember() == id && isOpenHold(h)
+	})
+	if err != nil {
+		return err
+	}
+	if len(holds) > 0 {
+		return status.Error(codes.FailedPrecondition, "The member has open holds")
+	}
+	if s.collections != nil {
+		collections, err := s.collections.QueryCollections(ctx, func(c *library.Collection) bool {
+			return c.GetOwner() == id
+		})
+		if err != nil {
+			return err
+		}
+		if len(collections) > 0 {
+			return status.Error(codes.FailedPrecondition, "The member owns collections")
+		}
+	}
+	if s.reviews != nil {
+		reviews, err := s.reviews.QueryReviews(ctx, func(r *library.Review) bool {
+			return r.GetMember() == id
+		})
+		if err != nil {
+			return err
+		}
+		if len(reviews) > 0 {
+			return status.Error(codes.FailedPrecondition, "The member has written reviews")
+		}
+	}
+	if s.tags != nil {
+		taggings, err := s.tags.QueryTaggings(ctx, func(t Tagging) bool {
+			return t.Member == id
+		})
+		if err != nil {
+			return err
+		}
+		if len(taggings) > 0 {
+			return status.Error(codes.FailedPrecondition, "The member has tagged books")
+		}
+	}
+
+	return nil
+}
+
+// activeMember returns the Member with the ID provided. It returns
+// a FailedPrecondition error if the Member is suspended.
+func activeMember(ctx context.Context, members MemberStore, id string) (*library.Member, error) {
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "The member must not be empty")
+	}
+	m, err := members.GetMember(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	err = checkActive(m)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// useActiveMember calls use with the Member with the ID provided,
+// like activeMember returns it, and keeps the Member from being deleted
+// until use returns. Anything referring to the Member must be stored by
+// use, so that the Member is not deleted while it is stored.
+func useActiveMember(ctx context.Context, members MemberStore, id string, use func(*library.Member) error) error {
+	if id == "" {
+		return status.Error(codes.InvalidArgument, "The member must not be empty")
+	}
+	return members.UseMember(ctx, id, func(m *library.Member) error {
+		err := checkActive(m)
+		if err != nil {
+			return err
+		}
+		return use(m)
+	})
+}
+
+// checkActive returns a FailedPrecondition error if m is suspended.
+func checkActive(m *library.Member) error {
+	if m.GetState() == library.Member_SUSPENDED {
+		return status.Error(codes.FailedPrecondition, "The member is suspended")
+	}
+	return nil
+}
+
+// validateMember returns an InvalidArgument error
+// if the profile of m is incomplete or invalid.
+func validateMember(m *library.Member) error {
+	switch {
+	case m.GetName() == "":
+		return status.Error(codes.InvalidArgument, "The name must not be empty")
+	case m.GetBorrowingLimit() < 0:
+		return status.Error(codes.InvalidArgument, "The borrowing limit must not be negative")
+	}
+	if m.GetEmail() != "" {
+		_, err := mail.ParseAddress(m.GetEmail())
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "Invalid email address %q", m.GetEmail())
+		}
+	}
+	return nil
+}
+
+// newCardNumber returns a random 14 digit library card
+// number, the last digit of which is a Luhn check digit.
+func newCardNumber() (string, error) {
+	b := make([]byte, 13)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to generate card number: %v", err)
+	}
+	digits := make([]byte, 14)
+	sum := 0
+	for i := range b {
+		d := int(b[i] % 10)
+		digits[i] = byte('0' + d)
+		// Double every other digit, starting
+		// with the one next to the check digit
+		if i%2 == 0 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	digits[13] = byte('0' + (10-sum%10)%10)
+	return string(digits), nil
+}
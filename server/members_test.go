@@ -0,0 +1,226 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// luhnValid reports whether the last digit of number
+// is the Luhn check digit of the digits before it.
+func luhnValid(number string) bool {
+	sum := 0
+	for i := len(number) - 1; i >= 0; i-- {
+		d := int(number[i] - '0')
+		if d < 0 || d > 9 {
+			return false
+		}
+		if (len(number)-i)%2 == 0 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+func TestLuhnValid(t *testing.T) {
+	tests := []struct {
+		number string
+		want   bool
+	}{
+		{number: "79927398713", want: true},
+		{number: "79927398710", want: false},
+		{number: "4539578763621486", want: true},
+		{number: "4539578763621487", want: false},
+		{number: "0000000000000", want: true},
+		{number: "1234a", want: false},
+	}
+	for _, tt := range tests {
+		if got := luhnValid(tt.number); got != tt.want {
+			t.Errorf("luhnValid(%q) = %t, want %t", tt.number, got, tt.want)
+		}
+	}
+}
+
+func TestNewCardNumber(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		number, err := newCardNumber()
+		if err != nil {
+			t.Fatalf("newCardNumber returned error: %v", err)
+		}
+		if len(number) != 14 {
+			t.Errorf("newCardNumber = %q, want 14 digits", number)
+		}
+		if !luhnValid(number) {
+			t.Errorf("newCardNumber = %q, which fails the Luhn check", number)
+		}
+	}
+}
+
+func TestRegisterMember(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemberService(&MemoryMemberStore{}, NewMemoryBookStore())
+
+	m, err := s.RegisterMember(ctx, &library.RegisterMemberRequest{
+		Member: &library.Member{Id: "chosen", Name: "Alice", Email: "alice@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("RegisterMember returned error: %v", err)
+	}
+	if m.GetId() == "" || m.GetId() == "chosen" {
+		t.Errorf("RegisterMember returned ID %q, want a new ID", m.GetId())
+	}
+	if m.GetState() != library.Member_ACTIVE || m.GetBorrowingLimit() != defaultBorrowingLimit || !luhnValid(m.GetCardNumber()) {
+		t.Errorf("RegisterMember returned %v, want an active member with a valid card number and the default borrowing limit", m)
+	}
+	byCard, err := s.GetMember(ctx, &library.GetMemberRequest{CardNumber: m.GetCardNumber()})
+	if err != nil {
+		t.Fatalf("GetMember returned error: %v", err)
+	}
+	if byCard.GetId() != m.GetId() {
+		t.Errorf("GetMember by card number returned %q, want %q", byCard.GetId(), m.GetId())
+	}
+
+	for _, invalid := range []*library.Member{
+		{},
+		{Name: "Bob", Email: "not an address"},
+		{Name: "Bob", BorrowingLimit: -1},
+	} {
+		_, err := s.RegisterMember(ctx, &library.RegisterMemberRequest{Member: invalid})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("RegisterMember(%v) returned error %v, want InvalidArgument", invalid, err)
+		}
+	}
+}
+
+func TestUpdateMember(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemberService(&MemoryMemberStore{}, NewMemoryBookStore())
+	m, err := s.RegisterMember(ctx, &library.RegisterMemberRequest{Member: &library.Member{Name: "Alice"}})
+	if err != nil {
+		t.Fatalf("RegisterMember returned error: %v", err)
+	}
+
+	updated, err := s.UpdateMember(ctx, &library.UpdateMemberRequest{
+		Member:     &library.Member{Id: m.GetId(), Name: "Alice Liddell", Phone: "555-0100"},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"name"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateMember returned error: %v", err)
+	}
+	if updated.GetName() != "Alice Liddell" || updated.GetPhone() != "" {
+		t.Errorf("UpdateMember returned name %q and phone %q, want only the name updated", updated.GetName(), updated.GetPhone())
+	}
+	_, err = s.UpdateMember(ctx, &library.UpdateMemberRequest{
+		Member:     &library.Member{Id: m.GetId(), State: library.Member_SUSPENDED},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"state"}},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("UpdateMember of the state returned error %v, want InvalidArgument", err)
+	}
+
+	suspended, err := s.SuspendMember(ctx, &library.SuspendMemberRequest{Id: m.GetId(), Reason: "Overdue books"})
+	if err != nil {
+		t.Fatalf("SuspendMember returned error: %v", err)
+	}
+	if suspended.GetState() != library.Member_SUSPENDED || suspended.GetSuspensionReason() != "Overdue books" {
+		t.Errorf("SuspendMember returned %v, want a suspended member", suspended)
+	}
+	reinstated, err := s.ReinstateMember(ctx, &library.ReinstateMemberRequest{Id: m.GetId()})
+	if err != nil {
+		t.Fatalf("ReinstateMember returned error: %v", err)
+	}
+	if reinstated.GetState() != library.Member_ACTIVE || reinstated.GetSuspensionReason() != "" {
+		t.Errorf("ReinstateMember returned %v, want an active member", reinstated)
+	}
+}
+
+func TestDeleteMember(t *testing.T) {
+	ctx := context.Background()
+	lending, store, members := newTestLendingService()
+	collections := &MemoryCollectionStore{}
+	s := NewMemberService(members, store, WithMemberCollectionStore(collections))
+	addMember(t, members, "alice", 5)
+
+	loan, err := lending.Checkout(ctx, &library.CheckoutRequest{Isbn: "9780140009729", Member: "alice"})
+	if err != nil {
+		t.Fatalf("Checkout returned error: %v", err)
+	}
+	_, err = s.DeleteMember(ctx, &library.DeleteMemberRequest{Id: "alice"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("DeleteMember with a book on loan returned error %v, want FailedPrecondition", err)
+	}
+	_, err = lending.Return(ctx, &library.ReturnRequest{Id: loan.GetId()})
+	if err != nil {
+		t.Fatalf("Return returned error: %v", err)
+	}
+
+	err = collections.AddCollection(ctx, &library.Collection{Id: "collection", Owner: "alice"})
+	if err != nil {
+		t.Fatalf("AddCollection returned error: %v", err)
+	}
+	_, err = s.DeleteMember(ctx, &library.DeleteMemberRequest{Id: "alice"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("DeleteMember with a collection returned error %v, want FailedPrecondition", err)
+	}
+	_, err = collections.DeleteCollection(ctx, "collection")
+	if err != nil {
+		t.Fatalf("DeleteCollection returned error: %v", err)
+	}
+
+	_, err = s.DeleteMember(ctx, &library.DeleteMemberRequest{Id: "alice"})
+	if err != nil {
+		t.Fatalf("DeleteMember returned error: %v", err)
+	}
+	_, err = s.GetMember(ctx, &library.GetMemberRequest{Id: "alice"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("GetMember of a deleted member returned error %v, want NotFound", err)
+	}
+}
+
+func TestDeleteMemberConcurrentCheckout(t *testing.T) {
+	ctx := context.Background()
+	lending, store, members := newTestLendingService()
+	s := NewMemberService(members, store)
+
+	for i := 0; i < 50; i++ {
+		id := fmt.Sprintf("member-%d", i)
+		addMember(t, members, id, 5)
+		done := make(chan error)
+		go func() {
+			_, err := lending.Checkout(ctx, &library.CheckoutRequest{Isbn: "9780140009729", Member: id})
+			done <- err
+		}()
+		_, deleteErr := s.DeleteMember(ctx, &library.DeleteMemberRequest{Id: id})
+		checkoutErr := <-done
+
+		// Either the checkout or the deletion must fail
+		if deleteErr == nil && checkoutErr == nil {
+			t.Fatalf("both DeleteMember and Checkout by %s succeeded", id)
+		}
+		if checkoutErr == nil {
+			loans, err := store.QueryLoans(ctx, func(l *library.Loan) bool {
+				return l.GetMember() == id
+			})
+			if err != nil {
+				t.Fatalf("QueryLoans returned error: %v", err)
+			}
+			_, err = lending.Return(ctx, &library.ReturnRequest{Id: loans[0].GetId()})
+			if err != nil {
+				t.Fatalf("Return returned error: %v", err)
+			}
+		}
+	}
+}
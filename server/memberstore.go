@@ -0,0 +1,163 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// MemberStore is the storage backend for the Members of the library.
+// Implementations must be safe for concurrent use.
+// Errors returned should be gRPC status errors, as they
+// are passed on to the client unchanged.
+type MemberStore interface {
+	// GetMember returns the Member with the ID provided.
+	// If no such Member exists, it returns a NotFound error.
+	GetMember(ctx context.Context, id string) (*library.Member, error)
+	// GetMemberByCard returns the Member with the card number provided.
+	// If no such Member exists, it returns a NotFound error.
+	GetMemberByCard(ctx context.Context, cardNumber string) (*library.Member, error)
+	// AddMember stores the Member provided. If a Member with the same ID
+	// or card number already exists, it returns an AlreadyExists error.
+	AddMember(ctx context.Context, member *library.Member) error
+	// UpdateMember calls update with the Member with the ID provided
+	// and stores the result, atomically with respect to other writes.
+	// If update returns an error, the Member is left unchanged and the
+	// error is returned. If no such Member exists, it returns a NotFound error.
+	UpdateMember(ctx context.Context, id string, update func(*library.Member) error) (*library.Member, error)
+	// UseMember calls use with the Member with the ID provided, and keeps
+	// the Member from being deleted until use returns, so that DeleteMember
+	// sees whatever use stores that refers to the Member. It returns the
+	// error returned by use. use must not call UseMember or DeleteMember.
+	// If no such Member exists, it returns a NotFound error.
+	UseMember(ctx context.Context, id string, use func(*library.Member) error) error
+	// DeleteMember calls check, if it is not nil, with the Member with the
+	// ID provided, and unless check returns an error, removes the Member
+	// and returns it. No calls to UseMember run from the start of check
+	// until the Member is removed. If no such Member exists, it returns
+	// a NotFound error.
+	DeleteMember(ctx context.Context, id string, check func(*library.Member) error) (*library.Member, error)
+}
+
+// MemoryMemberStore is an in-memory MemberStore.
+// The zero value is an empty store ready to use.
+type MemoryMemberStore struct {
+	mu      sync.RWMutex
+	members map[string]*library.Member
+	cards   map[string]string
+	// useMu is held for reading by UseMember and for
+	// writing by DeleteMember, so that no Member
+	// in use is deleted.
+	useMu sync.RWMutex
+}
+
+// GetMember implements MemberStore.
+func (s *MemoryMemberStore) GetMember(ctx context.Context, id string) (*library.Member, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	m, ok := s.members[id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "Member could not be found")
+	}
+	return cloneMember(m), nil
+}
+
+// GetMemberByCard implements MemberStore.
+func (s *MemoryMemberStore) GetMemberByCard(ctx context.Context, cardNumber string) (*library.Member, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	id, ok := s.cards[cardNumber]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "Member could not be found")
+	}
+	return cloneMember(s.members[id]), nil
+}
+
+// AddMember implements MemberStore.
+func (s *MemoryMemberStore) AddMember(ctx context.Context, member *library.Member) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.members == nil {
+		s.members = map[string]*library.Member{}
+		s.cards = map[string]string{}
+	}
+	if _, ok := s.members[member.GetId()]; ok {
+		return status.Errorf(codes.AlreadyExists, "A member with ID %s already exists", member.GetId())
+	}
+	if _, ok := s.cards[member.GetCardNumber()]; ok {
+		return status.Errorf(codes.AlreadyExists, "A member with card number %s already exists", member.GetCardNumber())
+	}
+	s.members[member.GetId()] = cloneMember(member)
+	s.cards[member.GetCardNumber()] = member.GetId()
+	return nil
+}
+
+// UpdateMember implements MemberStore.
+func (s *MemoryMemberStore) UpdateMember(ctx context.Context, id string, update func(*library.Member) error) (*library.Member, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, ok := s.members[id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "Member could not be found")
+	}
+	m := cloneMember(stored)
+	err := update(m)
+	if err != nil {
+		return nil, err
+	}
+	if m.GetId() != id || m.GetCardNumber() != stored.GetCardNumber() {
+		return nil, status.Error(codes.InvalidArgument, "The ID and card number of a member can't be changed")
+	}
+	s.members[id] = cloneMember(m)
+	return m, nil
+}
+
+// UseMember implements MemberStore.
+func (s *MemoryMemberStore) UseMember(ctx context.Context, id string, use func(*library.Member) error) error {
+	s.useMu.RLock()
+	defer s.useMu.RUnlock()
+	m, err := s.GetMember(ctx, id)
+	if err != nil {
+		return err
+	}
+	return use(m)
+}
+
+// DeleteMember implements MemberStore.
+func (s *MemoryMemberStore) DeleteMember(ctx context.Context, id string, check func(*library.Member) error) (*library.Member, error) {
+	s.useMu.Lock()
+	defer s.useMu.Unlock()
+	if check != nil {
+		m, err := s.GetMember(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		err = check(m)
+		if err != nil {
+			return nil, err
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m, ok := s.members[id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "Member could not be found")
+	}
+	delete(s.members, id)
+	delete(s.cards, m.GetCardNumber())
+	return m, nil
+}
+
+// cloneMember returns a deep copy of m, so that callers
+// can't modify the contents of the store.
+func cloneMember(m *library.Member) *library.Member {
+	return proto.Clone(m).(*library.Member)
+}
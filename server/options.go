@@ -0,0 +1,204 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"time"
+
+	"golang.org/x/text/language"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/blob"
+)
+
+// Option configures a BookService.
+type Option func(*BookService)
+
+// WithPageTokenKey sets the key used to sign page and resume tokens.
+// Servers sharing a key accept each other's tokens.
+// By default, a random key is generated for each BookService.
+func WithPageTokenKey(key []byte) Option {
+	return func(s *BookService) {
+		s.tokenKey = key
+	}
+}
+
+// WithLocale sets the locale whose collation rules are used
+// when ordering books by title or author. By default,
+// strings are ordered according to the rules for English.
+func WithLocale(locale language.Tag) Option {
+	return func(s *BookService) {
+		s.locale = locale
+	}
+}
+
+// WithBookmarkInterval sets how often WatchBooks sends a BOOKMARK
+// event with the current revision, if changes were made since the
+// last event sent. By default, bookmarks are sent every minute.
+func WithBookmarkInterval(d time.Duration) Option {
+	return func(s *BookService) {
+		s.bookmarkInterval = d
+	}
+}
+
+// WithMemberStore sets the store of the Members who own Collections,
+// take part in BookChat and make the changes in the revision history.
+// By default, an empty MemoryMemberStore is used, so it must be set
+// to the store shared with the MemberService.
+func WithMemberStore(store MemberStore) Option {
+	return func(s *BookService) {
+		s.members = store
+	}
+}
+
+// WithAuthorStore sets the store of the Authors of the Books.
+// By default, an empty MemoryAuthorStore is used, so Books stored
+// before Books referenced Authors must be migrated with MigrateAuthors.
+func WithAuthorStore(store AuthorStore) Option {
+	return func(s *BookService) {
+		s.authors = store
+	}
+}
+
+// WithWorkStore sets the store of the Works that Books are
+// editions of, and of the Series of Works. By default,
+// Works and Series are kept in a MemoryWorkStore.
+func WithWorkStore(store WorkStore) Option {
+	return func(s *BookService) {
+		s.works = store
+	}
+}
+
+// WithSubjectStore sets the store of the subject taxonomy
+// that Books are categorized by. By default, Subjects
+// are kept in a MemorySubjectStore.
+func WithSubjectStore(store SubjectStore) Option {
+	return func(s *BookService) {
+		s.subjects = store
+	}
+}
+
+// WithTagStore sets the store of the tags members apply to Books.
+// Tags are stored with the Books they are applied to, so by default
+// the BookStore of the BookService is used if it is also a TagStore,
+// as a MemoryBookStore is. Otherwise, no tags are stored.
+func WithTagStore(store TagStore) Option {
+	return func(s *BookService) {
+		s.tags = store
+	}
+}
+
+// WithCoverStore sets the store of the cover images uploaded with
+// UploadCover, and their thumbnails. By default, covers are kept
+// in the "covers" directory of the working directory.
+func WithCoverStore(store blob.Store) Option {
+	return func(s *BookService) {
+		s.covers = store
+	}
+}
+
+// WithCollectionStore sets the store used to persist the
+// Collections made with MakeCollection. By default,
+// Collections are kept in a MemoryCollectionStore.
+func WithCollectionStore(store CollectionStore) Option {
+	return func(s *BookService) {
+		s.collections = store
+	}
+}
+
+// WithLoanStore sets the store of the Loans that RecommendBooks learns
+// from, together with the Collections, and of the Copies that UpdateBook
+// won't reduce the copies of a Book below. By default, no Loans or Copies
+// are used, so it must be set to the store shared with the LendingService.
+func WithLoanStore(store LoanStore) Option {
+	return func(s *BookService) {
+		s.loans = store
+	}
+}
+
+// LendingOption configures a LendingService.
+type LendingOption func(*LendingService)
+
+// WithLoanPeriod sets the time a Book is lent for, and by which
+// a renewal extends a Loan. By default, Books are lent for 21 days.
+func WithLoanPeriod(d time.Duration) LendingOption {
+	return func(s *LendingService) {
+		s.loanPeriod = d
+	}
+}
+
+// WithMaxRenewals sets the number of times a Loan may
+// be renewed. By default, Loans may be renewed twice.
+func WithMaxRenewals(n int) LendingOption {
+	return func(s *LendingService) {
+		s.maxRenewals = n
+	}
+}
+
+// WithHoldPeriod sets the time a copy is set aside for a ready
+// Hold before it is offered to the next member in the queue.
+// By default, copies are set aside for 7 days.
+func WithHoldPeriod(d time.Duration) LendingOption {
+	return func(s *LendingService) {
+		s.holdPeriod = d
+	}
+}
+
+// WithBranchStore sets the store of the Branches that
+// Copies are kept at. By default, Branches are kept
+// in a MemoryBranchStore.
+func WithBranchStore(store BranchStore) LendingOption {
+	return func(s *LendingService) {
+		s.branches = store
+	}
+}
+
+// WithLoanPageTokenKey sets the key used to sign the page tokens of
+// ListLoans and ListHolds. By default, a random key is generated for each LendingService.
+func WithLoanPageTokenKey(key []byte) LendingOption {
+	return func(s *LendingService) {
+		s.tokenKey = key
+	}
+}
+
+// MemberOption configures a MemberService.
+type MemberOption func(*MemberService)
+
+// WithMemberCollectionStore sets the store of the Collections that
+// Members own, which are checked when a Member is deleted. By default,
+// Collections are not checked, so it must be set to the store shared
+// with the BookService.
+func WithMemberCollectionStore(store CollectionStore) MemberOption {
+	return func(s *MemberService) {
+		s.collections = store
+	}
+}
+
+// WithMemberReviewStore sets the store of the Reviews that Members
+// write, which are checked when a Member is deleted. By default, the
+// LoanStore of the MemberService is used if it is also a ReviewStore.
+func WithMemberReviewStore(store ReviewStore) MemberOption {
+	return func(s *MemberService) {
+		s.reviews = store
+	}
+}
+
+// WithMemberTagStore sets the store of the tags that Members apply
+// to Books, which are checked when a Member is deleted. By default,
+// the LoanStore of the MemberService is used if it is also a TagStore.
+func WithMemberTagStore(store TagStore) MemberOption {
+	return func(s *MemberService) {
+		s.tags = store
+	}
+}
+
+// ReviewOption configures a ReviewService.
+type ReviewOption func(*ReviewService)
+
+// WithReviewPageTokenKey sets the key used to sign the page tokens of
+// ListReviews. By default, a random key is generated for each ReviewService.
+func WithReviewPageTokenKey(key []byte) ReviewOption {
+	return func(s *ReviewService) {
+		s.tokenKey = key
+	}
+}
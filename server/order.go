@@ -0,0 +1,165 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"sort"
+	"strings"
+
+	"golang.org/x/net/context"
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/catalog"
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// sortKey is the position of a book in an ordering.
+// Books are ordered by either S or N, depending on the field
+// ordered by, with ties broken by the ISBN.
+type sortKey struct {
+	S    string `json:"s,omitempty"`
+	N    int64  `json:"n,omitempty"`
+	Isbn string `json:"i"`
+}
+
+// bookOrder is a parsed order_by clause.
+type bookOrder struct {
+	field string
+	desc  bool
+	// collator compares strings according to the
+	// rules of a locale. It is not safe for concurrent use,
+	// so each bookOrder has its own.
+	collator *collate.Collator
+	// sortNames holds the sort names of the Authors
+	// by ID, when ordering by author.
+	sortNames map[string]string
+}
+
+// parseBookOrder parses an order_by clause of the form "field [asc|desc]".
+// Valid fields are isbn, title, author and publication_date.
+// An empty clause orders by ISBN. Strings are ordered
+// according to the collation rules of locale. Orders by
+// author must be given the sort names of the Authors
+// with loadSortNames before they are used.
+func parseBookOrder(orderBy string, locale language.Tag) (bookOrder, error) {
+	parts := strings.Fields(orderBy)
+	if len(parts) == 0 {
+		return bookOrder{field: "isbn"}, nil
+	}
+	if len(parts) > 2 {
+		return bookOrder{}, status.Errorf(codes.InvalidArgument, "Invalid order_by %q", orderBy)
+	}
+	o := bookOrder{field: parts[0], collator: collate.New(locale)}
+	switch o.field {
+	case "isbn", "title", "author", "publication_date":
+	default:
+		return bookOrder{}, status.Errorf(codes.InvalidArgument, "Can't order by unknown field %q", o.field)
+	}
+	if len(parts) == 2 {
+		switch strings.ToLower(parts[1]) {
+		case "asc":
+		case "desc":
+			o.desc = true
+		default:
+			return bookOrder{}, status.Errorf(codes.InvalidArgument, "Invalid sort direction %q", parts[1])
+		}
+	}
+	return o, nil
+}
+
+// loadSortNames loads the sort names of the Authors from
+// store, if Books are ordered by the sort names of their authors.
+func (o *bookOrder) loadSortNames(ctx context.Context, store AuthorStore) error {
+	if o.field != "author" {
+		return nil
+	}
+	authors, err := store.QueryAuthors(ctx, func(*library.Author) bool { return true })
+	if err != nil {
+		return err
+	}
+	o.sortNames = make(map[string]string, len(authors))
+	for _, a := range authors {
+		o.sortNames[a.GetId()] = a.GetSortName()
+	}
+	return nil
+}
+
+// key returns the sort key of bk in this ordering.
+func (o bookOrder) key(bk *library.Book) sortKey {
+	k := sortKey{Isbn: bk.GetIsbn()}
+	switch o.field {
+	case "title":
+		k.S = bk.GetTitle()
+	case "author":
+		k.S = o.authorKey(bk)
+	case "publication_date":
+		k.N = bk.GetPublicationDate().GetSeconds()
+	}
+	return k
+}
+
+// authorKey returns the sort names of the linked authors of bk, in the
+// order they are credited, or its author if it has no linked authors.
+func (o bookOrder) authorKey(bk *library.Book) string {
+	var names []string
+	for _, id := range bk.GetAuthorIds() {
+		if name, ok := o.sortNames[id]; ok {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return bk.GetAuthor()
+	}
+	return strings.Join(names, catalog.AuthorSeparator)
+}
+
+// less reports whether a sorts before b in this ordering.
+func (o bookOrder) less(a, b sortKey) bool {
+	c := o.compareStrings(a.S, b.S)
+	if c == 0 {
+		c = compareInt64(a.N, b.N)
+	}
+	if c == 0 {
+		c = strings.Compare(a.Isbn, b.Isbn)
+	}
+	if o.desc {
+		return c > 0
+	}
+	return c < 0
+}
+
+// sort sorts bks in this ordering.
+func (o bookOrder) sort(bks []*library.Book) {
+	sort.Slice(bks, func(i, j int) bool {
+		return o.less(o.key(bks[i]), o.key(bks[j]))
+	})
+}
+
+// compareStrings compares a and b using the collator,
+// falling back to a byte-wise comparison of strings
+// the collator considers equal.
+func (o bookOrder) compareStrings(a, b string) int {
+	if a == b {
+		return 0
+	}
+	if o.collator != nil {
+		if c := o.collator.CompareString(a, b); c != 0 {
+			return c
+		}
+	}
+	return strings.Compare(a, b)
+}
+
+func compareInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
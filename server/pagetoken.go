@@ -0,0 +1,111 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// pageToken is the content of the page tokens handed out by ListBooks.
+// It records the position of the last book on the page rather than
+// an offset, so that pages stay stable as books are added and removed.
+type pageToken struct {
+	// Filter and OrderBy are the parameters of the request
+	// that created the token. They may not change between pages.
+	Filter  string `json:"f,omitempty"`
+	OrderBy string `json:"o,omitempty"`
+	// Last is the sort key of the last book on the previous page.
+	Last sortKey `json:"l"`
+}
+
+func (pageToken) kind() string { return "books" }
+
+// encodePageToken signs and encodes t with key.
+func encodePageToken(key []byte, t pageToken) (string, error) {
+	return encodeToken(key, t)
+}
+
+// decodePageToken verifies the signature of token with key and decodes it.
+// Tokens that have been modified are rejected with an InvalidArgument error.
+func decodePageToken(key []byte, token string) (pageToken, error) {
+	var t pageToken
+	if !decodeToken(key, token, &t) {
+		return t, status.Error(codes.InvalidArgument, "Invalid page token")
+	}
+	return t, nil
+}
+
+// token is the content of a signed token.
+type token interface {
+	// kind names the kind of token. It is signed into the token,
+	// so that a token handed out for one kind of request is not
+	// accepted by another, even when both are signed with a key.
+	kind() string
+}
+
+// signedToken is the signed payload of a token.
+type signedToken struct {
+	Kind  string          `json:"k"`
+	Value json.RawMessage `json:"v"`
+}
+
+// encodeToken encodes t as JSON and signs it with key.
+func encodeToken(key []byte, t token) (string, error) {
+	value, err := json.Marshal(t)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to encode token: %v", err)
+	}
+	payload, err := json.Marshal(signedToken{Kind: t.kind(), Value: value})
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to encode token: %v", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(payload) + "." +
+		base64.RawURLEncoding.EncodeToString(signToken(key, payload)), nil
+}
+
+// decodeToken verifies the signature of s with key and decodes it
+// into t. It reports whether s was a valid token of the kind of t.
+func decodeToken(key []byte, s string, t token) bool {
+	parts := strings.Split(s, ".")
+	if len(parts) != 2 {
+		return false
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return false
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil || !hmac.Equal(sig, signToken(key, payload)) {
+		return false
+	}
+	var signed signedToken
+	if json.Unmarshal(payload, &signed) != nil || signed.Kind != t.kind() {
+		return false
+	}
+	return json.Unmarshal(signed.Value, t) == nil
+}
+
+// newTokenKey returns a random key for signing tokens.
+func newTokenKey() []byte {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	if err != nil {
+		panic("failed to generate page token key: " + err.Error())
+	}
+	return key
+}
+
+func signToken(key, payload []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write(payload)
+	return mac.Sum(nil)
+}
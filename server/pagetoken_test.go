@@ -0,0 +1,74 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPageToken(t *testing.T) {
+	key := newTokenKey()
+	want := pageToken{
+		Filter:  "title:hobbit",
+		OrderBy: "title",
+		Last:    sortKey{S: "The Hobbit", Isbn: "9780306406157"},
+	}
+	token, err := encodePageToken(key, want)
+	if err != nil {
+		t.Fatalf("encodePageToken returned error: %v", err)
+	}
+	got, err := decodePageToken(key, token)
+	if err != nil {
+		t.Fatalf("decodePageToken returned error: %v", err)
+	}
+	if got != want {
+		t.Errorf("decodePageToken = %+v, want %+v", got, want)
+	}
+}
+
+func TestPageTokenInvalid(t *testing.T) {
+	key := newTokenKey()
+	token, err := encodePageToken(key, pageToken{Last: sortKey{Isbn: "9780306406157"}})
+	if err != nil {
+		t.Fatalf("encodePageToken returned error: %v", err)
+	}
+	parts := strings.Split(token, ".")
+	payload, _ := base64.RawURLEncoding.DecodeString(parts[0])
+	tampered := strings.Replace(string(payload), "9780306406157", "9780804429573", 1)
+	otherKind, err := encodeToken(key, collectionPageToken{})
+	if err != nil {
+		t.Fatalf("encodeToken returned error: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		key   []byte
+		token string
+	}{
+		{name: "empty", key: key, token: ""},
+		{name: "garbage", key: key, token: "not a token"},
+		{name: "no signature", key: key, token: parts[0]},
+		{name: "extra part", key: key, token: token + ".x"},
+		{name: "bad encoding", key: key, token: "!" + token},
+		{
+			name:  "tampered payload",
+			key:   key,
+			token: base64.RawURLEncoding.EncodeToString([]byte(tampered)) + "." + parts[1],
+		},
+		{name: "tampered signature", key: key, token: parts[0] + "." + base64.RawURLEncoding.EncodeToString([]byte("signature"))},
+		{name: "wrong key", key: newTokenKey(), token: token},
+		{name: "wrong kind", key: key, token: otherKind},
+	}
+	for _, tt := range tests {
+		_, err := decodePageToken(tt.key, tt.token)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: decodePageToken returned error %v, want InvalidArgument", tt.name, err)
+		}
+	}
+}
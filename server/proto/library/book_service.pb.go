@@ -12,19 +12,116 @@ It is generated from these files:
 
 It has these top-level messages:
 	Publisher
+	Author
+	Work
+	Series
+	Subject
+	TagCount
+	Localization
 	Book
 	GetBookRequest
 	QueryBooksRequest
+	ListBooksRequest
+	ListBooksResponse
+	SearchBooksRequest
+	SearchBooksResponse
+	SearchResult
+	Highlight
+	TextRange
+	RecommendBooksRequest
+	RecommendBooksResponse
+	Recommendation
+	CreateBookRequest
+	UpdateBookRequest
+	CreateAuthorRequest
+	GetAuthorRequest
+	UpdateAuthorRequest
+	UploadCoverRequest
+	CreateWorkRequest
+	GetWorkRequest
+	UpdateWorkRequest
+	ListEditionsRequest
+	ListEditionsResponse
+	CreateSeriesRequest
+	GetSeriesRequest
+	ListSeriesWorksRequest
+	ListSeriesWorksResponse
+	CreateSubjectRequest
+	GetSubjectRequest
+	UpdateSubjectRequest
+	DeleteSubjectRequest
+	BrowseSubjectsRequest
+	BrowseSubjectsResponse
+	SubjectCount
+	TagBookRequest
+	UntagBookRequest
+	ListTagsRequest
+	ListTagsResponse
+	ListAuthorBooksRequest
+	ListAuthorBooksResponse
+	DeleteBookRequest
+	RestoreBookRequest
+	BookRevision
+	ListBookRevisionsRequest
+	ListBookRevisionsResponse
 	Collection
+	GetCollectionRequest
+	ListCollectionsRequest
+	ListCollectionsResponse
+	UpdateCollectionRequest
+	DeleteCollectionRequest
+	ExportCollectionRequest
+	ExportChunk
+	WatchBooksRequest
+	BookEvent
+	Loan
+	CheckoutRequest
+	ReturnRequest
+	RenewRequest
+	ListLoansRequest
+	ListLoansResponse
+	Hold
+	PlaceHoldRequest
+	CancelHoldRequest
+	ListHoldsRequest
+	ListHoldsResponse
+	WatchHoldsRequest
+	Branch
+	Copy
+	CreateBranchRequest
+	GetBranchRequest
+	ListBranchesRequest
+	ListBranchesResponse
+	AddCopyRequest
+	GetCopyRequest
+	GetAvailabilityRequest
+	Availability
+	BranchAvailability
+	TransferCopyRequest
+	ReceiveCopyRequest
 	BookMessage
 	BookResponse
+	Review
+	CreateReviewRequest
+	ListReviewsRequest
+	ListReviewsResponse
+	DeleteReviewRequest
+	Member
+	RegisterMemberRequest
+	GetMemberRequest
+	UpdateMemberRequest
+	SuspendMemberRequest
+	ReinstateMemberRequest
+	DeleteMemberRequest
 */
 package library
 
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
-import google_protobuf "github.com/golang/protobuf/ptypes/timestamp"
+import google_protobuf "google.golang.org/genproto/protobuf/field_mask"
+import google_protobuf1 "github.com/golang/protobuf/ptypes/timestamp"
+import google_protobuf2 "github.com/golang/protobuf/ptypes/wrappers"
 import _ "github.com/johanbrandhorst/protobuf/proto"
 
 import (
@@ -72,6 +169,233 @@ func (x BookType) String() string {
 }
 func (BookType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
 
+// ExportFormat is a file format Books can be exported in.
+type ExportFormat int32
+
+const (
+	// CSV is comma separated values with a header row,
+	// which can be imported again.
+	ExportFormat_CSV ExportFormat = 0
+	// JSON is a JSON array of Books in the proto3 JSON mapping,
+	// which can be imported again.
+	ExportFormat_JSON ExportFormat = 1
+	// BIBTEX is a BibTeX bibliography with a @book entry per Book.
+	ExportFormat_BIBTEX ExportFormat = 2
+	// RIS is a RIS citation file with a record per Book.
+	ExportFormat_RIS ExportFormat = 3
+)
+
+var ExportFormat_name = map[int32]string{
+	0: "CSV",
+	1: "JSON",
+	2: "BIBTEX",
+	3: "RIS",
+}
+var ExportFormat_value = map[string]int32{
+	"CSV":    0,
+	"JSON":   1,
+	"BIBTEX": 2,
+	"RIS":    3,
+}
+
+func (x ExportFormat) String() string {
+	return proto.EnumName(ExportFormat_name, int32(x))
+}
+func (ExportFormat) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+// Reason is why a book is recommended.
+type Recommendation_Reason int32
+
+const (
+	// READ_TOGETHER books are often in the same collections,
+	// or borrowed by the same members, as the seed books.
+	Recommendation_READ_TOGETHER Recommendation_Reason = 0
+	// SAME_AUTHOR books are by the author of a seed book.
+	Recommendation_SAME_AUTHOR Recommendation_Reason = 1
+	// SAME_BOOK_TYPE books are of the type of a seed book.
+	Recommendation_SAME_BOOK_TYPE Recommendation_Reason = 2
+)
+
+var Recommendation_Reason_name = map[int32]string{
+	0: "READ_TOGETHER",
+	1: "SAME_AUTHOR",
+	2: "SAME_BOOK_TYPE",
+}
+var Recommendation_Reason_value = map[string]int32{
+	"READ_TOGETHER":  0,
+	"SAME_AUTHOR":    1,
+	"SAME_BOOK_TYPE": 2,
+}
+
+func (x Recommendation_Reason) String() string {
+	return proto.EnumName(Recommendation_Reason_name, int32(x))
+}
+func (Recommendation_Reason) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{19, 0} }
+
+// ChangeType is the kind of change that created a revision.
+type BookRevision_ChangeType int32
+
+const (
+	// CREATED revisions add the Book to the library.
+	BookRevision_CREATED BookRevision_ChangeType = 0
+	// UPDATED revisions change the Book.
+	BookRevision_UPDATED BookRevision_ChangeType = 1
+	// DELETED revisions delete the Book.
+	BookRevision_DELETED BookRevision_ChangeType = 2
+	// RESTORED revisions restore the deleted Book.
+	BookRevision_RESTORED BookRevision_ChangeType = 3
+)
+
+var BookRevision_ChangeType_name = map[int32]string{
+	0: "CREATED",
+	1: "UPDATED",
+	2: "DELETED",
+	3: "RESTORED",
+}
+var BookRevision_ChangeType_value = map[string]int32{
+	"CREATED":  0,
+	"UPDATED":  1,
+	"DELETED":  2,
+	"RESTORED": 3,
+}
+
+func (x BookRevision_ChangeType) String() string {
+	return proto.EnumName(BookRevision_ChangeType_name, int32(x))
+}
+func (BookRevision_ChangeType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{50, 0} }
+
+// Type is the kind of change made.
+type BookEvent_Type int32
+
+const (
+	// BOOKMARK events carry no Book. One is sent when a watch
+	// is started without a resume token, to report the revision
+	// the watch starts at. They are also sent periodically when
+	// changes not matching the filter have moved the revision on,
+	// so the resume token of the last event received stays current.
+	BookEvent_BOOKMARK BookEvent_Type = 0
+	// ADDED events are sent when a Book is added,
+	// or is changed to match the filter.
+	BookEvent_ADDED BookEvent_Type = 1
+	// UPDATED events are sent when a Book matching the filter is changed.
+	BookEvent_UPDATED BookEvent_Type = 2
+	// DELETED events are sent when a Book is deleted,
+	// or is changed to no longer match the filter.
+	BookEvent_DELETED BookEvent_Type = 3
+)
+
+var BookEvent_Type_name = map[int32]string{
+	0: "BOOKMARK",
+	1: "ADDED",
+	2: "UPDATED",
+	3: "DELETED",
+}
+var BookEvent_Type_value = map[string]int32{
+	"BOOKMARK": 0,
+	"ADDED":    1,
+	"UPDATED":  2,
+	"DELETED":  3,
+}
+
+func (x BookEvent_Type) String() string {
+	return proto.EnumName(BookEvent_Type_name, int32(x))
+}
+func (BookEvent_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{62, 0} }
+
+// State is the state of a hold.
+type Hold_State int32
+
+const (
+	// WAITING holds are queued for a copy.
+	Hold_WAITING Hold_State = 0
+	// READY holds have a copy set aside until the expire time.
+	Hold_READY Hold_State = 1
+	// FULFILLED holds have been checked out by the member.
+	Hold_FULFILLED Hold_State = 2
+	// CANCELLED holds were cancelled by the member.
+	Hold_CANCELLED Hold_State = 3
+	// EXPIRED holds were not checked out before the expire time.
+	Hold_EXPIRED Hold_State = 4
+)
+
+var Hold_State_name = map[int32]string{
+	0: "WAITING",
+	1: "READY",
+	2: "FULFILLED",
+	3: "CANCELLED",
+	4: "EXPIRED",
+}
+var Hold_State_value = map[string]int32{
+	"WAITING":   0,
+	"READY":     1,
+	"FULFILLED": 2,
+	"CANCELLED": 3,
+	"EXPIRED":   4,
+}
+
+func (x Hold_State) String() string {
+	return proto.EnumName(Hold_State_name, int32(x))
+}
+func (Hold_State) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{69, 0} }
+
+// State is the state of a copy.
+type Copy_State int32
+
+const (
+	// AVAILABLE copies are at their branch, and may be lent.
+	Copy_AVAILABLE Copy_State = 0
+	// ON_LOAN copies have been checked out by barcode.
+	Copy_ON_LOAN Copy_State = 1
+	// IN_TRANSIT copies are being transferred to another branch.
+	Copy_IN_TRANSIT Copy_State = 2
+	// RESERVED copies are at their branch, set aside for
+	// the ready hold with the barcode of the copy.
+	Copy_RESERVED Copy_State = 3
+)
+
+var Copy_State_name = map[int32]string{
+	0: "AVAILABLE",
+	1: "ON_LOAN",
+	2: "IN_TRANSIT",
+	3: "RESERVED",
+}
+var Copy_State_value = map[string]int32{
+	"AVAILABLE":  0,
+	"ON_LOAN":    1,
+	"IN_TRANSIT": 2,
+	"RESERVED":   3,
+}
+
+func (x Copy_State) String() string {
+	return proto.EnumName(Copy_State_name, int32(x))
+}
+func (Copy_State) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{76, 0} }
+
+// State is the state of a membership.
+type Member_State int32
+
+const (
+	// ACTIVE members may borrow books.
+	Member_ACTIVE Member_State = 0
+	// SUSPENDED members may not borrow books, place holds
+	// or join the chat until they are reinstated.
+	Member_SUSPENDED Member_State = 1
+)
+
+var Member_State_name = map[int32]string{
+	0: "ACTIVE",
+	1: "SUSPENDED",
+}
+var Member_State_value = map[string]int32{
+	"ACTIVE":    0,
+	"SUSPENDED": 1,
+}
+
+func (x Member_State) String() string {
+	return proto.EnumName(Member_State_name, int32(x))
+}
+func (Member_State) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{95, 0} }
+
 // Publisher describes a Book Publisher.
 type Publisher struct {
 	// Name is the name of the Publisher.
@@ -90,13 +414,267 @@ func (m *Publisher) GetName() string {
 	return ""
 }
 
+// Author is an author of Books in the library.
+type Author struct {
+	// Id identifies the author. It is set by the server.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// Name is the name of the author, as printed on their books.
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// SortName is the name of the author as it is ordered in
+	// a catalog, for example "Orwell, George". If empty, it is
+	// set from the name by the server.
+	SortName string `protobuf:"bytes,3,opt,name=sort_name,json=sortName" json:"sort_name,omitempty"`
+	// BirthYear is the year the author was born, or 0 if unknown.
+	// Years before the common era are negative.
+	BirthYear int32 `protobuf:"varint,4,opt,name=birth_year,json=birthYear" json:"birth_year,omitempty"`
+	// DeathYear is the year the author died, or 0
+	// if the author is alive or it is unknown.
+	DeathYear int32 `protobuf:"varint,5,opt,name=death_year,json=deathYear" json:"death_year,omitempty"`
+}
+
+func (m *Author) Reset()                    { *m = Author{} }
+func (m *Author) String() string            { return proto.CompactTextString(m) }
+func (*Author) ProtoMessage()               {}
+func (*Author) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *Author) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Author) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Author) GetSortName() string {
+	if m != nil {
+		return m.SortName
+	}
+	return ""
+}
+
+func (m *Author) GetBirthYear() int32 {
+	if m != nil {
+		return m.BirthYear
+	}
+	return 0
+}
+
+func (m *Author) GetDeathYear() int32 {
+	if m != nil {
+		return m.DeathYear
+	}
+	return 0
+}
+
+// Work is a text published in one or more editions,
+// such as the hardcover and paperback of a novel.
+type Work struct {
+	// Id identifies the work. It is set by the server.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// Title is the title of the work.
+	Title string `protobuf:"bytes,2,opt,name=title" json:"title,omitempty"`
+	// AuthorIds are the IDs of the authors of the work.
+	// The authors must exist.
+	AuthorIds []string `protobuf:"bytes,3,rep,name=author_ids,json=authorIds" json:"author_ids,omitempty"`
+	// SeriesId is the ID of the series the work is part of, if any.
+	SeriesId string `protobuf:"bytes,4,opt,name=series_id,json=seriesId" json:"series_id,omitempty"`
+	// SeriesNumber is the position of the work in its series,
+	// starting at 1. It must be set if the series is, and no
+	// two works in a series may have the same number.
+	SeriesNumber int32 `protobuf:"varint,5,opt,name=series_number,json=seriesNumber" json:"series_number,omitempty"`
+}
+
+func (m *Work) Reset()                    { *m = Work{} }
+func (m *Work) String() string            { return proto.CompactTextString(m) }
+func (*Work) ProtoMessage()               {}
+func (*Work) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *Work) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Work) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *Work) GetAuthorIds() []string {
+	if m != nil {
+		return m.AuthorIds
+	}
+	return nil
+}
+
+func (m *Work) GetSeriesId() string {
+	if m != nil {
+		return m.SeriesId
+	}
+	return ""
+}
+
+func (m *Work) GetSeriesNumber() int32 {
+	if m != nil {
+		return m.SeriesNumber
+	}
+	return 0
+}
+
+// Series is a sequence of works, such as the novels of a trilogy.
+type Series struct {
+	// Id identifies the series. It is set by the server.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// Name is the name of the series.
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+}
+
+func (m *Series) Reset()                    { *m = Series{} }
+func (m *Series) String() string            { return proto.CompactTextString(m) }
+func (*Series) ProtoMessage()               {}
+func (*Series) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *Series) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Series) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// Subject is a subject in the subject taxonomy of the library,
+// such as FIC009000 for Fiction / Fantasy / General. Subjects
+// form a tree, each subject under its parent, if it has one.
+type Subject struct {
+	// Code identifies the subject. It may only contain
+	// uppercase letters and digits, as in BISAC subject codes.
+	Code string `protobuf:"bytes,1,opt,name=code" json:"code,omitempty"`
+	// Name is the name of the subject.
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// ParentCode is the code of the subject this subject is under,
+	// or empty for top level subjects. The parent must exist.
+	ParentCode string `protobuf:"bytes,3,opt,name=parent_code,json=parentCode" json:"parent_code,omitempty"`
+}
+
+func (m *Subject) Reset()                    { *m = Subject{} }
+func (m *Subject) String() string            { return proto.CompactTextString(m) }
+func (*Subject) ProtoMessage()               {}
+func (*Subject) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *Subject) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *Subject) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Subject) GetParentCode() string {
+	if m != nil {
+		return m.ParentCode
+	}
+	return ""
+}
+
+// TagCount is the number of times a tag has been applied.
+type TagCount struct {
+	// Tag is the tag.
+	Tag string `protobuf:"bytes,1,opt,name=tag" json:"tag,omitempty"`
+	// Count is the number of members who have applied the tag
+	// to a book, or the number of books tagged with it.
+	Count int32 `protobuf:"varint,2,opt,name=count" json:"count,omitempty"`
+}
+
+func (m *TagCount) Reset()                    { *m = TagCount{} }
+func (m *TagCount) String() string            { return proto.CompactTextString(m) }
+func (*TagCount) ProtoMessage()               {}
+func (*TagCount) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *TagCount) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+func (m *TagCount) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// Localization is the title and description of a Book in a language.
+type Localization struct {
+	// LanguageCode is the BCP 47 code of the language, such as fr or pt-BR.
+	LanguageCode string `protobuf:"bytes,1,opt,name=language_code,json=languageCode" json:"language_code,omitempty"`
+	// Title is the title of the book in the language.
+	Title string `protobuf:"bytes,2,opt,name=title" json:"title,omitempty"`
+	// Description is the description of the book in the language.
+	Description string `protobuf:"bytes,3,opt,name=description" json:"description,omitempty"`
+}
+
+func (m *Localization) Reset()                    { *m = Localization{} }
+func (m *Localization) String() string            { return proto.CompactTextString(m) }
+func (*Localization) ProtoMessage()               {}
+func (*Localization) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *Localization) GetLanguageCode() string {
+	if m != nil {
+		return m.LanguageCode
+	}
+	return ""
+}
+
+func (m *Localization) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *Localization) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
 // Book represents a book in the library.
 type Book struct {
-	// Isbn is the ISBN number of the book.
-	Isbn int64 `protobuf:"varint,1,opt,name=isbn" json:"isbn,omitempty"`
+	// LegacyIsbn is the ISBN of the book as a number.
+	// Leading zeros are lost in this form, so it is
+	// deprecated in favour of Isbn. It is still set on
+	// all books returned, and accepted in place of Isbn.
+	LegacyIsbn int64 `protobuf:"varint,1,opt,name=legacy_isbn,json=legacyIsbn" json:"legacy_isbn,omitempty"`
 	// Title is the title of the book.
 	Title string `protobuf:"bytes,2,opt,name=title" json:"title,omitempty"`
-	// Author is the author of the book.
+	// Author is the names of the authors of the book, separated
+	// by "; ". It is deprecated in favour of AuthorIds, and is set
+	// by the server on all books returned. It is still accepted in
+	// place of AuthorIds, in which case the authors are looked up
+	// by name and added to the library if they don't exist.
 	Author string `protobuf:"bytes,3,opt,name=author" json:"author,omitempty"`
 	// BookType is the type of the book.
 	BookType BookType `protobuf:"varint,4,opt,name=book_type,json=bookType,enum=library.BookType" json:"book_type,omitempty"`
@@ -108,13 +686,80 @@ type Book struct {
 	//	*Book_Publisher
 	PublishingMethod isBook_PublishingMethod `protobuf_oneof:"publishing_method"`
 	// PublicationDate is the time of publication of the book.
-	PublicationDate *google_protobuf.Timestamp `protobuf:"bytes,7,opt,name=publication_date,json=publicationDate" json:"publication_date,omitempty"`
+	PublicationDate *google_protobuf1.Timestamp `protobuf:"bytes,7,opt,name=publication_date,json=publicationDate" json:"publication_date,omitempty"`
+	// Isbn is the ISBN-13 of the book, without hyphens.
+	// An ISBN-10 or ISBN-13, optionally with hyphens, is
+	// accepted when adding a book to the library.
+	Isbn string `protobuf:"bytes,8,opt,name=isbn" json:"isbn,omitempty"`
+	// Isbn10 is the ISBN-10 of the book, if it has one.
+	// It is set by the server.
+	Isbn10 string `protobuf:"bytes,9,opt,name=isbn10" json:"isbn10,omitempty"`
+	// Etag identifies the version of the book. It is set by the
+	// server and changes every time the book is written, except when
+	// only its copies, available copies, average rating and review count
+	// change. Pass it to UpdateBook or DeleteBook to only change the
+	// version read.
+	Etag string `protobuf:"bytes,10,opt,name=etag" json:"etag,omitempty"`
+	// DeleteTime is when the book was deleted.
+	// It is only set on deleted books.
+	DeleteTime *google_protobuf1.Timestamp `protobuf:"bytes,11,opt,name=delete_time,json=deleteTime" json:"delete_time,omitempty"`
+	// Copies is the number of copies of the book
+	// the library has for lending. Adding a Copy
+	// to a branch with AddCopy increases it.
+	Copies int32 `protobuf:"varint,12,opt,name=copies" json:"copies,omitempty"`
+	// AvailableCopies is the number of copies that are not on loan,
+	// in transit or set aside for a hold. It is set by the server.
+	AvailableCopies int32 `protobuf:"varint,13,opt,name=available_copies,json=availableCopies" json:"available_copies,omitempty"`
+	// AverageRating is the average rating of the reviews
+	// of the book, from 1 to 5, or 0 if it has no reviews.
+	// It is set by the server.
+	AverageRating float64 `protobuf:"fixed64,14,opt,name=average_rating,json=averageRating" json:"average_rating,omitempty"`
+	// ReviewCount is the number of reviews of the book.
+	// It is set by the server.
+	ReviewCount int32 `protobuf:"varint,15,opt,name=review_count,json=reviewCount" json:"review_count,omitempty"`
+	// AuthorIds are the IDs of the authors of the book, in
+	// the order they are credited. The authors must exist.
+	AuthorIds []string `protobuf:"bytes,16,rep,name=author_ids,json=authorIds" json:"author_ids,omitempty"`
+	// WorkId is the ID of the work the book is an edition of, if any.
+	// The work must exist.
+	WorkId string `protobuf:"bytes,17,opt,name=work_id,json=workId" json:"work_id,omitempty"`
+	// CoverUrl is the path of the cover image of the book on the
+	// server, if it has one. Thumbnails are served with a width
+	// parameter, for example `?width=128`. It is set by the server,
+	// and changes when a new cover is uploaded with UploadCover.
+	CoverUrl string `protobuf:"bytes,18,opt,name=cover_url,json=coverUrl" json:"cover_url,omitempty"`
+	// SubjectCodes are the codes of the subjects of the book.
+	// The subjects must exist.
+	SubjectCodes []string `protobuf:"bytes,19,rep,name=subject_codes,json=subjectCodes" json:"subject_codes,omitempty"`
+	// Tags are the tags members have applied to the book with TagBook,
+	// most applied first. It is set by the server.
+	Tags []*TagCount `protobuf:"bytes,20,rep,name=tags" json:"tags,omitempty"`
+	// LanguageCode is the BCP 47 code of the language the book
+	// is written in, such as en or pt-BR. The title and description
+	// are in this language.
+	LanguageCode string `protobuf:"bytes,21,opt,name=language_code,json=languageCode" json:"language_code,omitempty"`
+	// OriginalIsbn is the ISBN-13 of the book this book is a
+	// translation of, in its original language, if any.
+	// The original book must exist, and can't be deleted
+	// while its translations are in the library.
+	OriginalIsbn string `protobuf:"bytes,22,opt,name=original_isbn,json=originalIsbn" json:"original_isbn,omitempty"`
+	// Description is a description of the book.
+	Description string `protobuf:"bytes,23,opt,name=description" json:"description,omitempty"`
+	// Localizations are the title and description of the book
+	// translated into other languages, one per language.
+	Localizations []*Localization `protobuf:"bytes,24,rep,name=localizations" json:"localizations,omitempty"`
+	// Localized is the title and description to show the user, from the
+	// localization best matching the languages in the accept-language
+	// request metadata, which grpc-web sets from the Accept-Language
+	// header. If none match, it is the title and description of the
+	// book. It is set by the server on the books it returns.
+	Localized *Localization `protobuf:"bytes,25,opt,name=localized" json:"localized,omitempty"`
 }
 
 func (m *Book) Reset()                    { *m = Book{} }
 func (m *Book) String() string            { return proto.CompactTextString(m) }
 func (*Book) ProtoMessage()               {}
-func (*Book) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+func (*Book) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
 
 type isBook_PublishingMethod interface{ isBook_PublishingMethod() }
 
@@ -135,9 +780,9 @@ func (m *Book) GetPublishingMethod() isBook_PublishingMethod {
 	return nil
 }
 
-func (m *Book) GetIsbn() int64 {
+func (m *Book) GetLegacyIsbn() int64 {
 	if m != nil {
-		return m.Isbn
+		return m.LegacyIsbn
 	}
 	return 0
 }
@@ -177,247 +822,205 @@ func (m *Book) GetPublisher() *Publisher {
 	return nil
 }
 
-func (m *Book) GetPublicationDate() *google_protobuf.Timestamp {
+func (m *Book) GetPublicationDate() *google_protobuf1.Timestamp {
 	if m != nil {
 		return m.PublicationDate
 	}
 	return nil
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*Book) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _Book_OneofMarshaler, _Book_OneofUnmarshaler, _Book_OneofSizer, []interface{}{
-		(*Book_SelfPublished)(nil),
-		(*Book_Publisher)(nil),
+func (m *Book) GetIsbn() string {
+	if m != nil {
+		return m.Isbn
 	}
+	return ""
 }
 
-func _Book_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*Book)
-	// publishing_method
-	switch x := m.PublishingMethod.(type) {
-	case *Book_SelfPublished:
-		t := uint64(0)
-		if x.SelfPublished {
-			t = 1
-		}
-		b.EncodeVarint(5<<3 | proto.WireVarint)
-		b.EncodeVarint(t)
-	case *Book_Publisher:
-		b.EncodeVarint(6<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.Publisher); err != nil {
-			return err
-		}
-	case nil:
-	default:
-		return fmt.Errorf("Book.PublishingMethod has unexpected type %T", x)
+func (m *Book) GetIsbn10() string {
+	if m != nil {
+		return m.Isbn10
+	}
+	return ""
+}
+
+func (m *Book) GetEtag() string {
+	if m != nil {
+		return m.Etag
+	}
+	return ""
+}
+
+func (m *Book) GetDeleteTime() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.DeleteTime
 	}
 	return nil
 }
 
-func _Book_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*Book)
-	switch tag {
-	case 5: // publishing_method.self_published
-		if wire != proto.WireVarint {
-			return true, proto.ErrInternalBadWireType
-		}
-		x, err := b.DecodeVarint()
-		m.PublishingMethod = &Book_SelfPublished{x != 0}
-		return true, err
-	case 6: // publishing_method.publisher
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(Publisher)
-		err := b.DecodeMessage(msg)
-		m.PublishingMethod = &Book_Publisher{msg}
-		return true, err
-	default:
-		return false, nil
+func (m *Book) GetCopies() int32 {
+	if m != nil {
+		return m.Copies
 	}
+	return 0
 }
 
-func _Book_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*Book)
-	// publishing_method
-	switch x := m.PublishingMethod.(type) {
-	case *Book_SelfPublished:
-		n += proto.SizeVarint(5<<3 | proto.WireVarint)
-		n += 1
-	case *Book_Publisher:
-		s := proto.Size(x.Publisher)
-		n += proto.SizeVarint(6<<3 | proto.WireBytes)
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+func (m *Book) GetAvailableCopies() int32 {
+	if m != nil {
+		return m.AvailableCopies
 	}
-	return n
+	return 0
 }
 
-// GetBookRequest is the input to the GetBook method.
-type GetBookRequest struct {
-	// Isbn is the ISBN with which
-	// to match against the ISBN of a book in the library.
-	Isbn int64 `protobuf:"varint,1,opt,name=isbn" json:"isbn,omitempty"`
+func (m *Book) GetAverageRating() float64 {
+	if m != nil {
+		return m.AverageRating
+	}
+	return 0
 }
 
-func (m *GetBookRequest) Reset()                    { *m = GetBookRequest{} }
-func (m *GetBookRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetBookRequest) ProtoMessage()               {}
-func (*GetBookRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
-
-func (m *GetBookRequest) GetIsbn() int64 {
+func (m *Book) GetReviewCount() int32 {
 	if m != nil {
-		return m.Isbn
+		return m.ReviewCount
 	}
 	return 0
 }
 
-// QueryBooksRequest is the input to the QueryBooks method.
-type QueryBooksRequest struct {
-	// AuthorPrefix is the prefix with which
-	// to match against the author of a book in the library.
-	AuthorPrefix string `protobuf:"bytes,1,opt,name=author_prefix,json=authorPrefix" json:"author_prefix,omitempty"`
+func (m *Book) GetAuthorIds() []string {
+	if m != nil {
+		return m.AuthorIds
+	}
+	return nil
 }
 
-func (m *QueryBooksRequest) Reset()                    { *m = QueryBooksRequest{} }
-func (m *QueryBooksRequest) String() string            { return proto.CompactTextString(m) }
-func (*QueryBooksRequest) ProtoMessage()               {}
-func (*QueryBooksRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
-
-func (m *QueryBooksRequest) GetAuthorPrefix() string {
+func (m *Book) GetWorkId() string {
 	if m != nil {
-		return m.AuthorPrefix
+		return m.WorkId
 	}
 	return ""
 }
 
-// Collection is a collection of books
-type Collection struct {
-	// Books is a list of books
-	Books []*Book `protobuf:"bytes,1,rep,name=books" json:"books,omitempty"`
+func (m *Book) GetCoverUrl() string {
+	if m != nil {
+		return m.CoverUrl
+	}
+	return ""
 }
 
-func (m *Collection) Reset()                    { *m = Collection{} }
-func (m *Collection) String() string            { return proto.CompactTextString(m) }
-func (*Collection) ProtoMessage()               {}
-func (*Collection) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
-
-func (m *Collection) GetBooks() []*Book {
+func (m *Book) GetSubjectCodes() []string {
 	if m != nil {
-		return m.Books
+		return m.SubjectCodes
 	}
 	return nil
 }
 
-// BookMessage is used to discuss books
-type BookMessage struct {
-	// Types that are valid to be assigned to Content:
-	//	*BookMessage_Name
-	//	*BookMessage_Message
-	Content isBookMessage_Content `protobuf_oneof:"content"`
+func (m *Book) GetTags() []*TagCount {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
 }
 
-func (m *BookMessage) Reset()                    { *m = BookMessage{} }
-func (m *BookMessage) String() string            { return proto.CompactTextString(m) }
-func (*BookMessage) ProtoMessage()               {}
-func (*BookMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
-
-type isBookMessage_Content interface{ isBookMessage_Content() }
-
-type BookMessage_Name struct {
-	Name string `protobuf:"bytes,1,opt,name=name,oneof"`
-}
-type BookMessage_Message struct {
-	Message string `protobuf:"bytes,2,opt,name=message,oneof"`
+func (m *Book) GetLanguageCode() string {
+	if m != nil {
+		return m.LanguageCode
+	}
+	return ""
 }
 
-func (*BookMessage_Name) isBookMessage_Content()    {}
-func (*BookMessage_Message) isBookMessage_Content() {}
-
-func (m *BookMessage) GetContent() isBookMessage_Content {
+func (m *Book) GetOriginalIsbn() string {
 	if m != nil {
-		return m.Content
+		return m.OriginalIsbn
 	}
-	return nil
+	return ""
 }
 
-func (m *BookMessage) GetName() string {
-	if x, ok := m.GetContent().(*BookMessage_Name); ok {
-		return x.Name
+func (m *Book) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
 	return ""
 }
 
-func (m *BookMessage) GetMessage() string {
-	if x, ok := m.GetContent().(*BookMessage_Message); ok {
-		return x.Message
+func (m *Book) GetLocalizations() []*Localization {
+	if m != nil {
+		return m.Localizations
 	}
-	return ""
+	return nil
+}
+
+func (m *Book) GetLocalized() *Localization {
+	if m != nil {
+		return m.Localized
+	}
+	return nil
 }
 
 // XXX_OneofFuncs is for the internal use of the proto package.
-func (*BookMessage) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _BookMessage_OneofMarshaler, _BookMessage_OneofUnmarshaler, _BookMessage_OneofSizer, []interface{}{
-		(*BookMessage_Name)(nil),
-		(*BookMessage_Message)(nil),
+func (*Book) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _Book_OneofMarshaler, _Book_OneofUnmarshaler, _Book_OneofSizer, []interface{}{
+		(*Book_SelfPublished)(nil),
+		(*Book_Publisher)(nil),
 	}
 }
 
-func _BookMessage_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*BookMessage)
-	// content
-	switch x := m.Content.(type) {
-	case *BookMessage_Name:
-		b.EncodeVarint(1<<3 | proto.WireBytes)
-		b.EncodeStringBytes(x.Name)
-	case *BookMessage_Message:
-		b.EncodeVarint(2<<3 | proto.WireBytes)
-		b.EncodeStringBytes(x.Message)
+func _Book_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*Book)
+	// publishing_method
+	switch x := m.PublishingMethod.(type) {
+	case *Book_SelfPublished:
+		t := uint64(0)
+		if x.SelfPublished {
+			t = 1
+		}
+		b.EncodeVarint(5<<3 | proto.WireVarint)
+		b.EncodeVarint(t)
+	case *Book_Publisher:
+		b.EncodeVarint(6<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Publisher); err != nil {
+			return err
+		}
 	case nil:
 	default:
-		return fmt.Errorf("BookMessage.Content has unexpected type %T", x)
+		return fmt.Errorf("Book.PublishingMethod has unexpected type %T", x)
 	}
 	return nil
 }
 
-func _BookMessage_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*BookMessage)
+func _Book_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*Book)
 	switch tag {
-	case 1: // content.name
-		if wire != proto.WireBytes {
+	case 5: // publishing_method.self_published
+		if wire != proto.WireVarint {
 			return true, proto.ErrInternalBadWireType
 		}
-		x, err := b.DecodeStringBytes()
-		m.Content = &BookMessage_Name{x}
+		x, err := b.DecodeVarint()
+		m.PublishingMethod = &Book_SelfPublished{x != 0}
 		return true, err
-	case 2: // content.message
+	case 6: // publishing_method.publisher
 		if wire != proto.WireBytes {
 			return true, proto.ErrInternalBadWireType
 		}
-		x, err := b.DecodeStringBytes()
-		m.Content = &BookMessage_Message{x}
+		msg := new(Publisher)
+		err := b.DecodeMessage(msg)
+		m.PublishingMethod = &Book_Publisher{msg}
 		return true, err
 	default:
 		return false, nil
 	}
 }
 
-func _BookMessage_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*BookMessage)
-	// content
-	switch x := m.Content.(type) {
-	case *BookMessage_Name:
-		n += proto.SizeVarint(1<<3 | proto.WireBytes)
-		n += proto.SizeVarint(uint64(len(x.Name)))
-		n += len(x.Name)
-	case *BookMessage_Message:
-		n += proto.SizeVarint(2<<3 | proto.WireBytes)
-		n += proto.SizeVarint(uint64(len(x.Message)))
-		n += len(x.Message)
+func _Book_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Book)
+	// publishing_method
+	switch x := m.PublishingMethod.(type) {
+	case *Book_SelfPublished:
+		n += proto.SizeVarint(5<<3 | proto.WireVarint)
+		n += 1
+	case *Book_Publisher:
+		s := proto.Size(x.Publisher)
+		n += proto.SizeVarint(6<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -425,311 +1028,6255 @@ func _BookMessage_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
-// BookResponse is used to discuss books
-type BookResponse struct {
-	// Message is a message from a user.
-	Message string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
+// GetBookRequest is the input to the GetBook method.
+type GetBookRequest struct {
+	// LegacyIsbn is the ISBN with which to match against
+	// the ISBN of a book in the library, as a number.
+	// It is deprecated in favour of Isbn.
+	LegacyIsbn int64 `protobuf:"varint,1,opt,name=legacy_isbn,json=legacyIsbn" json:"legacy_isbn,omitempty"`
+	// Isbn is the ISBN-10 or ISBN-13, optionally with hyphens,
+	// with which to match against the ISBN of a book in the library.
+	Isbn string `protobuf:"bytes,2,opt,name=isbn" json:"isbn,omitempty"`
+	// AsOf returns the book as it was at this time, if set.
+	AsOf *google_protobuf1.Timestamp `protobuf:"bytes,3,opt,name=as_of,json=asOf" json:"as_of,omitempty"`
 }
 
-func (m *BookResponse) Reset()                    { *m = BookResponse{} }
-func (m *BookResponse) String() string            { return proto.CompactTextString(m) }
-func (*BookResponse) ProtoMessage()               {}
-func (*BookResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (m *GetBookRequest) Reset()                    { *m = GetBookRequest{} }
+func (m *GetBookRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetBookRequest) ProtoMessage()               {}
+func (*GetBookRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
 
-func (m *BookResponse) GetMessage() string {
+func (m *GetBookRequest) GetLegacyIsbn() int64 {
 	if m != nil {
-		return m.Message
+		return m.LegacyIsbn
 	}
-	return ""
+	return 0
 }
 
-func init() {
-	proto.RegisterType((*Publisher)(nil), "library.Publisher")
-	proto.RegisterType((*Book)(nil), "library.Book")
-	proto.RegisterType((*GetBookRequest)(nil), "library.GetBookRequest")
-	proto.RegisterType((*QueryBooksRequest)(nil), "library.QueryBooksRequest")
-	proto.RegisterType((*Collection)(nil), "library.Collection")
-	proto.RegisterType((*BookMessage)(nil), "library.BookMessage")
-	proto.RegisterType((*BookResponse)(nil), "library.BookResponse")
-	proto.RegisterEnum("library.BookType", BookType_name, BookType_value)
+func (m *GetBookRequest) GetIsbn() string {
+	if m != nil {
+		return m.Isbn
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *GetBookRequest) GetAsOf() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.AsOf
+	}
+	return nil
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// QueryBooksRequest is the input to the QueryBooks method.
+// Books must match all the filters set.
+type QueryBooksRequest struct {
+	// AuthorPrefix is the prefix with which
+	// to match against the author of a book in the library.
+	AuthorPrefix string `protobuf:"bytes,1,opt,name=author_prefix,json=authorPrefix" json:"author_prefix,omitempty"`
+	// BookTypes is the set of book types to match against
+	// the type of a book in the library. If empty,
+	// books of all types match.
+	BookTypes []BookType `protobuf:"varint,2,rep,packed,name=book_types,json=bookTypes,enum=library.BookType" json:"book_types,omitempty"`
+	// PublishedAfter matches books published at or after this time.
+	PublishedAfter *google_protobuf1.Timestamp `protobuf:"bytes,3,opt,name=published_after,json=publishedAfter" json:"published_after,omitempty"`
+	// PublishedBefore matches books published before this time.
+	// It must be after PublishedAfter, if both are set.
+	PublishedBefore *google_protobuf1.Timestamp `protobuf:"bytes,4,opt,name=published_before,json=publishedBefore" json:"published_before,omitempty"`
+	// Publisher is the name with which to match against
+	// the name of the publisher of a book in the library,
+	// ignoring case.
+	Publisher string `protobuf:"bytes,5,opt,name=publisher" json:"publisher,omitempty"`
+	// SelfPublished, if set, matches books that
+	// are self published if true, and books published
+	// through a publisher if false.
+	SelfPublished *google_protobuf2.BoolValue `protobuf:"bytes,6,opt,name=self_published,json=selfPublished" json:"self_published,omitempty"`
+	// OrderBy is the field to order the books by, optionally
+	// followed by asc or desc, for example `author desc`.
+	// Valid fields are isbn, title, author and publication_date.
+	// Titles and authors are ordered according to the collation
+	// rules of the server locale. Authors are ordered by the sort
+	// names of the linked authors, first author first, or else by
+	// the author field. If empty, books are returned in the order
+	// they were added to the library.
+	OrderBy string `protobuf:"bytes,7,opt,name=order_by,json=orderBy" json:"order_by,omitempty"`
+	// AsOf queries the books in the library as they were
+	// at this time, if set.
+	AsOf *google_protobuf1.Timestamp `protobuf:"bytes,8,opt,name=as_of,json=asOf" json:"as_of,omitempty"`
+	// CollapseEditions only returns the first matching edition of
+	// each work, in the order requested. Books that are not
+	// editions of a work are always returned.
+	CollapseEditions bool `protobuf:"varint,9,opt,name=collapse_editions,json=collapseEditions" json:"collapse_editions,omitempty"`
+	// SubjectCodes matches books with any of these subjects,
+	// or any subject under them. The subjects must exist.
+	SubjectCodes []string `protobuf:"bytes,10,rep,name=subject_codes,json=subjectCodes" json:"subject_codes,omitempty"`
+	// Tags matches books tagged with all of these tags.
+	Tags []string `protobuf:"bytes,11,rep,name=tags" json:"tags,omitempty"`
+}
 
-// Client API for BookService service
+func (m *QueryBooksRequest) Reset()                    { *m = QueryBooksRequest{} }
+func (m *QueryBooksRequest) String() string            { return proto.CompactTextString(m) }
+func (*QueryBooksRequest) ProtoMessage()               {}
+func (*QueryBooksRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
 
-type BookServiceClient interface {
-	// GetBook returns a Book from the library
-	// that matches the ISBN provided, if found.
-	// Otherwise it returns a NotFound error.
-	GetBook(ctx context.Context, in *GetBookRequest, opts ...grpc.CallOption) (*Book, error)
-	// QueryBooks returns all Books whos author
-	// matches the author prefix provided, as a stream
-	// of Books.
-	QueryBooks(ctx context.Context, in *QueryBooksRequest, opts ...grpc.CallOption) (BookService_QueryBooksClient, error)
-	// MakeCollection takes a stream of books and returns a Book collection.
-	MakeCollection(ctx context.Context, opts ...grpc.CallOption) (BookService_MakeCollectionClient, error)
-	// BookChat allows discussion about books
-	BookChat(ctx context.Context, opts ...grpc.CallOption) (BookService_BookChatClient, error)
+func (m *QueryBooksRequest) GetAuthorPrefix() string {
+	if m != nil {
+		return m.AuthorPrefix
+	}
+	return ""
 }
 
-type bookServiceClient struct {
-	cc *grpc.ClientConn
+func (m *QueryBooksRequest) GetBookTypes() []BookType {
+	if m != nil {
+		return m.BookTypes
+	}
+	return nil
 }
 
-func NewBookServiceClient(cc *grpc.ClientConn) BookServiceClient {
-	return &bookServiceClient{cc}
+func (m *QueryBooksRequest) GetPublishedAfter() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.PublishedAfter
+	}
+	return nil
 }
 
-func (c *bookServiceClient) GetBook(ctx context.Context, in *GetBookRequest, opts ...grpc.CallOption) (*Book, error) {
-	out := new(Book)
-	err := grpc.Invoke(ctx, "/library.BookService/GetBook", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBooksRequest) GetPublishedBefore() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.PublishedBefore
 	}
-	return out, nil
+	return nil
 }
 
-func (c *bookServiceClient) QueryBooks(ctx context.Context, in *QueryBooksRequest, opts ...grpc.CallOption) (BookService_QueryBooksClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_BookService_serviceDesc.Streams[0], c.cc, "/library.BookService/QueryBooks", opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBooksRequest) GetPublisher() string {
+	if m != nil {
+		return m.Publisher
 	}
-	x := &bookServiceQueryBooksClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	return ""
+}
+
+func (m *QueryBooksRequest) GetSelfPublished() *google_protobuf2.BoolValue {
+	if m != nil {
+		return m.SelfPublished
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	return nil
+}
+
+func (m *QueryBooksRequest) GetOrderBy() string {
+	if m != nil {
+		return m.OrderBy
 	}
-	return x, nil
+	return ""
 }
 
-type BookService_QueryBooksClient interface {
-	Recv() (*Book, error)
-	grpc.ClientStream
+func (m *QueryBooksRequest) GetAsOf() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.AsOf
+	}
+	return nil
 }
 
-type bookServiceQueryBooksClient struct {
-	grpc.ClientStream
+func (m *QueryBooksRequest) GetCollapseEditions() bool {
+	if m != nil {
+		return m.CollapseEditions
+	}
+	return false
 }
 
-func (x *bookServiceQueryBooksClient) Recv() (*Book, error) {
-	m := new(Book)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *QueryBooksRequest) GetSubjectCodes() []string {
+	if m != nil {
+		return m.SubjectCodes
 	}
-	return m, nil
+	return nil
 }
 
-func (c *bookServiceClient) MakeCollection(ctx context.Context, opts ...grpc.CallOption) (BookService_MakeCollectionClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_BookService_serviceDesc.Streams[1], c.cc, "/library.BookService/MakeCollection", opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBooksRequest) GetTags() []string {
+	if m != nil {
+		return m.Tags
 	}
-	x := &bookServiceMakeCollectionClient{stream}
-	return x, nil
+	return nil
 }
 
-type BookService_MakeCollectionClient interface {
-	Send(*Book) error
-	CloseAndRecv() (*Collection, error)
-	grpc.ClientStream
+// ListBooksRequest is the input to the ListBooks method.
+type ListBooksRequest struct {
+	// PageSize is the maximum number of books to return.
+	// If zero, at most 10 books are returned. Values above 100
+	// are treated as 100.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize" json:"page_size,omitempty"`
+	// PageToken is the next_page_token returned by a previous call
+	// to ListBooks. If empty, the first page is returned.
+	// The filter and order_by must be the same for all pages.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken" json:"page_token,omitempty"`
+	// Filter restricts the books returned. It is a list of
+	// restrictions of the form `field op value` joined by AND,
+	// for example `author = "George Orwell" AND book_type = PAPERBACK`.
+	// Valid fields are isbn, title, author, publisher, book_type
+	// and self_published. ISBNs are compared in their canonical
+	// ISBN-13 form. The = and != operators compare exactly,
+	// : matches text fields containing the value, ignoring case.
+	Filter string `protobuf:"bytes,3,opt,name=filter" json:"filter,omitempty"`
+	// OrderBy is the field to order the books by, optionally
+	// followed by asc or desc, for example `title desc`.
+	// Valid fields are isbn, title, author and publication_date.
+	// Titles and authors are ordered according to the collation
+	// rules of the server locale. Authors are ordered by the sort
+	// names of the linked authors, first author first, or else by
+	// the author field. If empty, books are ordered by ISBN.
+	OrderBy string `protobuf:"bytes,4,opt,name=order_by,json=orderBy" json:"order_by,omitempty"`
 }
 
-type bookServiceMakeCollectionClient struct {
-	grpc.ClientStream
+func (m *ListBooksRequest) Reset()                    { *m = ListBooksRequest{} }
+func (m *ListBooksRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListBooksRequest) ProtoMessage()               {}
+func (*ListBooksRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *ListBooksRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
 }
 
-func (x *bookServiceMakeCollectionClient) Send(m *Book) error {
-	return x.ClientStream.SendMsg(m)
+func (m *ListBooksRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
 }
 
-func (x *bookServiceMakeCollectionClient) CloseAndRecv() (*Collection, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *ListBooksRequest) GetFilter() string {
+	if m != nil {
+		return m.Filter
 	}
-	m := new(Collection)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+	return ""
+}
+
+func (m *ListBooksRequest) GetOrderBy() string {
+	if m != nil {
+		return m.OrderBy
 	}
-	return m, nil
+	return ""
 }
 
-func (c *bookServiceClient) BookChat(ctx context.Context, opts ...grpc.CallOption) (BookService_BookChatClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_BookService_serviceDesc.Streams[2], c.cc, "/library.BookService/BookChat", opts...)
-	if err != nil {
-		return nil, err
+// ListBooksResponse is the output of the ListBooks method.
+type ListBooksResponse struct {
+	// Books is a page of books matching the request.
+	Books []*Book `protobuf:"bytes,1,rep,name=books" json:"books,omitempty"`
+	// NextPageToken can be used to retrieve the next page of books.
+	// It is empty if there are no more books.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken" json:"next_page_token,omitempty"`
+}
+
+func (m *ListBooksResponse) Reset()                    { *m = ListBooksResponse{} }
+func (m *ListBooksResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListBooksResponse) ProtoMessage()               {}
+func (*ListBooksResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *ListBooksResponse) GetBooks() []*Book {
+	if m != nil {
+		return m.Books
 	}
-	x := &bookServiceBookChatClient{stream}
-	return x, nil
+	return nil
 }
 
-type BookService_BookChatClient interface {
-	Send(*BookMessage) error
-	Recv() (*BookResponse, error)
-	grpc.ClientStream
+func (m *ListBooksResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
 }
 
-type bookServiceBookChatClient struct {
-	grpc.ClientStream
+// SearchBooksRequest is the input to the SearchBooks method.
+type SearchBooksRequest struct {
+	// Query is the text to search for in the title,
+	// author and publisher of books.
+	Query string `protobuf:"bytes,1,opt,name=query" json:"query,omitempty"`
+	// Limit is the maximum number of results to return.
+	// If zero, at most 10 results are returned. Values above
+	// 100 are treated as 100.
+	Limit int32 `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
 }
 
-func (x *bookServiceBookChatClient) Send(m *BookMessage) error {
-	return x.ClientStream.SendMsg(m)
+func (m *SearchBooksRequest) Reset()                    { *m = SearchBooksRequest{} }
+func (m *SearchBooksRequest) String() string            { return proto.CompactTextString(m) }
+func (*SearchBooksRequest) ProtoMessage()               {}
+func (*SearchBooksRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+func (m *SearchBooksRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
 }
 
-func (x *bookServiceBookChatClient) Recv() (*BookResponse, error) {
-	m := new(BookResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *SearchBooksRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
 	}
-	return m, nil
+	return 0
 }
 
-// Server API for BookService service
+// SearchBooksResponse is the output of the SearchBooks method.
+type SearchBooksResponse struct {
+	// Results are the books matching the query,
+	// most relevant first.
+	Results []*SearchResult `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
+}
 
-type BookServiceServer interface {
-	// GetBook returns a Book from the library
-	// that matches the ISBN provided, if found.
-	// Otherwise it returns a NotFound error.
-	GetBook(context.Context, *GetBookRequest) (*Book, error)
-	// QueryBooks returns all Books whos author
-	// matches the author prefix provided, as a stream
-	// of Books.
-	QueryBooks(*QueryBooksRequest, BookService_QueryBooksServer) error
-	// MakeCollection takes a stream of books and returns a Book collection.
-	MakeCollection(BookService_MakeCollectionServer) error
-	// BookChat allows discussion about books
-	BookChat(BookService_BookChatServer) error
+func (m *SearchBooksResponse) Reset()                    { *m = SearchBooksResponse{} }
+func (m *SearchBooksResponse) String() string            { return proto.CompactTextString(m) }
+func (*SearchBooksResponse) ProtoMessage()               {}
+func (*SearchBooksResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *SearchBooksResponse) GetResults() []*SearchResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
 }
 
-func RegisterBookServiceServer(s *grpc.Server, srv BookServiceServer) {
-	s.RegisterService(&_BookService_serviceDesc, srv)
+// SearchResult is a book matching a search query.
+type SearchResult struct {
+	// Book is the matching book.
+	Book *Book `protobuf:"bytes,1,opt,name=book" json:"book,omitempty"`
+	// Score is the relevance of the book to the query.
+	// Higher scores are more relevant.
+	Score float64 `protobuf:"fixed64,2,opt,name=score" json:"score,omitempty"`
+	// Highlights are the fields of the book that matched the query.
+	Highlights []*Highlight `protobuf:"bytes,3,rep,name=highlights" json:"highlights,omitempty"`
 }
 
-func _BookService_GetBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBookRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *SearchResult) Reset()                    { *m = SearchResult{} }
+func (m *SearchResult) String() string            { return proto.CompactTextString(m) }
+func (*SearchResult) ProtoMessage()               {}
+func (*SearchResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+func (m *SearchResult) GetBook() *Book {
+	if m != nil {
+		return m.Book
 	}
-	if interceptor == nil {
-		return srv.(BookServiceServer).GetBook(ctx, in)
+	return nil
+}
+
+func (m *SearchResult) GetScore() float64 {
+	if m != nil {
+		return m.Score
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/library.BookService/GetBook",
+	return 0
+}
+
+func (m *SearchResult) GetHighlights() []*Highlight {
+	if m != nil {
+		return m.Highlights
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookServiceServer).GetBook(ctx, req.(*GetBookRequest))
+	return nil
+}
+
+// Highlight is an excerpt of a field matching a search query.
+type Highlight struct {
+	// Field is the name of the field, one of
+	// title, author or publisher.
+	Field string `protobuf:"bytes,1,opt,name=field" json:"field,omitempty"`
+	// Snippet is the excerpt of the field.
+	Snippet string `protobuf:"bytes,2,opt,name=snippet" json:"snippet,omitempty"`
+	// Matches are the parts of the snippet that matched the query.
+	Matches []*TextRange `protobuf:"bytes,3,rep,name=matches" json:"matches,omitempty"`
+}
+
+func (m *Highlight) Reset()                    { *m = Highlight{} }
+func (m *Highlight) String() string            { return proto.CompactTextString(m) }
+func (*Highlight) ProtoMessage()               {}
+func (*Highlight) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+
+func (m *Highlight) GetField() string {
+	if m != nil {
+		return m.Field
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _BookService_QueryBooks_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(QueryBooksRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *Highlight) GetSnippet() string {
+	if m != nil {
+		return m.Snippet
 	}
-	return srv.(BookServiceServer).QueryBooks(m, &bookServiceQueryBooksServer{stream})
+	return ""
 }
 
-type BookService_QueryBooksServer interface {
-	Send(*Book) error
-	grpc.ServerStream
+func (m *Highlight) GetMatches() []*TextRange {
+	if m != nil {
+		return m.Matches
+	}
+	return nil
 }
 
-type bookServiceQueryBooksServer struct {
-	grpc.ServerStream
+// TextRange is a range of characters (Unicode code points)
+// in a text.
+type TextRange struct {
+	// Start is the offset of the first character in the range.
+	Start int32 `protobuf:"varint,1,opt,name=start" json:"start,omitempty"`
+	// End is the offset of the character after the range.
+	End int32 `protobuf:"varint,2,opt,name=end" json:"end,omitempty"`
 }
 
-func (x *bookServiceQueryBooksServer) Send(m *Book) error {
-	return x.ServerStream.SendMsg(m)
+func (m *TextRange) Reset()                    { *m = TextRange{} }
+func (m *TextRange) String() string            { return proto.CompactTextString(m) }
+func (*TextRange) ProtoMessage()               {}
+func (*TextRange) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+
+func (m *TextRange) GetStart() int32 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
 }
 
-func _BookService_MakeCollection_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(BookServiceServer).MakeCollection(&bookServiceMakeCollectionServer{stream})
+func (m *TextRange) GetEnd() int32 {
+	if m != nil {
+		return m.End
+	}
+	return 0
 }
 
-type BookService_MakeCollectionServer interface {
-	SendAndClose(*Collection) error
-	Recv() (*Book, error)
-	grpc.ServerStream
+// RecommendBooksRequest is the input to the RecommendBooks method.
+type RecommendBooksRequest struct {
+	// Seed selects what the recommendations are for.
+	//
+	// Types that are valid to be assigned to Seed:
+	//	*RecommendBooksRequest_Isbn
+	//	*RecommendBooksRequest_MemberId
+	Seed isRecommendBooksRequest_Seed `protobuf_oneof:"seed"`
+	// Limit is the maximum number of recommendations to return.
+	// If zero, at most 10 recommendations are returned. Values above
+	// 100 are treated as 100.
+	Limit int32 `protobuf:"varint,3,opt,name=limit" json:"limit,omitempty"`
 }
 
-type bookServiceMakeCollectionServer struct {
-	grpc.ServerStream
+func (m *RecommendBooksRequest) Reset()                    { *m = RecommendBooksRequest{} }
+func (m *RecommendBooksRequest) String() string            { return proto.CompactTextString(m) }
+func (*RecommendBooksRequest) ProtoMessage()               {}
+func (*RecommendBooksRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+
+type isRecommendBooksRequest_Seed interface{ isRecommendBooksRequest_Seed() }
+
+type RecommendBooksRequest_Isbn struct {
+	Isbn string `protobuf:"bytes,1,opt,name=isbn,oneof"`
+}
+type RecommendBooksRequest_MemberId struct {
+	MemberId string `protobuf:"bytes,2,opt,name=member_id,json=memberId,oneof"`
 }
 
-func (x *bookServiceMakeCollectionServer) SendAndClose(m *Collection) error {
-	return x.ServerStream.SendMsg(m)
+func (*RecommendBooksRequest_Isbn) isRecommendBooksRequest_Seed()     {}
+func (*RecommendBooksRequest_MemberId) isRecommendBooksRequest_Seed() {}
+
+func (m *RecommendBooksRequest) GetSeed() isRecommendBooksRequest_Seed {
+	if m != nil {
+		return m.Seed
+	}
+	return nil
 }
 
-func (x *bookServiceMakeCollectionServer) Recv() (*Book, error) {
-	m := new(Book)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *RecommendBooksRequest) GetIsbn() string {
+	if x, ok := m.GetSeed().(*RecommendBooksRequest_Isbn); ok {
+		return x.Isbn
 	}
-	return m, nil
+	return ""
 }
 
-func _BookService_BookChat_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(BookServiceServer).BookChat(&bookServiceBookChatServer{stream})
+func (m *RecommendBooksRequest) GetMemberId() string {
+	if x, ok := m.GetSeed().(*RecommendBooksRequest_MemberId); ok {
+		return x.MemberId
+	}
+	return ""
 }
 
-type BookService_BookChatServer interface {
-	Send(*BookResponse) error
-	Recv() (*BookMessage, error)
-	grpc.ServerStream
+func (m *RecommendBooksRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
 }
 
-type bookServiceBookChatServer struct {
-	grpc.ServerStream
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*RecommendBooksRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _RecommendBooksRequest_OneofMarshaler, _RecommendBooksRequest_OneofUnmarshaler, _RecommendBooksRequest_OneofSizer, []interface{}{
+		(*RecommendBooksRequest_Isbn)(nil),
+		(*RecommendBooksRequest_MemberId)(nil),
+	}
 }
 
-func (x *bookServiceBookChatServer) Send(m *BookResponse) error {
-	return x.ServerStream.SendMsg(m)
+func _RecommendBooksRequest_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*RecommendBooksRequest)
+	// seed
+	switch x := m.Seed.(type) {
+	case *RecommendBooksRequest_Isbn:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.Isbn)
+	case *RecommendBooksRequest_MemberId:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.MemberId)
+	case nil:
+	default:
+		return fmt.Errorf("RecommendBooksRequest.Seed has unexpected type %T", x)
+	}
+	return nil
 }
 
-func (x *bookServiceBookChatServer) Recv() (*BookMessage, error) {
-	m := new(BookMessage)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
+func _RecommendBooksRequest_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*RecommendBooksRequest)
+	switch tag {
+	case 1: // seed.isbn
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Seed = &RecommendBooksRequest_Isbn{x}
+		return true, err
+	case 2: // seed.member_id
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Seed = &RecommendBooksRequest_MemberId{x}
+		return true, err
+	default:
+		return false, nil
 	}
-	return m, nil
 }
 
-var _BookService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "library.BookService",
-	HandlerType: (*BookServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetBook",
-			Handler:    _BookService_GetBook_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "QueryBooks",
-			Handler:       _BookService_QueryBooks_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "MakeCollection",
-			Handler:       _BookService_MakeCollection_Handler,
+func _RecommendBooksRequest_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*RecommendBooksRequest)
+	// seed
+	switch x := m.Seed.(type) {
+	case *RecommendBooksRequest_Isbn:
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(len(x.Isbn)))
+		n += len(x.Isbn)
+	case *RecommendBooksRequest_MemberId:
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(len(x.MemberId)))
+		n += len(x.MemberId)
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+// RecommendBooksResponse is the output of the RecommendBooks method.
+type RecommendBooksResponse struct {
+	// Recommendations are the recommended books, best first.
+	Recommendations []*Recommendation `protobuf:"bytes,1,rep,name=recommendations" json:"recommendations,omitempty"`
+}
+
+func (m *RecommendBooksResponse) Reset()                    { *m = RecommendBooksResponse{} }
+func (m *RecommendBooksResponse) String() string            { return proto.CompactTextString(m) }
+func (*RecommendBooksResponse) ProtoMessage()               {}
+func (*RecommendBooksResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+
+func (m *RecommendBooksResponse) GetRecommendations() []*Recommendation {
+	if m != nil {
+		return m.Recommendations
+	}
+	return nil
+}
+
+// Recommendation is a book recommended by RecommendBooks.
+type Recommendation struct {
+	// Book is the recommended book.
+	Book *Book `protobuf:"bytes,1,opt,name=book" json:"book,omitempty"`
+	// Reason is why the book is recommended.
+	Reason Recommendation_Reason `protobuf:"varint,2,opt,name=reason,enum=library.Recommendation_Reason" json:"reason,omitempty"`
+	// Score is the similarity, from 0 to 1, of the book to the seed
+	// books. It is only set for READ_TOGETHER recommendations.
+	Score float64 `protobuf:"fixed64,3,opt,name=score" json:"score,omitempty"`
+}
+
+func (m *Recommendation) Reset()                    { *m = Recommendation{} }
+func (m *Recommendation) String() string            { return proto.CompactTextString(m) }
+func (*Recommendation) ProtoMessage()               {}
+func (*Recommendation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+
+func (m *Recommendation) GetBook() *Book {
+	if m != nil {
+		return m.Book
+	}
+	return nil
+}
+
+func (m *Recommendation) GetReason() Recommendation_Reason {
+	if m != nil {
+		return m.Reason
+	}
+	return Recommendation_READ_TOGETHER
+}
+
+func (m *Recommendation) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+// CreateBookRequest is the input to the CreateBook method.
+type CreateBookRequest struct {
+	// Book is the book to add to the library.
+	// The ISBN and title must be set.
+	Book *Book `protobuf:"bytes,1,opt,name=book" json:"book,omitempty"`
+}
+
+func (m *CreateBookRequest) Reset()                    { *m = CreateBookRequest{} }
+func (m *CreateBookRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateBookRequest) ProtoMessage()               {}
+func (*CreateBookRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+
+func (m *CreateBookRequest) GetBook() *Book {
+	if m != nil {
+		return m.Book
+	}
+	return nil
+}
+
+// UpdateBookRequest is the input to the UpdateBook method.
+type UpdateBookRequest struct {
+	// Book contains the new values of the book.
+	// The ISBN identifies the book to update.
+	// The ISBN of a book can't be changed.
+	// If the etag is set, the book is only updated if
+	// it has not been changed since that version was read.
+	Book *Book `protobuf:"bytes,1,opt,name=book" json:"book,omitempty"`
+	// UpdateMask lists the fields of the book to update.
+	// If it is not set, all fields except the ISBN are replaced.
+	// Valid paths are title, author_ids, author, book_type, self_published,
+	// publisher, publication_date, copies, work_id, subject_codes,
+	// language_code, original_isbn, description and localizations.
+	UpdateMask *google_protobuf.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask" json:"update_mask,omitempty"`
+}
+
+func (m *UpdateBookRequest) Reset()                    { *m = UpdateBookRequest{} }
+func (m *UpdateBookRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateBookRequest) ProtoMessage()               {}
+func (*UpdateBookRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+
+func (m *UpdateBookRequest) GetBook() *Book {
+	if m != nil {
+		return m.Book
+	}
+	return nil
+}
+
+func (m *UpdateBookRequest) GetUpdateMask() *google_protobuf.FieldMask {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
+}
+
+// CreateAuthorRequest is the input to the CreateAuthor method.
+type CreateAuthorRequest struct {
+	// Author is the author to add to the library.
+	// The name must be set.
+	Author *Author `protobuf:"bytes,1,opt,name=author" json:"author,omitempty"`
+}
+
+func (m *CreateAuthorRequest) Reset()                    { *m = CreateAuthorRequest{} }
+func (m *CreateAuthorRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateAuthorRequest) ProtoMessage()               {}
+func (*CreateAuthorRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+
+func (m *CreateAuthorRequest) GetAuthor() *Author {
+	if m != nil {
+		return m.Author
+	}
+	return nil
+}
+
+// GetAuthorRequest is the input to the GetAuthor method.
+type GetAuthorRequest struct {
+	// Id is the ID of the author.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *GetAuthorRequest) Reset()                    { *m = GetAuthorRequest{} }
+func (m *GetAuthorRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetAuthorRequest) ProtoMessage()               {}
+func (*GetAuthorRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+
+func (m *GetAuthorRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// UpdateAuthorRequest is the input to the UpdateAuthor method.
+type UpdateAuthorRequest struct {
+	// Author contains the new values of the author.
+	// The ID identifies the author to update.
+	Author *Author `protobuf:"bytes,1,opt,name=author" json:"author,omitempty"`
+	// UpdateMask lists the fields of the author to update.
+	// If it is not set, all fields except the ID are replaced.
+	// Valid paths are name, sort_name, birth_year and death_year.
+	UpdateMask *google_protobuf.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask" json:"update_mask,omitempty"`
+}
+
+func (m *UpdateAuthorRequest) Reset()                    { *m = UpdateAuthorRequest{} }
+func (m *UpdateAuthorRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateAuthorRequest) ProtoMessage()               {}
+func (*UpdateAuthorRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+
+func (m *UpdateAuthorRequest) GetAuthor() *Author {
+	if m != nil {
+		return m.Author
+	}
+	return nil
+}
+
+func (m *UpdateAuthorRequest) GetUpdateMask() *google_protobuf.FieldMask {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
+}
+
+// UploadCoverRequest is a chunk of a cover image
+// uploaded with the UploadCover method.
+type UploadCoverRequest struct {
+	// Isbn is the ISBN-10 or ISBN-13, optionally with hyphens, of
+	// the book. It must be set on the first chunk, and is ignored
+	// on the rest.
+	Isbn string `protobuf:"bytes,1,opt,name=isbn" json:"isbn,omitempty"`
+	// Etag is the etag of the book, if set on the first chunk.
+	// The cover is then only set if the book has not been
+	// changed since that version was read.
+	Etag string `protobuf:"bytes,2,opt,name=etag" json:"etag,omitempty"`
+	// Data is the next chunk of the image. Images must be
+	// JPEG, PNG or GIF, and may be at most 10 MiB.
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *UploadCoverRequest) Reset()                    { *m = UploadCoverRequest{} }
+func (m *UploadCoverRequest) String() string            { return proto.CompactTextString(m) }
+func (*UploadCoverRequest) ProtoMessage()               {}
+func (*UploadCoverRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+
+func (m *UploadCoverRequest) GetIsbn() string {
+	if m != nil {
+		return m.Isbn
+	}
+	return ""
+}
+
+func (m *UploadCoverRequest) GetEtag() string {
+	if m != nil {
+		return m.Etag
+	}
+	return ""
+}
+
+func (m *UploadCoverRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// CreateWorkRequest is the input to the CreateWork method.
+type CreateWorkRequest struct {
+	// Work is the work to add to the library.
+	// The title must be set.
+	Work *Work `protobuf:"bytes,1,opt,name=work" json:"work,omitempty"`
+}
+
+func (m *CreateWorkRequest) Reset()                    { *m = CreateWorkRequest{} }
+func (m *CreateWorkRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateWorkRequest) ProtoMessage()               {}
+func (*CreateWorkRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+
+func (m *CreateWorkRequest) GetWork() *Work {
+	if m != nil {
+		return m.Work
+	}
+	return nil
+}
+
+// GetWorkRequest is the input to the GetWork method.
+type GetWorkRequest struct {
+	// Id is the ID of the work.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *GetWorkRequest) Reset()                    { *m = GetWorkRequest{} }
+func (m *GetWorkRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetWorkRequest) ProtoMessage()               {}
+func (*GetWorkRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+
+func (m *GetWorkRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// UpdateWorkRequest is the input to the UpdateWork method.
+type UpdateWorkRequest struct {
+	// Work contains the new values of the work.
+	// The ID identifies the work to update.
+	Work *Work `protobuf:"bytes,1,opt,name=work" json:"work,omitempty"`
+	// UpdateMask lists the fields of the work to update.
+	// If it is not set, all fields except the ID are replaced.
+	// Valid paths are title, author_ids, series_id and series_number.
+	UpdateMask *google_protobuf.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask" json:"update_mask,omitempty"`
+}
+
+func (m *UpdateWorkRequest) Reset()                    { *m = UpdateWorkRequest{} }
+func (m *UpdateWorkRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateWorkRequest) ProtoMessage()               {}
+func (*UpdateWorkRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+
+func (m *UpdateWorkRequest) GetWork() *Work {
+	if m != nil {
+		return m.Work
+	}
+	return nil
+}
+
+func (m *UpdateWorkRequest) GetUpdateMask() *google_protobuf.FieldMask {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
+}
+
+// ListEditionsRequest is the input to the ListEditions method.
+type ListEditionsRequest struct {
+	// WorkId is the ID of the work whose editions to list.
+	WorkId string `protobuf:"bytes,1,opt,name=work_id,json=workId" json:"work_id,omitempty"`
+}
+
+func (m *ListEditionsRequest) Reset()                    { *m = ListEditionsRequest{} }
+func (m *ListEditionsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListEditionsRequest) ProtoMessage()               {}
+func (*ListEditionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+
+func (m *ListEditionsRequest) GetWorkId() string {
+	if m != nil {
+		return m.WorkId
+	}
+	return ""
+}
+
+// ListEditionsResponse is the output of the ListEditions method.
+type ListEditionsResponse struct {
+	// Books are the editions of the work, by book
+	// type and then by publication date.
+	Books []*Book `protobuf:"bytes,1,rep,name=books" json:"books,omitempty"`
+}
+
+func (m *ListEditionsResponse) Reset()                    { *m = ListEditionsResponse{} }
+func (m *ListEditionsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListEditionsResponse) ProtoMessage()               {}
+func (*ListEditionsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+func (m *ListEditionsResponse) GetBooks() []*Book {
+	if m != nil {
+		return m.Books
+	}
+	return nil
+}
+
+// CreateSeriesRequest is the input to the CreateSeries method.
+type CreateSeriesRequest struct {
+	// Series is the series to add to the library.
+	// The name must be set.
+	Series *Series `protobuf:"bytes,1,opt,name=series" json:"series,omitempty"`
+}
+
+func (m *CreateSeriesRequest) Reset()                    { *m = CreateSeriesRequest{} }
+func (m *CreateSeriesRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateSeriesRequest) ProtoMessage()               {}
+func (*CreateSeriesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+
+func (m *CreateSeriesRequest) GetSeries() *Series {
+	if m != nil {
+		return m.Series
+	}
+	return nil
+}
+
+// GetSeriesRequest is the input to the GetSeries method.
+type GetSeriesRequest struct {
+	// Id is the ID of the series.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *GetSeriesRequest) Reset()                    { *m = GetSeriesRequest{} }
+func (m *GetSeriesRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetSeriesRequest) ProtoMessage()               {}
+func (*GetSeriesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+func (m *GetSeriesRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// ListSeriesWorksRequest is the input to the ListSeriesWorks method.
+type ListSeriesWorksRequest struct {
+	// SeriesId is the ID of the series whose works to list.
+	SeriesId string `protobuf:"bytes,1,opt,name=series_id,json=seriesId" json:"series_id,omitempty"`
+}
+
+func (m *ListSeriesWorksRequest) Reset()                    { *m = ListSeriesWorksRequest{} }
+func (m *ListSeriesWorksRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListSeriesWorksRequest) ProtoMessage()               {}
+func (*ListSeriesWorksRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+
+func (m *ListSeriesWorksRequest) GetSeriesId() string {
+	if m != nil {
+		return m.SeriesId
+	}
+	return ""
+}
+
+// ListSeriesWorksResponse is the output of the ListSeriesWorks method.
+type ListSeriesWorksResponse struct {
+	// Works are the works of the series, by series number.
+	Works []*Work `protobuf:"bytes,1,rep,name=works" json:"works,omitempty"`
+}
+
+func (m *ListSeriesWorksResponse) Reset()                    { *m = ListSeriesWorksResponse{} }
+func (m *ListSeriesWorksResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListSeriesWorksResponse) ProtoMessage()               {}
+func (*ListSeriesWorksResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+
+func (m *ListSeriesWorksResponse) GetWorks() []*Work {
+	if m != nil {
+		return m.Works
+	}
+	return nil
+}
+
+// CreateSubjectRequest is the input to the CreateSubject method.
+type CreateSubjectRequest struct {
+	// Subject is the subject to add to the taxonomy.
+	// The code and name must be set.
+	Subject *Subject `protobuf:"bytes,1,opt,name=subject" json:"subject,omitempty"`
+}
+
+func (m *CreateSubjectRequest) Reset()                    { *m = CreateSubjectRequest{} }
+func (m *CreateSubjectRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateSubjectRequest) ProtoMessage()               {}
+func (*CreateSubjectRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+
+func (m *CreateSubjectRequest) GetSubject() *Subject {
+	if m != nil {
+		return m.Subject
+	}
+	return nil
+}
+
+// GetSubjectRequest is the input to the GetSubject method.
+type GetSubjectRequest struct {
+	// Code is the code of the subject to return.
+	Code string `protobuf:"bytes,1,opt,name=code" json:"code,omitempty"`
+}
+
+func (m *GetSubjectRequest) Reset()                    { *m = GetSubjectRequest{} }
+func (m *GetSubjectRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetSubjectRequest) ProtoMessage()               {}
+func (*GetSubjectRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+
+func (m *GetSubjectRequest) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+// UpdateSubjectRequest is the input to the UpdateSubject method.
+type UpdateSubjectRequest struct {
+	// Subject is the subject to update, identified by its code.
+	Subject *Subject `protobuf:"bytes,1,opt,name=subject" json:"subject,omitempty"`
+	// UpdateMask selects the fields of the subject to update,
+	// which may be name and parent_code. If empty,
+	// all of these fields are updated.
+	UpdateMask *google_protobuf.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask" json:"update_mask,omitempty"`
+}
+
+func (m *UpdateSubjectRequest) Reset()                    { *m = UpdateSubjectRequest{} }
+func (m *UpdateSubjectRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateSubjectRequest) ProtoMessage()               {}
+func (*UpdateSubjectRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+
+func (m *UpdateSubjectRequest) GetSubject() *Subject {
+	if m != nil {
+		return m.Subject
+	}
+	return nil
+}
+
+func (m *UpdateSubjectRequest) GetUpdateMask() *google_protobuf.FieldMask {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
+}
+
+// DeleteSubjectRequest is the input to the DeleteSubject method.
+type DeleteSubjectRequest struct {
+	// Code is the code of the subject to delete.
+	Code string `protobuf:"bytes,1,opt,name=code" json:"code,omitempty"`
+}
+
+func (m *DeleteSubjectRequest) Reset()                    { *m = DeleteSubjectRequest{} }
+func (m *DeleteSubjectRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteSubjectRequest) ProtoMessage()               {}
+func (*DeleteSubjectRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+
+func (m *DeleteSubjectRequest) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+// BrowseSubjectsRequest is the input to the BrowseSubjects method.
+type BrowseSubjectsRequest struct {
+	// ParentCode is the code of the subject whose subjects
+	// to return. If empty, the top level subjects are returned.
+	ParentCode string `protobuf:"bytes,1,opt,name=parent_code,json=parentCode" json:"parent_code,omitempty"`
+}
+
+func (m *BrowseSubjectsRequest) Reset()                    { *m = BrowseSubjectsRequest{} }
+func (m *BrowseSubjectsRequest) String() string            { return proto.CompactTextString(m) }
+func (*BrowseSubjectsRequest) ProtoMessage()               {}
+func (*BrowseSubjectsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+
+func (m *BrowseSubjectsRequest) GetParentCode() string {
+	if m != nil {
+		return m.ParentCode
+	}
+	return ""
+}
+
+// BrowseSubjectsResponse is the output of the BrowseSubjects method.
+type BrowseSubjectsResponse struct {
+	// Subjects are the subjects under the parent, by code.
+	Subjects []*SubjectCount `protobuf:"bytes,1,rep,name=subjects" json:"subjects,omitempty"`
+}
+
+func (m *BrowseSubjectsResponse) Reset()                    { *m = BrowseSubjectsResponse{} }
+func (m *BrowseSubjectsResponse) String() string            { return proto.CompactTextString(m) }
+func (*BrowseSubjectsResponse) ProtoMessage()               {}
+func (*BrowseSubjectsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+
+func (m *BrowseSubjectsResponse) GetSubjects() []*SubjectCount {
+	if m != nil {
+		return m.Subjects
+	}
+	return nil
+}
+
+// SubjectCount is a Subject and the number of Books about it.
+type SubjectCount struct {
+	// Subject is the subject.
+	Subject *Subject `protobuf:"bytes,1,opt,name=subject" json:"subject,omitempty"`
+	// BookCount is the number of books with the subject,
+	// or any subject under it.
+	BookCount int32 `protobuf:"varint,2,opt,name=book_count,json=bookCount" json:"book_count,omitempty"`
+	// SubjectCount is the number of subjects directly under the subject.
+	SubjectCount int32 `protobuf:"varint,3,opt,name=subject_count,json=subjectCount" json:"subject_count,omitempty"`
+}
+
+func (m *SubjectCount) Reset()                    { *m = SubjectCount{} }
+func (m *SubjectCount) String() string            { return proto.CompactTextString(m) }
+func (*SubjectCount) ProtoMessage()               {}
+func (*SubjectCount) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+
+func (m *SubjectCount) GetSubject() *Subject {
+	if m != nil {
+		return m.Subject
+	}
+	return nil
+}
+
+func (m *SubjectCount) GetBookCount() int32 {
+	if m != nil {
+		return m.BookCount
+	}
+	return 0
+}
+
+func (m *SubjectCount) GetSubjectCount() int32 {
+	if m != nil {
+		return m.SubjectCount
+	}
+	return 0
+}
+
+// TagBookRequest is the input to the TagBook method.
+type TagBookRequest struct {
+	// Isbn is the ISBN-10 or ISBN-13, optionally with hyphens,
+	// of the book to tag.
+	Isbn string `protobuf:"bytes,1,opt,name=isbn" json:"isbn,omitempty"`
+	// Member is the ID of the member applying the tag.
+	Member string `protobuf:"bytes,2,opt,name=member" json:"member,omitempty"`
+	// Tag is the tag to apply. Tags are made lowercase, and may only
+	// contain letters, digits, spaces and dashes, up to 32 characters.
+	Tag string `protobuf:"bytes,3,opt,name=tag" json:"tag,omitempty"`
+}
+
+func (m *TagBookRequest) Reset()                    { *m = TagBookRequest{} }
+func (m *TagBookRequest) String() string            { return proto.CompactTextString(m) }
+func (*TagBookRequest) ProtoMessage()               {}
+func (*TagBookRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+
+func (m *TagBookRequest) GetIsbn() string {
+	if m != nil {
+		return m.Isbn
+	}
+	return ""
+}
+
+func (m *TagBookRequest) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+func (m *TagBookRequest) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+// UntagBookRequest is the input to the UntagBook method.
+type UntagBookRequest struct {
+	// Isbn is the ISBN-10 or ISBN-13, optionally with hyphens,
+	// of the book to remove the tag from.
+	Isbn string `protobuf:"bytes,1,opt,name=isbn" json:"isbn,omitempty"`
+	// Member is the ID of the member who applied the tag.
+	Member string `protobuf:"bytes,2,opt,name=member" json:"member,omitempty"`
+	// Tag is the tag to remove.
+	Tag string `protobuf:"bytes,3,opt,name=tag" json:"tag,omitempty"`
+}
+
+func (m *UntagBookRequest) Reset()                    { *m = UntagBookRequest{} }
+func (m *UntagBookRequest) String() string            { return proto.CompactTextString(m) }
+func (*UntagBookRequest) ProtoMessage()               {}
+func (*UntagBookRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+
+func (m *UntagBookRequest) GetIsbn() string {
+	if m != nil {
+		return m.Isbn
+	}
+	return ""
+}
+
+func (m *UntagBookRequest) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+func (m *UntagBookRequest) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+// ListTagsRequest is the input to the ListTags method.
+type ListTagsRequest struct {
+	// Member only counts the tags applied by the member
+	// with this ID, if set.
+	Member string `protobuf:"bytes,1,opt,name=member" json:"member,omitempty"`
+}
+
+func (m *ListTagsRequest) Reset()                    { *m = ListTagsRequest{} }
+func (m *ListTagsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListTagsRequest) ProtoMessage()               {}
+func (*ListTagsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+
+func (m *ListTagsRequest) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+// ListTagsResponse is the output of the ListTags method.
+type ListTagsResponse struct {
+	// Tags are the tags applied to books, with the number of books
+	// tagged with each, most used first.
+	Tags []*TagCount `protobuf:"bytes,1,rep,name=tags" json:"tags,omitempty"`
+}
+
+func (m *ListTagsResponse) Reset()                    { *m = ListTagsResponse{} }
+func (m *ListTagsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListTagsResponse) ProtoMessage()               {}
+func (*ListTagsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
+
+func (m *ListTagsResponse) GetTags() []*TagCount {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+// ListAuthorBooksRequest is the input to the ListAuthorBooks method.
+type ListAuthorBooksRequest struct {
+	// AuthorId is the ID of the author whose books to list.
+	AuthorId string `protobuf:"bytes,1,opt,name=author_id,json=authorId" json:"author_id,omitempty"`
+	// PageSize is the maximum number of books to return.
+	// It defaults to 10, and may be at most 100.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize" json:"page_size,omitempty"`
+	// PageToken is the NextPageToken of the previous response,
+	// to return the next page. The author must be the same.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken" json:"page_token,omitempty"`
+}
+
+func (m *ListAuthorBooksRequest) Reset()                    { *m = ListAuthorBooksRequest{} }
+func (m *ListAuthorBooksRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListAuthorBooksRequest) ProtoMessage()               {}
+func (*ListAuthorBooksRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+
+func (m *ListAuthorBooksRequest) GetAuthorId() string {
+	if m != nil {
+		return m.AuthorId
+	}
+	return ""
+}
+
+func (m *ListAuthorBooksRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListAuthorBooksRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+// ListAuthorBooksResponse is the output of the ListAuthorBooks method.
+type ListAuthorBooksResponse struct {
+	// Books is a page of the books of the author,
+	// by publication date, oldest first.
+	Books []*Book `protobuf:"bytes,1,rep,name=books" json:"books,omitempty"`
+	// NextPageToken returns the next page when passed to ListAuthorBooks.
+	// It is empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken" json:"next_page_token,omitempty"`
+}
+
+func (m *ListAuthorBooksResponse) Reset()                    { *m = ListAuthorBooksResponse{} }
+func (m *ListAuthorBooksResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListAuthorBooksResponse) ProtoMessage()               {}
+func (*ListAuthorBooksResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
+
+func (m *ListAuthorBooksResponse) GetBooks() []*Book {
+	if m != nil {
+		return m.Books
+	}
+	return nil
+}
+
+func (m *ListAuthorBooksResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+// DeleteBookRequest is the input to the DeleteBook method.
+type DeleteBookRequest struct {
+	// LegacyIsbn is the ISBN of the book to remove from the
+	// library, as a number. It is deprecated in favour of Isbn.
+	LegacyIsbn int64 `protobuf:"varint,1,opt,name=legacy_isbn,json=legacyIsbn" json:"legacy_isbn,omitempty"`
+	// Isbn is the ISBN-10 or ISBN-13, optionally with hyphens,
+	// of the book to remove from the library.
+	Isbn string `protobuf:"bytes,2,opt,name=isbn" json:"isbn,omitempty"`
+	// Etag is the etag of the book, if set. The book is only removed
+	// if it has not been changed since that version was read.
+	Etag string `protobuf:"bytes,3,opt,name=etag" json:"etag,omitempty"`
+}
+
+func (m *DeleteBookRequest) Reset()                    { *m = DeleteBookRequest{} }
+func (m *DeleteBookRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteBookRequest) ProtoMessage()               {}
+func (*DeleteBookRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+
+func (m *DeleteBookRequest) GetLegacyIsbn() int64 {
+	if m != nil {
+		return m.LegacyIsbn
+	}
+	return 0
+}
+
+func (m *DeleteBookRequest) GetIsbn() string {
+	if m != nil {
+		return m.Isbn
+	}
+	return ""
+}
+
+func (m *DeleteBookRequest) GetEtag() string {
+	if m != nil {
+		return m.Etag
+	}
+	return ""
+}
+
+// RestoreBookRequest is the input to the RestoreBook method.
+type RestoreBookRequest struct {
+	// Isbn is the ISBN-10 or ISBN-13, optionally with hyphens,
+	// of the deleted book to restore.
+	Isbn string `protobuf:"bytes,1,opt,name=isbn" json:"isbn,omitempty"`
+}
+
+func (m *RestoreBookRequest) Reset()                    { *m = RestoreBookRequest{} }
+func (m *RestoreBookRequest) String() string            { return proto.CompactTextString(m) }
+func (*RestoreBookRequest) ProtoMessage()               {}
+func (*RestoreBookRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+
+func (m *RestoreBookRequest) GetIsbn() string {
+	if m != nil {
+		return m.Isbn
+	}
+	return ""
+}
+
+// BookRevision is a version of a Book in the revision history.
+type BookRevision struct {
+	// Etag is the etag of the Book after the change.
+	// Deletions keep the etag of the deleted Book.
+	Etag string `protobuf:"bytes,1,opt,name=etag" json:"etag,omitempty"`
+	// ChangeType is the kind of change made.
+	ChangeType BookRevision_ChangeType `protobuf:"varint,2,opt,name=change_type,json=changeType,enum=library.BookRevision_ChangeType" json:"change_type,omitempty"`
+	// Book is the Book after the change. For deletions,
+	// it is the Book as it was deleted.
+	Book *Book `protobuf:"bytes,3,opt,name=book" json:"book,omitempty"`
+	// ChangeTime is when the change was made.
+	ChangeTime *google_protobuf1.Timestamp `protobuf:"bytes,4,opt,name=change_time,json=changeTime" json:"change_time,omitempty"`
+	// User is the user who made the change, as provided
+	// in the "user" request metadata. Changes by users who
+	// are not members return an Unauthenticated error.
+	// The metadata is not authenticated, so it is only as
+	// trustworthy as the clients of the library.
+	User string `protobuf:"bytes,5,opt,name=user" json:"user,omitempty"`
+	// ChangedFields lists the fields of the Book
+	// changed by an update, such as title or publisher.
+	ChangedFields []string `protobuf:"bytes,6,rep,name=changed_fields,json=changedFields" json:"changed_fields,omitempty"`
+}
+
+func (m *BookRevision) Reset()                    { *m = BookRevision{} }
+func (m *BookRevision) String() string            { return proto.CompactTextString(m) }
+func (*BookRevision) ProtoMessage()               {}
+func (*BookRevision) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+
+func (m *BookRevision) GetEtag() string {
+	if m != nil {
+		return m.Etag
+	}
+	return ""
+}
+
+func (m *BookRevision) GetChangeType() BookRevision_ChangeType {
+	if m != nil {
+		return m.ChangeType
+	}
+	return BookRevision_CREATED
+}
+
+func (m *BookRevision) GetBook() *Book {
+	if m != nil {
+		return m.Book
+	}
+	return nil
+}
+
+func (m *BookRevision) GetChangeTime() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.ChangeTime
+	}
+	return nil
+}
+
+func (m *BookRevision) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *BookRevision) GetChangedFields() []string {
+	if m != nil {
+		return m.ChangedFields
+	}
+	return nil
+}
+
+// ListBookRevisionsRequest is the input to the ListBookRevisions method.
+type ListBookRevisionsRequest struct {
+	// Isbn is the ISBN-10 or ISBN-13, optionally with hyphens,
+	// of the book to list the revisions of.
+	Isbn string `protobuf:"bytes,1,opt,name=isbn" json:"isbn,omitempty"`
+	// PageSize is the maximum number of revisions to return.
+	// It defaults to 10, and may be at most 100.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize" json:"page_size,omitempty"`
+	// PageToken is the NextPageToken of the previous response,
+	// to return the next page. The ISBN must be the same.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken" json:"page_token,omitempty"`
+}
+
+func (m *ListBookRevisionsRequest) Reset()                    { *m = ListBookRevisionsRequest{} }
+func (m *ListBookRevisionsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListBookRevisionsRequest) ProtoMessage()               {}
+func (*ListBookRevisionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
+
+func (m *ListBookRevisionsRequest) GetIsbn() string {
+	if m != nil {
+		return m.Isbn
+	}
+	return ""
+}
+
+func (m *ListBookRevisionsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListBookRevisionsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+// ListBookRevisionsResponse is the output of the ListBookRevisions method.
+type ListBookRevisionsResponse struct {
+	// Revisions is a page of revisions, newest first.
+	Revisions []*BookRevision `protobuf:"bytes,1,rep,name=revisions" json:"revisions,omitempty"`
+	// NextPageToken returns the next page when passed to ListBookRevisions.
+	// It is empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken" json:"next_page_token,omitempty"`
+}
+
+func (m *ListBookRevisionsResponse) Reset()                    { *m = ListBookRevisionsResponse{} }
+func (m *ListBookRevisionsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListBookRevisionsResponse) ProtoMessage()               {}
+func (*ListBookRevisionsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
+
+func (m *ListBookRevisionsResponse) GetRevisions() []*BookRevision {
+	if m != nil {
+		return m.Revisions
+	}
+	return nil
+}
+
+func (m *ListBookRevisionsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+// Collection is a collection of books
+type Collection struct {
+	// Books is a list of books
+	Books []*Book `protobuf:"bytes,1,rep,name=books" json:"books,omitempty"`
+	// Id identifies the Collection. It is assigned
+	// when the Collection is made.
+	Id string `protobuf:"bytes,2,opt,name=id" json:"id,omitempty"`
+	// Owner is the ID of the member who made the Collection.
+	Owner string `protobuf:"bytes,3,opt,name=owner" json:"owner,omitempty"`
+	// Name is the name of the Collection.
+	Name string `protobuf:"bytes,4,opt,name=name" json:"name,omitempty"`
+	// CreateTime is when the Collection was made.
+	CreateTime *google_protobuf1.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime" json:"create_time,omitempty"`
+}
+
+func (m *Collection) Reset()                    { *m = Collection{} }
+func (m *Collection) String() string            { return proto.CompactTextString(m) }
+func (*Collection) ProtoMessage()               {}
+func (*Collection) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
+
+func (m *Collection) GetBooks() []*Book {
+	if m != nil {
+		return m.Books
+	}
+	return nil
+}
+
+func (m *Collection) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Collection) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Collection) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Collection) GetCreateTime() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+// GetCollectionRequest is the input to the GetCollection method.
+type GetCollectionRequest struct {
+	// Id is the ID of the Collection to return.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *GetCollectionRequest) Reset()                    { *m = GetCollectionRequest{} }
+func (m *GetCollectionRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetCollectionRequest) ProtoMessage()               {}
+func (*GetCollectionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
+
+func (m *GetCollectionRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// ListCollectionsRequest is the input to the ListCollections method.
+type ListCollectionsRequest struct {
+	// Owner selects the Collections made by the member with this ID.
+	// If empty, Collections of all members are listed.
+	Owner string `protobuf:"bytes,1,opt,name=owner" json:"owner,omitempty"`
+	// PageSize is the maximum number of Collections to return.
+	// It defaults to 10, and may be at most 100.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize" json:"page_size,omitempty"`
+	// PageToken is the NextPageToken of the previous response,
+	// to return the next page. The owner must be the same.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken" json:"page_token,omitempty"`
+}
+
+func (m *ListCollectionsRequest) Reset()                    { *m = ListCollectionsRequest{} }
+func (m *ListCollectionsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListCollectionsRequest) ProtoMessage()               {}
+func (*ListCollectionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+
+func (m *ListCollectionsRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ListCollectionsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListCollectionsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+// ListCollectionsResponse is the output of the ListCollections method.
+type ListCollectionsResponse struct {
+	// Collections is a page of Collections, oldest first.
+	Collections []*Collection `protobuf:"bytes,1,rep,name=collections" json:"collections,omitempty"`
+	// NextPageToken returns the next page when passed to ListCollections.
+	// It is empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken" json:"next_page_token,omitempty"`
+}
+
+func (m *ListCollectionsResponse) Reset()                    { *m = ListCollectionsResponse{} }
+func (m *ListCollectionsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListCollectionsResponse) ProtoMessage()               {}
+func (*ListCollectionsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+
+func (m *ListCollectionsResponse) GetCollections() []*Collection {
+	if m != nil {
+		return m.Collections
+	}
+	return nil
+}
+
+func (m *ListCollectionsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+// UpdateCollectionRequest is the input to the UpdateCollection method.
+type UpdateCollectionRequest struct {
+	// Collection contains the new values of the Collection.
+	// The ID identifies the Collection to update.
+	Collection *Collection `protobuf:"bytes,1,opt,name=collection" json:"collection,omitempty"`
+	// UpdateMask lists the fields of the Collection to update.
+	// Valid paths are name and books. Updating the books replaces
+	// the Books of the Collection with the ISBNs of the Books provided,
+	// in order, which adds, removes and reorders Books.
+	// If it is not set, both fields are replaced.
+	UpdateMask *google_protobuf.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask" json:"update_mask,omitempty"`
+}
+
+func (m *UpdateCollectionRequest) Reset()                    { *m = UpdateCollectionRequest{} }
+func (m *UpdateCollectionRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateCollectionRequest) ProtoMessage()               {}
+func (*UpdateCollectionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
+
+func (m *UpdateCollectionRequest) GetCollection() *Collection {
+	if m != nil {
+		return m.Collection
+	}
+	return nil
+}
+
+func (m *UpdateCollectionRequest) GetUpdateMask() *google_protobuf.FieldMask {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
+}
+
+// DeleteCollectionRequest is the input to the DeleteCollection method.
+type DeleteCollectionRequest struct {
+	// Id is the ID of the Collection to delete.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *DeleteCollectionRequest) Reset()                    { *m = DeleteCollectionRequest{} }
+func (m *DeleteCollectionRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteCollectionRequest) ProtoMessage()               {}
+func (*DeleteCollectionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
+
+func (m *DeleteCollectionRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// ExportCollectionRequest is the input to the ExportCollection method.
+// Either a collection ID or a query must be set.
+type ExportCollectionRequest struct {
+	// Types that are valid to be assigned to Source:
+	//	*ExportCollectionRequest_CollectionId
+	//	*ExportCollectionRequest_Query
+	Source isExportCollectionRequest_Source `protobuf_oneof:"source"`
+	// Format is the file format to export the Books in.
+	Format ExportFormat `protobuf:"varint,3,opt,name=format,enum=library.ExportFormat" json:"format,omitempty"`
+}
+
+func (m *ExportCollectionRequest) Reset()                    { *m = ExportCollectionRequest{} }
+func (m *ExportCollectionRequest) String() string            { return proto.CompactTextString(m) }
+func (*ExportCollectionRequest) ProtoMessage()               {}
+func (*ExportCollectionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
+
+type isExportCollectionRequest_Source interface{ isExportCollectionRequest_Source() }
+
+type ExportCollectionRequest_CollectionId struct {
+	CollectionId string `protobuf:"bytes,4,opt,name=collection_id,json=collectionId,oneof"`
+}
+type ExportCollectionRequest_Query struct {
+	Query *QueryBooksRequest `protobuf:"bytes,2,opt,name=query,oneof"`
+}
+
+func (*ExportCollectionRequest_CollectionId) isExportCollectionRequest_Source() {}
+func (*ExportCollectionRequest_Query) isExportCollectionRequest_Source()        {}
+
+func (m *ExportCollectionRequest) GetSource() isExportCollectionRequest_Source {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (m *ExportCollectionRequest) GetCollectionId() string {
+	if x, ok := m.GetSource().(*ExportCollectionRequest_CollectionId); ok {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (m *ExportCollectionRequest) GetQuery() *QueryBooksRequest {
+	if x, ok := m.GetSource().(*ExportCollectionRequest_Query); ok {
+		return x.Query
+	}
+	return nil
+}
+
+func (m *ExportCollectionRequest) GetFormat() ExportFormat {
+	if m != nil {
+		return m.Format
+	}
+	return ExportFormat_CSV
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*ExportCollectionRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _ExportCollectionRequest_OneofMarshaler, _ExportCollectionRequest_OneofUnmarshaler, _ExportCollectionRequest_OneofSizer, []interface{}{
+		(*ExportCollectionRequest_CollectionId)(nil),
+		(*ExportCollectionRequest_Query)(nil),
+	}
+}
+
+func _ExportCollectionRequest_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*ExportCollectionRequest)
+	// source
+	switch x := m.Source.(type) {
+	case *ExportCollectionRequest_CollectionId:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.CollectionId)
+	case *ExportCollectionRequest_Query:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Query); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("ExportCollectionRequest.Source has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _ExportCollectionRequest_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*ExportCollectionRequest)
+	switch tag {
+	case 4: // source.collection_id
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Source = &ExportCollectionRequest_CollectionId{x}
+		return true, err
+	case 2: // source.query
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(QueryBooksRequest)
+		err := b.DecodeMessage(msg)
+		m.Source = &ExportCollectionRequest_Query{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _ExportCollectionRequest_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*ExportCollectionRequest)
+	// source
+	switch x := m.Source.(type) {
+	case *ExportCollectionRequest_CollectionId:
+		n += proto.SizeVarint(4<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(len(x.CollectionId)))
+		n += len(x.CollectionId)
+	case *ExportCollectionRequest_Query:
+		s := proto.Size(x.Query)
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+// ExportChunk is a part of an exported file.
+type ExportChunk struct {
+	// ContentType is the media type of the file.
+	// It is only set in the first chunk.
+	ContentType string `protobuf:"bytes,1,opt,name=content_type,json=contentType" json:"content_type,omitempty"`
+	// Filename is a suggested name for the file.
+	// It is only set in the first chunk.
+	Filename string `protobuf:"bytes,2,opt,name=filename" json:"filename,omitempty"`
+	// Data is the next part of the file.
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *ExportChunk) Reset()                    { *m = ExportChunk{} }
+func (m *ExportChunk) String() string            { return proto.CompactTextString(m) }
+func (*ExportChunk) ProtoMessage()               {}
+func (*ExportChunk) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
+
+func (m *ExportChunk) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *ExportChunk) GetFilename() string {
+	if m != nil {
+		return m.Filename
+	}
+	return ""
+}
+
+func (m *ExportChunk) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// WatchBooksRequest is the input to the WatchBooks method.
+type WatchBooksRequest struct {
+	// Filter selects the Books to watch, using
+	// the same syntax as the filter of ListBooks.
+	Filter string `protobuf:"bytes,1,opt,name=filter" json:"filter,omitempty"`
+	// ResumeToken is the resume token of the last event received,
+	// to resume a watch after reconnecting. The filter must be
+	// the same as in the watch that sent the event.
+	ResumeToken string `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken" json:"resume_token,omitempty"`
+}
+
+func (m *WatchBooksRequest) Reset()                    { *m = WatchBooksRequest{} }
+func (m *WatchBooksRequest) String() string            { return proto.CompactTextString(m) }
+func (*WatchBooksRequest) ProtoMessage()               {}
+func (*WatchBooksRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
+
+func (m *WatchBooksRequest) GetFilter() string {
+	if m != nil {
+		return m.Filter
+	}
+	return ""
+}
+
+func (m *WatchBooksRequest) GetResumeToken() string {
+	if m != nil {
+		return m.ResumeToken
+	}
+	return ""
+}
+
+// BookEvent is a change to the Books in the library.
+type BookEvent struct {
+	// Type is the kind of change made.
+	Type BookEvent_Type `protobuf:"varint,1,opt,name=type,enum=library.BookEvent_Type" json:"type,omitempty"`
+	// Book is the Book after the change,
+	// or before it, if it was deleted.
+	Book *Book `protobuf:"bytes,2,opt,name=book" json:"book,omitempty"`
+	// Revision identifies the change.
+	// Revisions increase with each change to the library.
+	Revision int64 `protobuf:"varint,3,opt,name=revision" json:"revision,omitempty"`
+	// ResumeToken can be used to resume the watch after this event.
+	ResumeToken string `protobuf:"bytes,4,opt,name=resume_token,json=resumeToken" json:"resume_token,omitempty"`
+}
+
+func (m *BookEvent) Reset()                    { *m = BookEvent{} }
+func (m *BookEvent) String() string            { return proto.CompactTextString(m) }
+func (*BookEvent) ProtoMessage()               {}
+func (*BookEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
+
+func (m *BookEvent) GetType() BookEvent_Type {
+	if m != nil {
+		return m.Type
+	}
+	return BookEvent_BOOKMARK
+}
+
+func (m *BookEvent) GetBook() *Book {
+	if m != nil {
+		return m.Book
+	}
+	return nil
+}
+
+func (m *BookEvent) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *BookEvent) GetResumeToken() string {
+	if m != nil {
+		return m.ResumeToken
+	}
+	return ""
+}
+
+// Loan is a copy of a Book lent to a member of the library.
+type Loan struct {
+	// Id identifies the loan. It is set by the server.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// Isbn is the ISBN-13 of the book lent.
+	Isbn string `protobuf:"bytes,2,opt,name=isbn" json:"isbn,omitempty"`
+	// Member is the ID of the member borrowing the book.
+	Member string `protobuf:"bytes,3,opt,name=member" json:"member,omitempty"`
+	// CheckoutTime is when the book was checked out.
+	CheckoutTime *google_protobuf1.Timestamp `protobuf:"bytes,4,opt,name=checkout_time,json=checkoutTime" json:"checkout_time,omitempty"`
+	// DueTime is when the book must be returned.
+	DueTime *google_protobuf1.Timestamp `protobuf:"bytes,5,opt,name=due_time,json=dueTime" json:"due_time,omitempty"`
+	// Renewals is the number of times the loan has been renewed.
+	Renewals int32 `protobuf:"varint,6,opt,name=renewals" json:"renewals,omitempty"`
+	// ReturnTime is when the book was returned.
+	// It is only set on returned loans.
+	ReturnTime *google_protobuf1.Timestamp `protobuf:"bytes,7,opt,name=return_time,json=returnTime" json:"return_time,omitempty"`
+	// Barcode is the barcode of the copy lent, if it
+	// was checked out by barcode.
+	Barcode string `protobuf:"bytes,8,opt,name=barcode" json:"barcode,omitempty"`
+}
+
+func (m *Loan) Reset()                    { *m = Loan{} }
+func (m *Loan) String() string            { return proto.CompactTextString(m) }
+func (*Loan) ProtoMessage()               {}
+func (*Loan) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
+
+func (m *Loan) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Loan) GetIsbn() string {
+	if m != nil {
+		return m.Isbn
+	}
+	return ""
+}
+
+func (m *Loan) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+func (m *Loan) GetCheckoutTime() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.CheckoutTime
+	}
+	return nil
+}
+
+func (m *Loan) GetDueTime() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.DueTime
+	}
+	return nil
+}
+
+func (m *Loan) GetRenewals() int32 {
+	if m != nil {
+		return m.Renewals
+	}
+	return 0
+}
+
+func (m *Loan) GetReturnTime() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.ReturnTime
+	}
+	return nil
+}
+
+func (m *Loan) GetBarcode() string {
+	if m != nil {
+		return m.Barcode
+	}
+	return ""
+}
+
+// CheckoutRequest is the input to the Checkout method.
+type CheckoutRequest struct {
+	// Isbn is the ISBN-10 or ISBN-13, optionally with hyphens,
+	// of the book to check out.
+	Isbn string `protobuf:"bytes,1,opt,name=isbn" json:"isbn,omitempty"`
+	// Member is the ID of the member borrowing the book.
+	Member string `protobuf:"bytes,2,opt,name=member" json:"member,omitempty"`
+	// Barcode is the barcode of the copy to check out, if set.
+	// The ISBN may then be omitted.
+	Barcode string `protobuf:"bytes,3,opt,name=barcode" json:"barcode,omitempty"`
+}
+
+func (m *CheckoutRequest) Reset()                    { *m = CheckoutRequest{} }
+func (m *CheckoutRequest) String() string            { return proto.CompactTextString(m) }
+func (*CheckoutRequest) ProtoMessage()               {}
+func (*CheckoutRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
+
+func (m *CheckoutRequest) GetIsbn() string {
+	if m != nil {
+		return m.Isbn
+	}
+	return ""
+}
+
+func (m *CheckoutRequest) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+func (m *CheckoutRequest) GetBarcode() string {
+	if m != nil {
+		return m.Barcode
+	}
+	return ""
+}
+
+// ReturnRequest is the input to the Return method.
+type ReturnRequest struct {
+	// Id is the ID of the loan to return.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// BranchId is the ID of the branch the copy was returned to,
+	// if the loan was checked out by barcode. It defaults to the
+	// branch the copy was lent from.
+	BranchId string `protobuf:"bytes,2,opt,name=branch_id,json=branchId" json:"branch_id,omitempty"`
+}
+
+func (m *ReturnRequest) Reset()                    { *m = ReturnRequest{} }
+func (m *ReturnRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReturnRequest) ProtoMessage()               {}
+func (*ReturnRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
+
+func (m *ReturnRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ReturnRequest) GetBranchId() string {
+	if m != nil {
+		return m.BranchId
+	}
+	return ""
+}
+
+// RenewRequest is the input to the Renew method.
+type RenewRequest struct {
+	// Id is the ID of the loan to renew.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *RenewRequest) Reset()                    { *m = RenewRequest{} }
+func (m *RenewRequest) String() string            { return proto.CompactTextString(m) }
+func (*RenewRequest) ProtoMessage()               {}
+func (*RenewRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
+
+func (m *RenewRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// ListLoansRequest is the input to the ListLoans method.
+type ListLoansRequest struct {
+	// Member only lists the loans of the member with this ID, if set.
+	Member string `protobuf:"bytes,1,opt,name=member" json:"member,omitempty"`
+	// Isbn is the ISBN-10 or ISBN-13, optionally with hyphens,
+	// of the book to list the loans of, if set.
+	Isbn string `protobuf:"bytes,2,opt,name=isbn" json:"isbn,omitempty"`
+	// IncludeReturned also lists loans that have been returned.
+	IncludeReturned bool `protobuf:"varint,3,opt,name=include_returned,json=includeReturned" json:"include_returned,omitempty"`
+	// PageSize is the maximum number of loans to return.
+	// It defaults to 10, and may be at most 100.
+	PageSize int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize" json:"page_size,omitempty"`
+	// PageToken is the NextPageToken of the previous response,
+	// to return the next page. The filters must be the same.
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken" json:"page_token,omitempty"`
+}
+
+func (m *ListLoansRequest) Reset()                    { *m = ListLoansRequest{} }
+func (m *ListLoansRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListLoansRequest) ProtoMessage()               {}
+func (*ListLoansRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
+
+func (m *ListLoansRequest) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+func (m *ListLoansRequest) GetIsbn() string {
+	if m != nil {
+		return m.Isbn
+	}
+	return ""
+}
+
+func (m *ListLoansRequest) GetIncludeReturned() bool {
+	if m != nil {
+		return m.IncludeReturned
+	}
+	return false
+}
+
+func (m *ListLoansRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListLoansRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+// ListLoansResponse is the output of the ListLoans method.
+type ListLoansResponse struct {
+	// Loans is a page of loans, oldest first.
+	Loans []*Loan `protobuf:"bytes,1,rep,name=loans" json:"loans,omitempty"`
+	// NextPageToken returns the next page when passed to ListLoans.
+	// It is empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken" json:"next_page_token,omitempty"`
+}
+
+func (m *ListLoansResponse) Reset()                    { *m = ListLoansResponse{} }
+func (m *ListLoansResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListLoansResponse) ProtoMessage()               {}
+func (*ListLoansResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
+
+func (m *ListLoansResponse) GetLoans() []*Loan {
+	if m != nil {
+		return m.Loans
+	}
+	return nil
+}
+
+func (m *ListLoansResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+// Hold is a member's place in the queue for a Book
+// when every copy is on loan.
+type Hold struct {
+	// Id identifies the hold. It is set by the server.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// Isbn is the ISBN-13 of the book held.
+	Isbn string `protobuf:"bytes,2,opt,name=isbn" json:"isbn,omitempty"`
+	// Member is the ID of the member waiting for the book.
+	Member string `protobuf:"bytes,3,opt,name=member" json:"member,omitempty"`
+	// CreateTime is when the hold was placed.
+	CreateTime *google_protobuf1.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime" json:"create_time,omitempty"`
+	// State is the state of the hold. It is set by the server.
+	State Hold_State `protobuf:"varint,5,opt,name=state,enum=library.Hold_State" json:"state,omitempty"`
+	// Position is the 1-based position of a waiting hold in
+	// the queue for the book, first come first served.
+	// It is 0 for holds that are not waiting.
+	Position int32 `protobuf:"varint,6,opt,name=position" json:"position,omitempty"`
+	// ReadyTime is when a copy was set aside for the hold.
+	ReadyTime *google_protobuf1.Timestamp `protobuf:"bytes,7,opt,name=ready_time,json=readyTime" json:"ready_time,omitempty"`
+	// ExpireTime is when the copy set aside for a ready hold
+	// is offered to the next member in the queue.
+	ExpireTime *google_protobuf1.Timestamp `protobuf:"bytes,8,opt,name=expire_time,json=expireTime" json:"expire_time,omitempty"`
+	// Barcode is the barcode of the copy set aside for a ready hold.
+	// It is empty if a copy without a barcode was set aside.
+	// It is set by the server.
+	Barcode string `protobuf:"bytes,9,opt,name=barcode" json:"barcode,omitempty"`
+}
+
+func (m *Hold) Reset()                    { *m = Hold{} }
+func (m *Hold) String() string            { return proto.CompactTextString(m) }
+func (*Hold) ProtoMessage()               {}
+func (*Hold) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
+
+func (m *Hold) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Hold) GetIsbn() string {
+	if m != nil {
+		return m.Isbn
+	}
+	return ""
+}
+
+func (m *Hold) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+func (m *Hold) GetCreateTime() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+func (m *Hold) GetState() Hold_State {
+	if m != nil {
+		return m.State
+	}
+	return Hold_WAITING
+}
+
+func (m *Hold) GetPosition() int32 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+func (m *Hold) GetReadyTime() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.ReadyTime
+	}
+	return nil
+}
+
+func (m *Hold) GetExpireTime() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
+func (m *Hold) GetBarcode() string {
+	if m != nil {
+		return m.Barcode
+	}
+	return ""
+}
+
+// PlaceHoldRequest is the input to the PlaceHold method.
+type PlaceHoldRequest struct {
+	// Isbn is the ISBN-10 or ISBN-13, optionally with hyphens,
+	// of the book to place a hold on.
+	Isbn string `protobuf:"bytes,1,opt,name=isbn" json:"isbn,omitempty"`
+	// Member is the ID of the member placing the hold.
+	Member string `protobuf:"bytes,2,opt,name=member" json:"member,omitempty"`
+}
+
+func (m *PlaceHoldRequest) Reset()                    { *m = PlaceHoldRequest{} }
+func (m *PlaceHoldRequest) String() string            { return proto.CompactTextString(m) }
+func (*PlaceHoldRequest) ProtoMessage()               {}
+func (*PlaceHoldRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{70} }
+
+func (m *PlaceHoldRequest) GetIsbn() string {
+	if m != nil {
+		return m.Isbn
+	}
+	return ""
+}
+
+func (m *PlaceHoldRequest) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+// CancelHoldRequest is the input to the CancelHold method.
+type CancelHoldRequest struct {
+	// Id is the ID of the hold to cancel.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *CancelHoldRequest) Reset()                    { *m = CancelHoldRequest{} }
+func (m *CancelHoldRequest) String() string            { return proto.CompactTextString(m) }
+func (*CancelHoldRequest) ProtoMessage()               {}
+func (*CancelHoldRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71} }
+
+func (m *CancelHoldRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// ListHoldsRequest is the input to the ListHolds method.
+type ListHoldsRequest struct {
+	// Member only lists the holds of the member with this ID, if set.
+	Member string `protobuf:"bytes,1,opt,name=member" json:"member,omitempty"`
+	// Isbn is the ISBN-10 or ISBN-13, optionally with hyphens,
+	// of the book to list the holds of, if set.
+	Isbn string `protobuf:"bytes,2,opt,name=isbn" json:"isbn,omitempty"`
+	// IncludeClosed also lists holds that have been
+	// fulfilled, cancelled or have expired.
+	IncludeClosed bool `protobuf:"varint,3,opt,name=include_closed,json=includeClosed" json:"include_closed,omitempty"`
+	// PageSize is the maximum number of holds to return.
+	// It defaults to 10, and may be at most 100.
+	PageSize int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize" json:"page_size,omitempty"`
+	// PageToken is the NextPageToken of the previous response,
+	// to return the next page. The filters must be the same.
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken" json:"page_token,omitempty"`
+}
+
+func (m *ListHoldsRequest) Reset()                    { *m = ListHoldsRequest{} }
+func (m *ListHoldsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListHoldsRequest) ProtoMessage()               {}
+func (*ListHoldsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
+
+func (m *ListHoldsRequest) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+func (m *ListHoldsRequest) GetIsbn() string {
+	if m != nil {
+		return m.Isbn
+	}
+	return ""
+}
+
+func (m *ListHoldsRequest) GetIncludeClosed() bool {
+	if m != nil {
+		return m.IncludeClosed
+	}
+	return false
+}
+
+func (m *ListHoldsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListHoldsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+// ListHoldsResponse is the output of the ListHolds method.
+type ListHoldsResponse struct {
+	// Holds is a page of holds, oldest first.
+	Holds []*Hold `protobuf:"bytes,1,rep,name=holds" json:"holds,omitempty"`
+	// NextPageToken returns the next page when passed to ListHolds.
+	// It is empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken" json:"next_page_token,omitempty"`
+}
+
+func (m *ListHoldsResponse) Reset()                    { *m = ListHoldsResponse{} }
+func (m *ListHoldsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListHoldsResponse) ProtoMessage()               {}
+func (*ListHoldsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
+
+func (m *ListHoldsResponse) GetHolds() []*Hold {
+	if m != nil {
+		return m.Holds
+	}
+	return nil
+}
+
+func (m *ListHoldsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+// WatchHoldsRequest is the input to the WatchHolds method.
+type WatchHoldsRequest struct {
+	// Member is the ID of the member whose holds to watch.
+	Member string `protobuf:"bytes,1,opt,name=member" json:"member,omitempty"`
+}
+
+func (m *WatchHoldsRequest) Reset()                    { *m = WatchHoldsRequest{} }
+func (m *WatchHoldsRequest) String() string            { return proto.CompactTextString(m) }
+func (*WatchHoldsRequest) ProtoMessage()               {}
+func (*WatchHoldsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
+
+func (m *WatchHoldsRequest) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+// Branch is a branch of the library, where copies of Books are kept.
+type Branch struct {
+	// Id identifies the branch. It is set by the server.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// Name is the name of the branch.
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// Address is the postal address of the branch.
+	Address string `protobuf:"bytes,3,opt,name=address" json:"address,omitempty"`
+}
+
+func (m *Branch) Reset()                    { *m = Branch{} }
+func (m *Branch) String() string            { return proto.CompactTextString(m) }
+func (*Branch) ProtoMessage()               {}
+func (*Branch) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{75} }
+
+func (m *Branch) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Branch) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Branch) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// Copy is a physical copy of a Book, kept at a Branch.
+type Copy struct {
+	// Barcode identifies the copy. It is printed on a label
+	// on the copy, and may only contain letters, digits and dashes.
+	Barcode string `protobuf:"bytes,1,opt,name=barcode" json:"barcode,omitempty"`
+	// Isbn is the ISBN-13 of the book the copy is of.
+	Isbn string `protobuf:"bytes,2,opt,name=isbn" json:"isbn,omitempty"`
+	// BranchId is the ID of the branch the copy is kept at.
+	// In transit copies are kept at the branch they were sent from.
+	BranchId string `protobuf:"bytes,3,opt,name=branch_id,json=branchId" json:"branch_id,omitempty"`
+	// State is the state of the copy. It is set by the server.
+	State Copy_State `protobuf:"varint,4,opt,name=state,enum=library.Copy_State" json:"state,omitempty"`
+	// DestinationBranchId is the ID of the branch an in transit
+	// copy is being transferred to. It is set by the server.
+	DestinationBranchId string `protobuf:"bytes,5,opt,name=destination_branch_id,json=destinationBranchId" json:"destination_branch_id,omitempty"`
+	// TransferTime is when an in transit copy
+	// was sent. It is set by the server.
+	TransferTime *google_protobuf1.Timestamp `protobuf:"bytes,6,opt,name=transfer_time,json=transferTime" json:"transfer_time,omitempty"`
+}
+
+func (m *Copy) Reset()                    { *m = Copy{} }
+func (m *Copy) String() string            { return proto.CompactTextString(m) }
+func (*Copy) ProtoMessage()               {}
+func (*Copy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{76} }
+
+func (m *Copy) GetBarcode() string {
+	if m != nil {
+		return m.Barcode
+	}
+	return ""
+}
+
+func (m *Copy) GetIsbn() string {
+	if m != nil {
+		return m.Isbn
+	}
+	return ""
+}
+
+func (m *Copy) GetBranchId() string {
+	if m != nil {
+		return m.BranchId
+	}
+	return ""
+}
+
+func (m *Copy) GetState() Copy_State {
+	if m != nil {
+		return m.State
+	}
+	return Copy_AVAILABLE
+}
+
+func (m *Copy) GetDestinationBranchId() string {
+	if m != nil {
+		return m.DestinationBranchId
+	}
+	return ""
+}
+
+func (m *Copy) GetTransferTime() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.TransferTime
+	}
+	return nil
+}
+
+// CreateBranchRequest is the input to the CreateBranch method.
+type CreateBranchRequest struct {
+	// Branch is the branch to create.
+	Branch *Branch `protobuf:"bytes,1,opt,name=branch" json:"branch,omitempty"`
+}
+
+func (m *CreateBranchRequest) Reset()                    { *m = CreateBranchRequest{} }
+func (m *CreateBranchRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateBranchRequest) ProtoMessage()               {}
+func (*CreateBranchRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
+
+func (m *CreateBranchRequest) GetBranch() *Branch {
+	if m != nil {
+		return m.Branch
+	}
+	return nil
+}
+
+// GetBranchRequest is the input to the GetBranch method.
+type GetBranchRequest struct {
+	// Id is the ID of the branch to return.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *GetBranchRequest) Reset()                    { *m = GetBranchRequest{} }
+func (m *GetBranchRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetBranchRequest) ProtoMessage()               {}
+func (*GetBranchRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{78} }
+
+func (m *GetBranchRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// ListBranchesRequest is the input to the ListBranches method.
+type ListBranchesRequest struct {
+}
+
+func (m *ListBranchesRequest) Reset()                    { *m = ListBranchesRequest{} }
+func (m *ListBranchesRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListBranchesRequest) ProtoMessage()               {}
+func (*ListBranchesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{79} }
+
+// ListBranchesResponse is the output of the ListBranches method.
+type ListBranchesResponse struct {
+	// Branches are the branches of the library,
+	// in the order they were created.
+	Branches []*Branch `protobuf:"bytes,1,rep,name=branches" json:"branches,omitempty"`
+}
+
+func (m *ListBranchesResponse) Reset()                    { *m = ListBranchesResponse{} }
+func (m *ListBranchesResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListBranchesResponse) ProtoMessage()               {}
+func (*ListBranchesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{80} }
+
+func (m *ListBranchesResponse) GetBranches() []*Branch {
+	if m != nil {
+		return m.Branches
+	}
+	return nil
+}
+
+// AddCopyRequest is the input to the AddCopy method.
+type AddCopyRequest struct {
+	// Copy is the copy to add. Its barcode, ISBN and branch must be set.
+	// The ISBN may be an ISBN-10 or ISBN-13, optionally with hyphens.
+	Copy *Copy `protobuf:"bytes,1,opt,name=copy" json:"copy,omitempty"`
+}
+
+func (m *AddCopyRequest) Reset()                    { *m = AddCopyRequest{} }
+func (m *AddCopyRequest) String() string            { return proto.CompactTextString(m) }
+func (*AddCopyRequest) ProtoMessage()               {}
+func (*AddCopyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{81} }
+
+func (m *AddCopyRequest) GetCopy() *Copy {
+	if m != nil {
+		return m.Copy
+	}
+	return nil
+}
+
+// GetCopyRequest is the input to the GetCopy method.
+type GetCopyRequest struct {
+	// Barcode is the barcode of the copy to return.
+	Barcode string `protobuf:"bytes,1,opt,name=barcode" json:"barcode,omitempty"`
+}
+
+func (m *GetCopyRequest) Reset()                    { *m = GetCopyRequest{} }
+func (m *GetCopyRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetCopyRequest) ProtoMessage()               {}
+func (*GetCopyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{82} }
+
+func (m *GetCopyRequest) GetBarcode() string {
+	if m != nil {
+		return m.Barcode
+	}
+	return ""
+}
+
+// GetAvailabilityRequest is the input to the GetAvailability method.
+type GetAvailabilityRequest struct {
+	// Isbn is the ISBN-10 or ISBN-13, optionally with hyphens,
+	// of the book to return the availability of.
+	Isbn string `protobuf:"bytes,1,opt,name=isbn" json:"isbn,omitempty"`
+}
+
+func (m *GetAvailabilityRequest) Reset()                    { *m = GetAvailabilityRequest{} }
+func (m *GetAvailabilityRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetAvailabilityRequest) ProtoMessage()               {}
+func (*GetAvailabilityRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{83} }
+
+func (m *GetAvailabilityRequest) GetIsbn() string {
+	if m != nil {
+		return m.Isbn
+	}
+	return ""
+}
+
+// Availability is where the copies of a Book are.
+type Availability struct {
+	// Isbn is the ISBN-13 of the book.
+	Isbn string `protobuf:"bytes,1,opt,name=isbn" json:"isbn,omitempty"`
+	// AvailableCopies is the number of copies of the book that
+	// can be checked out, across all branches. Copies without a
+	// barcode are not tied to a branch, so it may be more than
+	// the sum of the available copies of the branches.
+	AvailableCopies int32 `protobuf:"varint,2,opt,name=available_copies,json=availableCopies" json:"available_copies,omitempty"`
+	// Branches is the availability of the book at each
+	// branch, in the order the branches were created.
+	Branches []*BranchAvailability `protobuf:"bytes,3,rep,name=branches" json:"branches,omitempty"`
+}
+
+func (m *Availability) Reset()                    { *m = Availability{} }
+func (m *Availability) String() string            { return proto.CompactTextString(m) }
+func (*Availability) ProtoMessage()               {}
+func (*Availability) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{84} }
+
+func (m *Availability) GetIsbn() string {
+	if m != nil {
+		return m.Isbn
+	}
+	return ""
+}
+
+func (m *Availability) GetAvailableCopies() int32 {
+	if m != nil {
+		return m.AvailableCopies
+	}
+	return 0
+}
+
+func (m *Availability) GetBranches() []*BranchAvailability {
+	if m != nil {
+		return m.Branches
+	}
+	return nil
+}
+
+// BranchAvailability is how many copies of a Book a Branch has.
+type BranchAvailability struct {
+	// Branch is the branch.
+	Branch *Branch `protobuf:"bytes,1,opt,name=branch" json:"branch,omitempty"`
+	// Copies is the number of copies kept at the branch.
+	Copies int32 `protobuf:"varint,2,opt,name=copies" json:"copies,omitempty"`
+	// AvailableCopies is the number of those copies that
+	// are not on loan, in transit or set aside for a hold.
+	AvailableCopies int32 `protobuf:"varint,3,opt,name=available_copies,json=availableCopies" json:"available_copies,omitempty"`
+	// IncomingCopies is the number of copies in
+	// transit to the branch from other branches.
+	IncomingCopies int32 `protobuf:"varint,4,opt,name=incoming_copies,json=incomingCopies" json:"incoming_copies,omitempty"`
+}
+
+func (m *BranchAvailability) Reset()                    { *m = BranchAvailability{} }
+func (m *BranchAvailability) String() string            { return proto.CompactTextString(m) }
+func (*BranchAvailability) ProtoMessage()               {}
+func (*BranchAvailability) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{85} }
+
+func (m *BranchAvailability) GetBranch() *Branch {
+	if m != nil {
+		return m.Branch
+	}
+	return nil
+}
+
+func (m *BranchAvailability) GetCopies() int32 {
+	if m != nil {
+		return m.Copies
+	}
+	return 0
+}
+
+func (m *BranchAvailability) GetAvailableCopies() int32 {
+	if m != nil {
+		return m.AvailableCopies
+	}
+	return 0
+}
+
+func (m *BranchAvailability) GetIncomingCopies() int32 {
+	if m != nil {
+		return m.IncomingCopies
+	}
+	return 0
+}
+
+// TransferCopyRequest is the input to the TransferCopy method.
+type TransferCopyRequest struct {
+	// Barcode is the barcode of the copy to transfer.
+	Barcode string `protobuf:"bytes,1,opt,name=barcode" json:"barcode,omitempty"`
+	// BranchId is the ID of the branch to transfer the copy to.
+	BranchId string `protobuf:"bytes,2,opt,name=branch_id,json=branchId" json:"branch_id,omitempty"`
+}
+
+func (m *TransferCopyRequest) Reset()                    { *m = TransferCopyRequest{} }
+func (m *TransferCopyRequest) String() string            { return proto.CompactTextString(m) }
+func (*TransferCopyRequest) ProtoMessage()               {}
+func (*TransferCopyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{86} }
+
+func (m *TransferCopyRequest) GetBarcode() string {
+	if m != nil {
+		return m.Barcode
+	}
+	return ""
+}
+
+func (m *TransferCopyRequest) GetBranchId() string {
+	if m != nil {
+		return m.BranchId
+	}
+	return ""
+}
+
+// ReceiveCopyRequest is the input to the ReceiveCopy method.
+type ReceiveCopyRequest struct {
+	// Barcode is the barcode of the copy received.
+	Barcode string `protobuf:"bytes,1,opt,name=barcode" json:"barcode,omitempty"`
+}
+
+func (m *ReceiveCopyRequest) Reset()                    { *m = ReceiveCopyRequest{} }
+func (m *ReceiveCopyRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReceiveCopyRequest) ProtoMessage()               {}
+func (*ReceiveCopyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{87} }
+
+func (m *ReceiveCopyRequest) GetBarcode() string {
+	if m != nil {
+		return m.Barcode
+	}
+	return ""
+}
+
+// BookMessage is used to discuss books
+type BookMessage struct {
+	// Types that are valid to be assigned to Content:
+	//	*BookMessage_Name
+	//	*BookMessage_Message
+	//	*BookMessage_MemberId
+	Content isBookMessage_Content `protobuf_oneof:"content"`
+}
+
+func (m *BookMessage) Reset()                    { *m = BookMessage{} }
+func (m *BookMessage) String() string            { return proto.CompactTextString(m) }
+func (*BookMessage) ProtoMessage()               {}
+func (*BookMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{88} }
+
+type isBookMessage_Content interface{ isBookMessage_Content() }
+
+type BookMessage_Name struct {
+	Name string `protobuf:"bytes,1,opt,name=name,oneof"`
+}
+type BookMessage_Message struct {
+	Message string `protobuf:"bytes,2,opt,name=message,oneof"`
+}
+type BookMessage_MemberId struct {
+	MemberId string `protobuf:"bytes,3,opt,name=member_id,json=memberId,oneof"`
+}
+
+func (*BookMessage_Name) isBookMessage_Content()     {}
+func (*BookMessage_Message) isBookMessage_Content()  {}
+func (*BookMessage_MemberId) isBookMessage_Content() {}
+
+func (m *BookMessage) GetContent() isBookMessage_Content {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func (m *BookMessage) GetName() string {
+	if x, ok := m.GetContent().(*BookMessage_Name); ok {
+		return x.Name
+	}
+	return ""
+}
+
+func (m *BookMessage) GetMessage() string {
+	if x, ok := m.GetContent().(*BookMessage_Message); ok {
+		return x.Message
+	}
+	return ""
+}
+
+func (m *BookMessage) GetMemberId() string {
+	if x, ok := m.GetContent().(*BookMessage_MemberId); ok {
+		return x.MemberId
+	}
+	return ""
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*BookMessage) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _BookMessage_OneofMarshaler, _BookMessage_OneofUnmarshaler, _BookMessage_OneofSizer, []interface{}{
+		(*BookMessage_Name)(nil),
+		(*BookMessage_Message)(nil),
+		(*BookMessage_MemberId)(nil),
+	}
+}
+
+func _BookMessage_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*BookMessage)
+	// content
+	switch x := m.Content.(type) {
+	case *BookMessage_Name:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.Name)
+	case *BookMessage_Message:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.Message)
+	case *BookMessage_MemberId:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.MemberId)
+	case nil:
+	default:
+		return fmt.Errorf("BookMessage.Content has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _BookMessage_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*BookMessage)
+	switch tag {
+	case 1: // content.name
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Content = &BookMessage_Name{x}
+		return true, err
+	case 2: // content.message
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Content = &BookMessage_Message{x}
+		return true, err
+	case 3: // content.member_id
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Content = &BookMessage_MemberId{x}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _BookMessage_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*BookMessage)
+	// content
+	switch x := m.Content.(type) {
+	case *BookMessage_Name:
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(len(x.Name)))
+		n += len(x.Name)
+	case *BookMessage_Message:
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(len(x.Message)))
+		n += len(x.Message)
+	case *BookMessage_MemberId:
+		n += proto.SizeVarint(3<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(len(x.MemberId)))
+		n += len(x.MemberId)
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+// BookResponse is used to discuss books
+type BookResponse struct {
+	// Message is a message from a user.
+	Message string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
+}
+
+func (m *BookResponse) Reset()                    { *m = BookResponse{} }
+func (m *BookResponse) String() string            { return proto.CompactTextString(m) }
+func (*BookResponse) ProtoMessage()               {}
+func (*BookResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{89} }
+
+func (m *BookResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// Review is a member's review of a Book.
+type Review struct {
+	// Id identifies the review. It is set by the server.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// Isbn is the ISBN-13 of the book reviewed. An ISBN-10 or ISBN-13,
+	// optionally with hyphens, is accepted when creating a review.
+	Isbn string `protobuf:"bytes,2,opt,name=isbn" json:"isbn,omitempty"`
+	// Member is the ID of the member who wrote the review.
+	Member string `protobuf:"bytes,3,opt,name=member" json:"member,omitempty"`
+	// Rating is the rating of the book, from 1 to 5 stars.
+	Rating int32 `protobuf:"varint,4,opt,name=rating" json:"rating,omitempty"`
+	// Text is the text of the review.
+	Text string `protobuf:"bytes,5,opt,name=text" json:"text,omitempty"`
+	// CreateTime is when the review was written.
+	CreateTime *google_protobuf1.Timestamp `protobuf:"bytes,6,opt,name=create_time,json=createTime" json:"create_time,omitempty"`
+}
+
+func (m *Review) Reset()                    { *m = Review{} }
+func (m *Review) String() string            { return proto.CompactTextString(m) }
+func (*Review) ProtoMessage()               {}
+func (*Review) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{90} }
+
+func (m *Review) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Review) GetIsbn() string {
+	if m != nil {
+		return m.Isbn
+	}
+	return ""
+}
+
+func (m *Review) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+func (m *Review) GetRating() int32 {
+	if m != nil {
+		return m.Rating
+	}
+	return 0
+}
+
+func (m *Review) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *Review) GetCreateTime() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+// CreateReviewRequest is the input to the CreateReview method.
+type CreateReviewRequest struct {
+	// Review is the review to create.
+	Review *Review `protobuf:"bytes,1,opt,name=review" json:"review,omitempty"`
+}
+
+func (m *CreateReviewRequest) Reset()                    { *m = CreateReviewRequest{} }
+func (m *CreateReviewRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateReviewRequest) ProtoMessage()               {}
+func (*CreateReviewRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{91} }
+
+func (m *CreateReviewRequest) GetReview() *Review {
+	if m != nil {
+		return m.Review
+	}
+	return nil
+}
+
+// ListReviewsRequest is the input to the ListReviews method.
+type ListReviewsRequest struct {
+	// Isbn is the ISBN-10 or ISBN-13, optionally with hyphens,
+	// of the book to list the reviews of, if set.
+	Isbn string `protobuf:"bytes,1,opt,name=isbn" json:"isbn,omitempty"`
+	// Member only lists the reviews of the member with this ID, if set.
+	Member string `protobuf:"bytes,2,opt,name=member" json:"member,omitempty"`
+	// PageSize is the maximum number of reviews to return.
+	// It defaults to 10, and may be at most 100.
+	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize" json:"page_size,omitempty"`
+	// PageToken is the NextPageToken of the previous response,
+	// to return the next page. The filters must be the same.
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken" json:"page_token,omitempty"`
+}
+
+func (m *ListReviewsRequest) Reset()                    { *m = ListReviewsRequest{} }
+func (m *ListReviewsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListReviewsRequest) ProtoMessage()               {}
+func (*ListReviewsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{92} }
+
+func (m *ListReviewsRequest) GetIsbn() string {
+	if m != nil {
+		return m.Isbn
+	}
+	return ""
+}
+
+func (m *ListReviewsRequest) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+func (m *ListReviewsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListReviewsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+// ListReviewsResponse is the output of the ListReviews method.
+type ListReviewsResponse struct {
+	// Reviews is a page of reviews, newest first.
+	Reviews []*Review `protobuf:"bytes,1,rep,name=reviews" json:"reviews,omitempty"`
+	// NextPageToken returns the next page when passed to ListReviews.
+	// It is empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken" json:"next_page_token,omitempty"`
+}
+
+func (m *ListReviewsResponse) Reset()                    { *m = ListReviewsResponse{} }
+func (m *ListReviewsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListReviewsResponse) ProtoMessage()               {}
+func (*ListReviewsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{93} }
+
+func (m *ListReviewsResponse) GetReviews() []*Review {
+	if m != nil {
+		return m.Reviews
+	}
+	return nil
+}
+
+func (m *ListReviewsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+// DeleteReviewRequest is the input to the DeleteReview method.
+type DeleteReviewRequest struct {
+	// Id is the ID of the review to delete.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// Member is the ID of the member deleting the review,
+	// who must be the member who wrote it.
+	Member string `protobuf:"bytes,2,opt,name=member" json:"member,omitempty"`
+}
+
+func (m *DeleteReviewRequest) Reset()                    { *m = DeleteReviewRequest{} }
+func (m *DeleteReviewRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteReviewRequest) ProtoMessage()               {}
+func (*DeleteReviewRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{94} }
+
+func (m *DeleteReviewRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DeleteReviewRequest) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+// Member is a member of the library.
+type Member struct {
+	// Id identifies the member. It is set by the server.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// CardNumber is the number on the library card of the member.
+	// It is set by the server.
+	CardNumber string `protobuf:"bytes,2,opt,name=card_number,json=cardNumber" json:"card_number,omitempty"`
+	// Name is the name of the member.
+	Name string `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
+	// Email is the email address of the member.
+	Email string `protobuf:"bytes,4,opt,name=email" json:"email,omitempty"`
+	// Phone is the phone number of the member.
+	Phone string `protobuf:"bytes,5,opt,name=phone" json:"phone,omitempty"`
+	// BorrowingLimit is the number of books the member
+	// may have on loan at once. It defaults to 5.
+	BorrowingLimit int32 `protobuf:"varint,6,opt,name=borrowing_limit,json=borrowingLimit" json:"borrowing_limit,omitempty"`
+	// State is the state of the membership. It is set by the server.
+	State Member_State `protobuf:"varint,7,opt,name=state,enum=library.Member_State" json:"state,omitempty"`
+	// SuspensionReason is why a suspended member was suspended.
+	SuspensionReason string `protobuf:"bytes,8,opt,name=suspension_reason,json=suspensionReason" json:"suspension_reason,omitempty"`
+	// CreateTime is when the member registered.
+	CreateTime *google_protobuf1.Timestamp `protobuf:"bytes,9,opt,name=create_time,json=createTime" json:"create_time,omitempty"`
+}
+
+func (m *Member) Reset()                    { *m = Member{} }
+func (m *Member) String() string            { return proto.CompactTextString(m) }
+func (*Member) ProtoMessage()               {}
+func (*Member) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{95} }
+
+func (m *Member) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Member) GetCardNumber() string {
+	if m != nil {
+		return m.CardNumber
+	}
+	return ""
+}
+
+func (m *Member) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Member) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *Member) GetPhone() string {
+	if m != nil {
+		return m.Phone
+	}
+	return ""
+}
+
+func (m *Member) GetBorrowingLimit() int32 {
+	if m != nil {
+		return m.BorrowingLimit
+	}
+	return 0
+}
+
+func (m *Member) GetState() Member_State {
+	if m != nil {
+		return m.State
+	}
+	return Member_ACTIVE
+}
+
+func (m *Member) GetSuspensionReason() string {
+	if m != nil {
+		return m.SuspensionReason
+	}
+	return ""
+}
+
+func (m *Member) GetCreateTime() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+// RegisterMemberRequest is the input to the RegisterMember method.
+type RegisterMemberRequest struct {
+	// Member is the profile of the member to register.
+	Member *Member `protobuf:"bytes,1,opt,name=member" json:"member,omitempty"`
+}
+
+func (m *RegisterMemberRequest) Reset()                    { *m = RegisterMemberRequest{} }
+func (m *RegisterMemberRequest) String() string            { return proto.CompactTextString(m) }
+func (*RegisterMemberRequest) ProtoMessage()               {}
+func (*RegisterMemberRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{96} }
+
+func (m *RegisterMemberRequest) GetMember() *Member {
+	if m != nil {
+		return m.Member
+	}
+	return nil
+}
+
+// GetMemberRequest is the input to the GetMember method.
+// Either the ID or the card number must be set.
+type GetMemberRequest struct {
+	// Id is the ID of the member to return.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// CardNumber is the card number of the member to return.
+	CardNumber string `protobuf:"bytes,2,opt,name=card_number,json=cardNumber" json:"card_number,omitempty"`
+}
+
+func (m *GetMemberRequest) Reset()                    { *m = GetMemberRequest{} }
+func (m *GetMemberRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetMemberRequest) ProtoMessage()               {}
+func (*GetMemberRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{97} }
+
+func (m *GetMemberRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *GetMemberRequest) GetCardNumber() string {
+	if m != nil {
+		return m.CardNumber
+	}
+	return ""
+}
+
+// UpdateMemberRequest is the input to the UpdateMember method.
+type UpdateMemberRequest struct {
+	// Member is the member to update, identified by its ID.
+	Member *Member `protobuf:"bytes,1,opt,name=member" json:"member,omitempty"`
+	// UpdateMask selects the fields of the member to update,
+	// which may be name, email, phone and borrowing_limit.
+	// If empty, all of these fields are updated.
+	UpdateMask *google_protobuf.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask" json:"update_mask,omitempty"`
+}
+
+func (m *UpdateMemberRequest) Reset()                    { *m = UpdateMemberRequest{} }
+func (m *UpdateMemberRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateMemberRequest) ProtoMessage()               {}
+func (*UpdateMemberRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{98} }
+
+func (m *UpdateMemberRequest) GetMember() *Member {
+	if m != nil {
+		return m.Member
+	}
+	return nil
+}
+
+func (m *UpdateMemberRequest) GetUpdateMask() *google_protobuf.FieldMask {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
+}
+
+// SuspendMemberRequest is the input to the SuspendMember method.
+type SuspendMemberRequest struct {
+	// Id is the ID of the member to suspend.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// Reason is why the member is suspended.
+	Reason string `protobuf:"bytes,2,opt,name=reason" json:"reason,omitempty"`
+}
+
+func (m *SuspendMemberRequest) Reset()                    { *m = SuspendMemberRequest{} }
+func (m *SuspendMemberRequest) String() string            { return proto.CompactTextString(m) }
+func (*SuspendMemberRequest) ProtoMessage()               {}
+func (*SuspendMemberRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{99} }
+
+func (m *SuspendMemberRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *SuspendMemberRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// ReinstateMemberRequest is the input to the ReinstateMember method.
+type ReinstateMemberRequest struct {
+	// Id is the ID of the member to reinstate.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *ReinstateMemberRequest) Reset()                    { *m = ReinstateMemberRequest{} }
+func (m *ReinstateMemberRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReinstateMemberRequest) ProtoMessage()               {}
+func (*ReinstateMemberRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{100} }
+
+func (m *ReinstateMemberRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// DeleteMemberRequest is the input to the DeleteMember method.
+type DeleteMemberRequest struct {
+	// Id is the ID of the member to delete.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *DeleteMemberRequest) Reset()                    { *m = DeleteMemberRequest{} }
+func (m *DeleteMemberRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteMemberRequest) ProtoMessage()               {}
+func (*DeleteMemberRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{101} }
+
+func (m *DeleteMemberRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Publisher)(nil), "library.Publisher")
+	proto.RegisterType((*Author)(nil), "library.Author")
+	proto.RegisterType((*Work)(nil), "library.Work")
+	proto.RegisterType((*Series)(nil), "library.Series")
+	proto.RegisterType((*Subject)(nil), "library.Subject")
+	proto.RegisterType((*TagCount)(nil), "library.TagCount")
+	proto.RegisterType((*Localization)(nil), "library.Localization")
+	proto.RegisterType((*Book)(nil), "library.Book")
+	proto.RegisterType((*GetBookRequest)(nil), "library.GetBookRequest")
+	proto.RegisterType((*QueryBooksRequest)(nil), "library.QueryBooksRequest")
+	proto.RegisterType((*ListBooksRequest)(nil), "library.ListBooksRequest")
+	proto.RegisterType((*ListBooksResponse)(nil), "library.ListBooksResponse")
+	proto.RegisterType((*SearchBooksRequest)(nil), "library.SearchBooksRequest")
+	proto.RegisterType((*SearchBooksResponse)(nil), "library.SearchBooksResponse")
+	proto.RegisterType((*SearchResult)(nil), "library.SearchResult")
+	proto.RegisterType((*Highlight)(nil), "library.Highlight")
+	proto.RegisterType((*TextRange)(nil), "library.TextRange")
+	proto.RegisterType((*RecommendBooksRequest)(nil), "library.RecommendBooksRequest")
+	proto.RegisterType((*RecommendBooksResponse)(nil), "library.RecommendBooksResponse")
+	proto.RegisterType((*Recommendation)(nil), "library.Recommendation")
+	proto.RegisterType((*CreateBookRequest)(nil), "library.CreateBookRequest")
+	proto.RegisterType((*UpdateBookRequest)(nil), "library.UpdateBookRequest")
+	proto.RegisterType((*CreateAuthorRequest)(nil), "library.CreateAuthorRequest")
+	proto.RegisterType((*GetAuthorRequest)(nil), "library.GetAuthorRequest")
+	proto.RegisterType((*UpdateAuthorRequest)(nil), "library.UpdateAuthorRequest")
+	proto.RegisterType((*UploadCoverRequest)(nil), "library.UploadCoverRequest")
+	proto.RegisterType((*CreateWorkRequest)(nil), "library.CreateWorkRequest")
+	proto.RegisterType((*GetWorkRequest)(nil), "library.GetWorkRequest")
+	proto.RegisterType((*UpdateWorkRequest)(nil), "library.UpdateWorkRequest")
+	proto.RegisterType((*ListEditionsRequest)(nil), "library.ListEditionsRequest")
+	proto.RegisterType((*ListEditionsResponse)(nil), "library.ListEditionsResponse")
+	proto.RegisterType((*CreateSeriesRequest)(nil), "library.CreateSeriesRequest")
+	proto.RegisterType((*GetSeriesRequest)(nil), "library.GetSeriesRequest")
+	proto.RegisterType((*ListSeriesWorksRequest)(nil), "library.ListSeriesWorksRequest")
+	proto.RegisterType((*ListSeriesWorksResponse)(nil), "library.ListSeriesWorksResponse")
+	proto.RegisterType((*CreateSubjectRequest)(nil), "library.CreateSubjectRequest")
+	proto.RegisterType((*GetSubjectRequest)(nil), "library.GetSubjectRequest")
+	proto.RegisterType((*UpdateSubjectRequest)(nil), "library.UpdateSubjectRequest")
+	proto.RegisterType((*DeleteSubjectRequest)(nil), "library.DeleteSubjectRequest")
+	proto.RegisterType((*BrowseSubjectsRequest)(nil), "library.BrowseSubjectsRequest")
+	proto.RegisterType((*BrowseSubjectsResponse)(nil), "library.BrowseSubjectsResponse")
+	proto.RegisterType((*SubjectCount)(nil), "library.SubjectCount")
+	proto.RegisterType((*TagBookRequest)(nil), "library.TagBookRequest")
+	proto.RegisterType((*UntagBookRequest)(nil), "library.UntagBookRequest")
+	proto.RegisterType((*ListTagsRequest)(nil), "library.ListTagsRequest")
+	proto.RegisterType((*ListTagsResponse)(nil), "library.ListTagsResponse")
+	proto.RegisterType((*ListAuthorBooksRequest)(nil), "library.ListAuthorBooksRequest")
+	proto.RegisterType((*ListAuthorBooksResponse)(nil), "library.ListAuthorBooksResponse")
+	proto.RegisterType((*DeleteBookRequest)(nil), "library.DeleteBookRequest")
+	proto.RegisterType((*RestoreBookRequest)(nil), "library.RestoreBookRequest")
+	proto.RegisterType((*BookRevision)(nil), "library.BookRevision")
+	proto.RegisterType((*ListBookRevisionsRequest)(nil), "library.ListBookRevisionsRequest")
+	proto.RegisterType((*ListBookRevisionsResponse)(nil), "library.ListBookRevisionsResponse")
+	proto.RegisterType((*Collection)(nil), "library.Collection")
+	proto.RegisterType((*GetCollectionRequest)(nil), "library.GetCollectionRequest")
+	proto.RegisterType((*ListCollectionsRequest)(nil), "library.ListCollectionsRequest")
+	proto.RegisterType((*ListCollectionsResponse)(nil), "library.ListCollectionsResponse")
+	proto.RegisterType((*UpdateCollectionRequest)(nil), "library.UpdateCollectionRequest")
+	proto.RegisterType((*DeleteCollectionRequest)(nil), "library.DeleteCollectionRequest")
+	proto.RegisterType((*ExportCollectionRequest)(nil), "library.ExportCollectionRequest")
+	proto.RegisterType((*ExportChunk)(nil), "library.ExportChunk")
+	proto.RegisterType((*WatchBooksRequest)(nil), "library.WatchBooksRequest")
+	proto.RegisterType((*BookEvent)(nil), "library.BookEvent")
+	proto.RegisterType((*Loan)(nil), "library.Loan")
+	proto.RegisterType((*CheckoutRequest)(nil), "library.CheckoutRequest")
+	proto.RegisterType((*ReturnRequest)(nil), "library.ReturnRequest")
+	proto.RegisterType((*RenewRequest)(nil), "library.RenewRequest")
+	proto.RegisterType((*ListLoansRequest)(nil), "library.ListLoansRequest")
+	proto.RegisterType((*ListLoansResponse)(nil), "library.ListLoansResponse")
+	proto.RegisterType((*Hold)(nil), "library.Hold")
+	proto.RegisterType((*PlaceHoldRequest)(nil), "library.PlaceHoldRequest")
+	proto.RegisterType((*CancelHoldRequest)(nil), "library.CancelHoldRequest")
+	proto.RegisterType((*ListHoldsRequest)(nil), "library.ListHoldsRequest")
+	proto.RegisterType((*ListHoldsResponse)(nil), "library.ListHoldsResponse")
+	proto.RegisterType((*WatchHoldsRequest)(nil), "library.WatchHoldsRequest")
+	proto.RegisterType((*Branch)(nil), "library.Branch")
+	proto.RegisterType((*Copy)(nil), "library.Copy")
+	proto.RegisterType((*CreateBranchRequest)(nil), "library.CreateBranchRequest")
+	proto.RegisterType((*GetBranchRequest)(nil), "library.GetBranchRequest")
+	proto.RegisterType((*ListBranchesRequest)(nil), "library.ListBranchesRequest")
+	proto.RegisterType((*ListBranchesResponse)(nil), "library.ListBranchesResponse")
+	proto.RegisterType((*AddCopyRequest)(nil), "library.AddCopyRequest")
+	proto.RegisterType((*GetCopyRequest)(nil), "library.GetCopyRequest")
+	proto.RegisterType((*GetAvailabilityRequest)(nil), "library.GetAvailabilityRequest")
+	proto.RegisterType((*Availability)(nil), "library.Availability")
+	proto.RegisterType((*BranchAvailability)(nil), "library.BranchAvailability")
+	proto.RegisterType((*TransferCopyRequest)(nil), "library.TransferCopyRequest")
+	proto.RegisterType((*ReceiveCopyRequest)(nil), "library.ReceiveCopyRequest")
+	proto.RegisterType((*BookMessage)(nil), "library.BookMessage")
+	proto.RegisterType((*BookResponse)(nil), "library.BookResponse")
+	proto.RegisterType((*Review)(nil), "library.Review")
+	proto.RegisterType((*CreateReviewRequest)(nil), "library.CreateReviewRequest")
+	proto.RegisterType((*ListReviewsRequest)(nil), "library.ListReviewsRequest")
+	proto.RegisterType((*ListReviewsResponse)(nil), "library.ListReviewsResponse")
+	proto.RegisterType((*DeleteReviewRequest)(nil), "library.DeleteReviewRequest")
+	proto.RegisterType((*Member)(nil), "library.Member")
+	proto.RegisterType((*RegisterMemberRequest)(nil), "library.RegisterMemberRequest")
+	proto.RegisterType((*GetMemberRequest)(nil), "library.GetMemberRequest")
+	proto.RegisterType((*UpdateMemberRequest)(nil), "library.UpdateMemberRequest")
+	proto.RegisterType((*SuspendMemberRequest)(nil), "library.SuspendMemberRequest")
+	proto.RegisterType((*ReinstateMemberRequest)(nil), "library.ReinstateMemberRequest")
+	proto.RegisterType((*DeleteMemberRequest)(nil), "library.DeleteMemberRequest")
+	proto.RegisterEnum("library.BookType", BookType_name, BookType_value)
+	proto.RegisterEnum("library.ExportFormat", ExportFormat_name, ExportFormat_value)
+	proto.RegisterEnum("library.Recommendation_Reason", Recommendation_Reason_name, Recommendation_Reason_value)
+	proto.RegisterEnum("library.BookRevision_ChangeType", BookRevision_ChangeType_name, BookRevision_ChangeType_value)
+	proto.RegisterEnum("library.BookEvent_Type", BookEvent_Type_name, BookEvent_Type_value)
+	proto.RegisterEnum("library.Hold_State", Hold_State_name, Hold_State_value)
+	proto.RegisterEnum("library.Copy_State", Copy_State_name, Copy_State_value)
+	proto.RegisterEnum("library.Member_State", Member_State_name, Member_State_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for BookService service
+
+type BookServiceClient interface {
+	// GetBook returns a Book from the library
+	// that matches the ISBN provided, if found.
+	// Otherwise it returns a NotFound error.
+	GetBook(ctx context.Context, in *GetBookRequest, opts ...grpc.CallOption) (*Book, error)
+	// QueryBooks returns all Books matching the
+	// filters provided, as a stream of Books.
+	// It returns an InvalidArgument error if the
+	// filters are invalid.
+	QueryBooks(ctx context.Context, in *QueryBooksRequest, opts ...grpc.CallOption) (BookService_QueryBooksClient, error)
+	// ListBooks returns a page of Books matching the filter
+	// provided, in the order requested. Pages are stable as
+	// Books are added and removed between calls.
+	ListBooks(ctx context.Context, in *ListBooksRequest, opts ...grpc.CallOption) (*ListBooksResponse, error)
+	// SearchBooks returns the Books whose title, author or
+	// publisher match the query provided, most relevant first.
+	// Matching ignores case and diacritics.
+	SearchBooks(ctx context.Context, in *SearchBooksRequest, opts ...grpc.CallOption) (*SearchBooksResponse, error)
+	// RecommendBooks returns Books similar to a Book, or to the Books a
+	// member has borrowed and collected, best first. Similarity is learnt from
+	// the Collections and Loans of the library. When there is too little of
+	// either, Books by the same author and of the same type are recommended.
+	// It returns a NotFound error if the Book or member does not exist.
+	RecommendBooks(ctx context.Context, in *RecommendBooksRequest, opts ...grpc.CallOption) (*RecommendBooksResponse, error)
+	// CreateBook adds a Book to the library.
+	// It returns an AlreadyExists error if a Book
+	// with the same ISBN is already in the library.
+	CreateBook(ctx context.Context, in *CreateBookRequest, opts ...grpc.CallOption) (*Book, error)
+	// UpdateBook updates the fields of a Book in the library
+	// selected by the update mask, and returns the updated Book.
+	// It returns a NotFound error if the Book does not exist,
+	// and an Aborted error if the etag does not match.
+	UpdateBook(ctx context.Context, in *UpdateBookRequest, opts ...grpc.CallOption) (*Book, error)
+	// DeleteBook removes a Book from the library
+	// and returns the removed Book. Deleted Books
+	// can be restored with RestoreBook.
+	// It returns a NotFound error if the Book does not exist,
+	// an Aborted error if the etag does not match, and a
+	// FailedPrecondition error if any copies are on loan or on hold,
+	// or translations of the Book are in the library.
+	DeleteBook(ctx context.Context, in *DeleteBookRequest, opts ...grpc.CallOption) (*Book, error)
+	// RestoreBook restores a deleted Book and returns it.
+	// It returns a NotFound error if no such deleted Book exists.
+	RestoreBook(ctx context.Context, in *RestoreBookRequest, opts ...grpc.CallOption) (*Book, error)
+	// ListBookRevisions returns the revision history of
+	// a Book, newest first, including any deletions. Changes to only the
+	// copies, available copies, average rating and review count of the
+	// Book are not revisions.
+	// It returns a NotFound error if the Book never existed.
+	ListBookRevisions(ctx context.Context, in *ListBookRevisionsRequest, opts ...grpc.CallOption) (*ListBookRevisionsResponse, error)
+	// CreateAuthor adds an Author to the library and returns it.
+	CreateAuthor(ctx context.Context, in *CreateAuthorRequest, opts ...grpc.CallOption) (*Author, error)
+	// GetAuthor returns the Author with the ID provided.
+	// It returns a NotFound error if the Author does not exist.
+	GetAuthor(ctx context.Context, in *GetAuthorRequest, opts ...grpc.CallOption) (*Author, error)
+	// UpdateAuthor updates the fields of an Author selected by the
+	// update mask, and returns the updated Author. Renaming an Author
+	// updates the author names of their Books.
+	// It returns a NotFound error if the Author does not exist.
+	UpdateAuthor(ctx context.Context, in *UpdateAuthorRequest, opts ...grpc.CallOption) (*Author, error)
+	// ListAuthorBooks returns a page of the Books of
+	// an Author, by publication date, oldest first.
+	// It returns a NotFound error if the Author does not exist.
+	ListAuthorBooks(ctx context.Context, in *ListAuthorBooksRequest, opts ...grpc.CallOption) (*ListAuthorBooksResponse, error)
+	// UploadCover takes a stream of chunks of an image, and sets it
+	// as the cover of a Book. It returns the Book with its new cover URL.
+	// It returns a NotFound error if the Book does not exist, an Aborted
+	// error if the etag does not match, and an InvalidArgument error
+	// if the image is too large or not in a supported format.
+	UploadCover(ctx context.Context, opts ...grpc.CallOption) (BookService_UploadCoverClient, error)
+	// CreateWork adds a Work to the library and returns it. Books
+	// are made editions of a Work by setting their work ID.
+	CreateWork(ctx context.Context, in *CreateWorkRequest, opts ...grpc.CallOption) (*Work, error)
+	// GetWork returns the Work with the ID provided.
+	// It returns a NotFound error if the Work does not exist.
+	GetWork(ctx context.Context, in *GetWorkRequest, opts ...grpc.CallOption) (*Work, error)
+	// UpdateWork updates the fields of a Work selected by the
+	// update mask, and returns the updated Work.
+	// It returns a NotFound error if the Work does not exist.
+	UpdateWork(ctx context.Context, in *UpdateWorkRequest, opts ...grpc.CallOption) (*Work, error)
+	// ListEditions returns the Books that are editions of a Work,
+	// such as its hardcover, paperback and audiobook.
+	// It returns a NotFound error if the Work does not exist.
+	ListEditions(ctx context.Context, in *ListEditionsRequest, opts ...grpc.CallOption) (*ListEditionsResponse, error)
+	// CreateSeries adds a Series to the library and returns it. Works
+	// are added to a Series by setting their series ID and number.
+	CreateSeries(ctx context.Context, in *CreateSeriesRequest, opts ...grpc.CallOption) (*Series, error)
+	// GetSeries returns the Series with the ID provided.
+	// It returns a NotFound error if the Series does not exist.
+	GetSeries(ctx context.Context, in *GetSeriesRequest, opts ...grpc.CallOption) (*Series, error)
+	// ListSeriesWorks returns the Works of a Series, in order.
+	// It returns a NotFound error if the Series does not exist.
+	ListSeriesWorks(ctx context.Context, in *ListSeriesWorksRequest, opts ...grpc.CallOption) (*ListSeriesWorksResponse, error)
+	// CreateSubject adds a Subject to the subject taxonomy and returns it.
+	// It returns an AlreadyExists error if a Subject with the same code exists.
+	CreateSubject(ctx context.Context, in *CreateSubjectRequest, opts ...grpc.CallOption) (*Subject, error)
+	// GetSubject returns the Subject with the code provided.
+	GetSubject(ctx context.Context, in *GetSubjectRequest, opts ...grpc.CallOption) (*Subject, error)
+	// UpdateSubject renames or moves a Subject, and returns it.
+	// A Subject can't be moved under itself or its subjects.
+	UpdateSubject(ctx context.Context, in *UpdateSubjectRequest, opts ...grpc.CallOption) (*Subject, error)
+	// DeleteSubject removes a Subject from the taxonomy and returns it.
+	// It returns a FailedPrecondition error if any Subjects are under
+	// it, or any Books have it.
+	DeleteSubject(ctx context.Context, in *DeleteSubjectRequest, opts ...grpc.CallOption) (*Subject, error)
+	// BrowseSubjects returns the Subjects under a Subject, or the top
+	// level Subjects, with the number of Books about each. The Books
+	// themselves are returned by QueryBooks with the subject code.
+	BrowseSubjects(ctx context.Context, in *BrowseSubjectsRequest, opts ...grpc.CallOption) (*BrowseSubjectsResponse, error)
+	// TagBook applies a tag to a Book on behalf of a member,
+	// and returns the Book. It returns an AlreadyExists error
+	// if the member has already applied the tag to the Book.
+	TagBook(ctx context.Context, in *TagBookRequest, opts ...grpc.CallOption) (*Book, error)
+	// UntagBook removes a tag a member applied to a Book, and returns
+	// the Book. It returns a NotFound error if the member does not
+	// exist or has not applied the tag to the Book, and a
+	// FailedPrecondition error if the member is suspended.
+	UntagBook(ctx context.Context, in *UntagBookRequest, opts ...grpc.CallOption) (*Book, error)
+	// ListTags returns the tags applied to Books, with the number
+	// of Books tagged with each. Deleted Books are not counted.
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	// MakeCollection takes a stream of books and returns a Book collection.
+	// Books are identified by their ISBN and resolved to the Books in the
+	// library. Duplicates are dropped. If any ISBN is invalid or unknown,
+	// it returns an InvalidArgument error with a google.rpc.BadRequest
+	// detail listing every bad entry, and no collection is made.
+	// The collection is stored, with the owner and name provided in
+	// the "owner" and "collection-name" request metadata. The owner
+	// must be the ID of a member, else an InvalidArgument error is returned.
+	MakeCollection(ctx context.Context, opts ...grpc.CallOption) (BookService_MakeCollectionClient, error)
+	// GetCollection returns a Collection that was made with MakeCollection.
+	// It returns a NotFound error if the Collection does not exist.
+	GetCollection(ctx context.Context, in *GetCollectionRequest, opts ...grpc.CallOption) (*Collection, error)
+	// ListCollections returns a page of Collections, oldest first.
+	ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpc.CallOption) (*ListCollectionsResponse, error)
+	// UpdateCollection renames a Collection or changes its Books,
+	// and returns the updated Collection. Books are resolved like in
+	// MakeCollection, with the same InvalidArgument error for bad ISBNs.
+	// It returns a NotFound error if the Collection does not exist, an
+	// Unauthenticated error if the "owner" request metadata is not the ID
+	// of a member, and a PermissionDenied error if the Collection has an
+	// owner other than that member. The metadata is not authenticated.
+	UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*Collection, error)
+	// DeleteCollection deletes a Collection and returns it.
+	// It returns a NotFound error if the Collection does not exist, an
+	// Unauthenticated error if the "owner" request metadata is not the ID
+	// of a member, and a PermissionDenied error if the Collection has an
+	// owner other than that member. The metadata is not authenticated.
+	DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*Collection, error)
+	// ExportCollection renders a collection or the result of a query
+	// as a file in the format requested, streamed in chunks.
+	// It returns an InvalidArgument error if neither is set,
+	// and a NotFound error if the Collection does not exist.
+	ExportCollection(ctx context.Context, in *ExportCollectionRequest, opts ...grpc.CallOption) (BookService_ExportCollectionClient, error)
+	// WatchBooks streams the changes made to the Books matching the filter,
+	// except changes to only their copies, available copies, average rating
+	// and review count. Watches can be resumed with the resume token of the
+	// last event received. If the changes after that event are no longer
+	// retained, it returns a ResourceExhausted error, and the client should
+	// start a new watch without a resume token and then reload the Books.
+	WatchBooks(ctx context.Context, in *WatchBooksRequest, opts ...grpc.CallOption) (BookService_WatchBooksClient, error)
+	// BookChat allows discussion about books between members.
+	// It returns a NotFound error if the member does not exist,
+	// and a PermissionDenied error if the member is suspended.
+	BookChat(ctx context.Context, opts ...grpc.CallOption) (BookService_BookChatClient, error)
+}
+
+type bookServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBookServiceClient(cc *grpc.ClientConn) BookServiceClient {
+	return &bookServiceClient{cc}
+}
+
+func (c *bookServiceClient) GetBook(ctx context.Context, in *GetBookRequest, opts ...grpc.CallOption) (*Book, error) {
+	out := new(Book)
+	err := grpc.Invoke(ctx, "/library.BookService/GetBook", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) QueryBooks(ctx context.Context, in *QueryBooksRequest, opts ...grpc.CallOption) (BookService_QueryBooksClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_BookService_serviceDesc.Streams[0], c.cc, "/library.BookService/QueryBooks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bookServiceQueryBooksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BookService_QueryBooksClient interface {
+	Recv() (*Book, error)
+	grpc.ClientStream
+}
+
+type bookServiceQueryBooksClient struct {
+	grpc.ClientStream
+}
+
+func (x *bookServiceQueryBooksClient) Recv() (*Book, error) {
+	m := new(Book)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bookServiceClient) ListBooks(ctx context.Context, in *ListBooksRequest, opts ...grpc.CallOption) (*ListBooksResponse, error) {
+	out := new(ListBooksResponse)
+	err := grpc.Invoke(ctx, "/library.BookService/ListBooks", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) SearchBooks(ctx context.Context, in *SearchBooksRequest, opts ...grpc.CallOption) (*SearchBooksResponse, error) {
+	out := new(SearchBooksResponse)
+	err := grpc.Invoke(ctx, "/library.BookService/SearchBooks", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) RecommendBooks(ctx context.Context, in *RecommendBooksRequest, opts ...grpc.CallOption) (*RecommendBooksResponse, error) {
+	out := new(RecommendBooksResponse)
+	err := grpc.Invoke(ctx, "/library.BookService/RecommendBooks", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) CreateBook(ctx context.Context, in *CreateBookRequest, opts ...grpc.CallOption) (*Book, error) {
+	out := new(Book)
+	err := grpc.Invoke(ctx, "/library.BookService/CreateBook", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) UpdateBook(ctx context.Context, in *UpdateBookRequest, opts ...grpc.CallOption) (*Book, error) {
+	out := new(Book)
+	err := grpc.Invoke(ctx, "/library.BookService/UpdateBook", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) DeleteBook(ctx context.Context, in *DeleteBookRequest, opts ...grpc.CallOption) (*Book, error) {
+	out := new(Book)
+	err := grpc.Invoke(ctx, "/library.BookService/DeleteBook", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) RestoreBook(ctx context.Context, in *RestoreBookRequest, opts ...grpc.CallOption) (*Book, error) {
+	out := new(Book)
+	err := grpc.Invoke(ctx, "/library.BookService/RestoreBook", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) ListBookRevisions(ctx context.Context, in *ListBookRevisionsRequest, opts ...grpc.CallOption) (*ListBookRevisionsResponse, error) {
+	out := new(ListBookRevisionsResponse)
+	err := grpc.Invoke(ctx, "/library.BookService/ListBookRevisions", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) CreateAuthor(ctx context.Context, in *CreateAuthorRequest, opts ...grpc.CallOption) (*Author, error) {
+	out := new(Author)
+	err := grpc.Invoke(ctx, "/library.BookService/CreateAuthor", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) GetAuthor(ctx context.Context, in *GetAuthorRequest, opts ...grpc.CallOption) (*Author, error) {
+	out := new(Author)
+	err := grpc.Invoke(ctx, "/library.BookService/GetAuthor", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) UpdateAuthor(ctx context.Context, in *UpdateAuthorRequest, opts ...grpc.CallOption) (*Author, error) {
+	out := new(Author)
+	err := grpc.Invoke(ctx, "/library.BookService/UpdateAuthor", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) ListAuthorBooks(ctx context.Context, in *ListAuthorBooksRequest, opts ...grpc.CallOption) (*ListAuthorBooksResponse, error) {
+	out := new(ListAuthorBooksResponse)
+	err := grpc.Invoke(ctx, "/library.BookService/ListAuthorBooks", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) UploadCover(ctx context.Context, opts ...grpc.CallOption) (BookService_UploadCoverClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_BookService_serviceDesc.Streams[1], c.cc, "/library.BookService/UploadCover", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bookServiceUploadCoverClient{stream}
+	return x, nil
+}
+
+type BookService_UploadCoverClient interface {
+	Send(*UploadCoverRequest) error
+	CloseAndRecv() (*Book, error)
+	grpc.ClientStream
+}
+
+type bookServiceUploadCoverClient struct {
+	grpc.ClientStream
+}
+
+func (x *bookServiceUploadCoverClient) Send(m *UploadCoverRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *bookServiceUploadCoverClient) CloseAndRecv() (*Book, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(Book)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bookServiceClient) CreateWork(ctx context.Context, in *CreateWorkRequest, opts ...grpc.CallOption) (*Work, error) {
+	out := new(Work)
+	err := grpc.Invoke(ctx, "/library.BookService/CreateWork", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) GetWork(ctx context.Context, in *GetWorkRequest, opts ...grpc.CallOption) (*Work, error) {
+	out := new(Work)
+	err := grpc.Invoke(ctx, "/library.BookService/GetWork", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) UpdateWork(ctx context.Context, in *UpdateWorkRequest, opts ...grpc.CallOption) (*Work, error) {
+	out := new(Work)
+	err := grpc.Invoke(ctx, "/library.BookService/UpdateWork", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) ListEditions(ctx context.Context, in *ListEditionsRequest, opts ...grpc.CallOption) (*ListEditionsResponse, error) {
+	out := new(ListEditionsResponse)
+	err := grpc.Invoke(ctx, "/library.BookService/ListEditions", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) CreateSeries(ctx context.Context, in *CreateSeriesRequest, opts ...grpc.CallOption) (*Series, error) {
+	out := new(Series)
+	err := grpc.Invoke(ctx, "/library.BookService/CreateSeries", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) GetSeries(ctx context.Context, in *GetSeriesRequest, opts ...grpc.CallOption) (*Series, error) {
+	out := new(Series)
+	err := grpc.Invoke(ctx, "/library.BookService/GetSeries", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) ListSeriesWorks(ctx context.Context, in *ListSeriesWorksRequest, opts ...grpc.CallOption) (*ListSeriesWorksResponse, error) {
+	out := new(ListSeriesWorksResponse)
+	err := grpc.Invoke(ctx, "/library.BookService/ListSeriesWorks", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) CreateSubject(ctx context.Context, in *CreateSubjectRequest, opts ...grpc.CallOption) (*Subject, error) {
+	out := new(Subject)
+	err := grpc.Invoke(ctx, "/library.BookService/CreateSubject", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) GetSubject(ctx context.Context, in *GetSubjectRequest, opts ...grpc.CallOption) (*Subject, error) {
+	out := new(Subject)
+	err := grpc.Invoke(ctx, "/library.BookService/GetSubject", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) UpdateSubject(ctx context.Context, in *UpdateSubjectRequest, opts ...grpc.CallOption) (*Subject, error) {
+	out := new(Subject)
+	err := grpc.Invoke(ctx, "/library.BookService/UpdateSubject", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) DeleteSubject(ctx context.Context, in *DeleteSubjectRequest, opts ...grpc.CallOption) (*Subject, error) {
+	out := new(Subject)
+	err := grpc.Invoke(ctx, "/library.BookService/DeleteSubject", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) BrowseSubjects(ctx context.Context, in *BrowseSubjectsRequest, opts ...grpc.CallOption) (*BrowseSubjectsResponse, error) {
+	out := new(BrowseSubjectsResponse)
+	err := grpc.Invoke(ctx, "/library.BookService/BrowseSubjects", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) TagBook(ctx context.Context, in *TagBookRequest, opts ...grpc.CallOption) (*Book, error) {
+	out := new(Book)
+	err := grpc.Invoke(ctx, "/library.BookService/TagBook", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) UntagBook(ctx context.Context, in *UntagBookRequest, opts ...grpc.CallOption) (*Book, error) {
+	out := new(Book)
+	err := grpc.Invoke(ctx, "/library.BookService/UntagBook", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	out := new(ListTagsResponse)
+	err := grpc.Invoke(ctx, "/library.BookService/ListTags", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) MakeCollection(ctx context.Context, opts ...grpc.CallOption) (BookService_MakeCollectionClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_BookService_serviceDesc.Streams[2], c.cc, "/library.BookService/MakeCollection", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bookServiceMakeCollectionClient{stream}
+	return x, nil
+}
+
+type BookService_MakeCollectionClient interface {
+	Send(*Book) error
+	CloseAndRecv() (*Collection, error)
+	grpc.ClientStream
+}
+
+type bookServiceMakeCollectionClient struct {
+	grpc.ClientStream
+}
+
+func (x *bookServiceMakeCollectionClient) Send(m *Book) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *bookServiceMakeCollectionClient) CloseAndRecv() (*Collection, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(Collection)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bookServiceClient) GetCollection(ctx context.Context, in *GetCollectionRequest, opts ...grpc.CallOption) (*Collection, error) {
+	out := new(Collection)
+	err := grpc.Invoke(ctx, "/library.BookService/GetCollection", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpc.CallOption) (*ListCollectionsResponse, error) {
+	out := new(ListCollectionsResponse)
+	err := grpc.Invoke(ctx, "/library.BookService/ListCollections", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*Collection, error) {
+	out := new(Collection)
+	err := grpc.Invoke(ctx, "/library.BookService/UpdateCollection", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*Collection, error) {
+	out := new(Collection)
+	err := grpc.Invoke(ctx, "/library.BookService/DeleteCollection", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) ExportCollection(ctx context.Context, in *ExportCollectionRequest, opts ...grpc.CallOption) (BookService_ExportCollectionClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_BookService_serviceDesc.Streams[3], c.cc, "/library.BookService/ExportCollection", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bookServiceExportCollectionClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BookService_ExportCollectionClient interface {
+	Recv() (*ExportChunk, error)
+	grpc.ClientStream
+}
+
+type bookServiceExportCollectionClient struct {
+	grpc.ClientStream
+}
+
+func (x *bookServiceExportCollectionClient) Recv() (*ExportChunk, error) {
+	m := new(ExportChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bookServiceClient) WatchBooks(ctx context.Context, in *WatchBooksRequest, opts ...grpc.CallOption) (BookService_WatchBooksClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_BookService_serviceDesc.Streams[4], c.cc, "/library.BookService/WatchBooks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bookServiceWatchBooksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BookService_WatchBooksClient interface {
+	Recv() (*BookEvent, error)
+	grpc.ClientStream
+}
+
+type bookServiceWatchBooksClient struct {
+	grpc.ClientStream
+}
+
+func (x *bookServiceWatchBooksClient) Recv() (*BookEvent, error) {
+	m := new(BookEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bookServiceClient) BookChat(ctx context.Context, opts ...grpc.CallOption) (BookService_BookChatClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_BookService_serviceDesc.Streams[5], c.cc, "/library.BookService/BookChat", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bookServiceBookChatClient{stream}
+	return x, nil
+}
+
+type BookService_BookChatClient interface {
+	Send(*BookMessage) error
+	Recv() (*BookResponse, error)
+	grpc.ClientStream
+}
+
+type bookServiceBookChatClient struct {
+	grpc.ClientStream
+}
+
+func (x *bookServiceBookChatClient) Send(m *BookMessage) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *bookServiceBookChatClient) Recv() (*BookResponse, error) {
+	m := new(BookResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Server API for BookService service
+
+type BookServiceServer interface {
+	// GetBook returns a Book from the library
+	// that matches the ISBN provided, if found.
+	// Otherwise it returns a NotFound error.
+	GetBook(context.Context, *GetBookRequest) (*Book, error)
+	// QueryBooks returns all Books matching the
+	// filters provided, as a stream of Books.
+	// It returns an InvalidArgument error if the
+	// filters are invalid.
+	QueryBooks(*QueryBooksRequest, BookService_QueryBooksServer) error
+	// ListBooks returns a page of Books matching the filter
+	// provided, in the order requested. Pages are stable as
+	// Books are added and removed between calls.
+	ListBooks(context.Context, *ListBooksRequest) (*ListBooksResponse, error)
+	// SearchBooks returns the Books whose title, author or
+	// publisher match the query provided, most relevant first.
+	// Matching ignores case and diacritics.
+	SearchBooks(context.Context, *SearchBooksRequest) (*SearchBooksResponse, error)
+	// RecommendBooks returns Books similar to a Book, or to the Books a
+	// member has borrowed and collected, best first. Similarity is learnt from
+	// the Collections and Loans of the library. When there is too little of
+	// either, Books by the same author and of the same type are recommended.
+	// It returns a NotFound error if the Book or member does not exist.
+	RecommendBooks(context.Context, *RecommendBooksRequest) (*RecommendBooksResponse, error)
+	// CreateBook adds a Book to the library.
+	// It returns an AlreadyExists error if a Book
+	// with the same ISBN is already in the library.
+	CreateBook(context.Context, *CreateBookRequest) (*Book, error)
+	// UpdateBook updates the fields of a Book in the library
+	// selected by the update mask, and returns the updated Book.
+	// It returns a NotFound error if the Book does not exist,
+	// and an Aborted error if the etag does not match.
+	UpdateBook(context.Context, *UpdateBookRequest) (*Book, error)
+	// DeleteBook removes a Book from the library
+	// and returns the removed Book. Deleted Books
+	// can be restored with RestoreBook.
+	// It returns a NotFound error if the Book does not exist,
+	// an Aborted error if the etag does not match, and a
+	// FailedPrecondition error if any copies are on loan or on hold,
+	// or translations of the Book are in the library.
+	DeleteBook(context.Context, *DeleteBookRequest) (*Book, error)
+	// RestoreBook restores a deleted Book and returns it.
+	// It returns a NotFound error if no such deleted Book exists.
+	RestoreBook(context.Context, *RestoreBookRequest) (*Book, error)
+	// ListBookRevisions returns the revision history of
+	// a Book, newest first, including any deletions. Changes to only the
+	// copies, available copies, average rating and review count of the
+	// Book are not revisions.
+	// It returns a NotFound error if the Book never existed.
+	ListBookRevisions(context.Context, *ListBookRevisionsRequest) (*ListBookRevisionsResponse, error)
+	// CreateAuthor adds an Author to the library and returns it.
+	CreateAuthor(context.Context, *CreateAuthorRequest) (*Author, error)
+	// GetAuthor returns the Author with the ID provided.
+	// It returns a NotFound error if the Author does not exist.
+	GetAuthor(context.Context, *GetAuthorRequest) (*Author, error)
+	// UpdateAuthor updates the fields of an Author selected by the
+	// update mask, and returns the updated Author. Renaming an Author
+	// updates the author names of their Books.
+	// It returns a NotFound error if the Author does not exist.
+	UpdateAuthor(context.Context, *UpdateAuthorRequest) (*Author, error)
+	// ListAuthorBooks returns a page of the Books of
+	// an Author, by publication date, oldest first.
+	// It returns a NotFound error if the Author does not exist.
+	ListAuthorBooks(context.Context, *ListAuthorBooksRequest) (*ListAuthorBooksResponse, error)
+	// UploadCover takes a stream of chunks of an image, and sets it
+	// as the cover of a Book. It returns the Book with its new cover URL.
+	// It returns a NotFound error if the Book does not exist, an Aborted
+	// error if the etag does not match, and an InvalidArgument error
+	// if the image is too large or not in a supported format.
+	UploadCover(BookService_UploadCoverServer) error
+	// CreateWork adds a Work to the library and returns it. Books
+	// are made editions of a Work by setting their work ID.
+	CreateWork(context.Context, *CreateWorkRequest) (*Work, error)
+	// GetWork returns the Work with the ID provided.
+	// It returns a NotFound error if the Work does not exist.
+	GetWork(context.Context, *GetWorkRequest) (*Work, error)
+	// UpdateWork updates the fields of a Work selected by the
+	// update mask, and returns the updated Work.
+	// It returns a NotFound error if the Work does not exist.
+	UpdateWork(context.Context, *UpdateWorkRequest) (*Work, error)
+	// ListEditions returns the Books that are editions of a Work,
+	// such as its hardcover, paperback and audiobook.
+	// It returns a NotFound error if the Work does not exist.
+	ListEditions(context.Context, *ListEditionsRequest) (*ListEditionsResponse, error)
+	// CreateSeries adds a Series to the library and returns it. Works
+	// are added to a Series by setting their series ID and number.
+	CreateSeries(context.Context, *CreateSeriesRequest) (*Series, error)
+	// GetSeries returns the Series with the ID provided.
+	// It returns a NotFound error if the Series does not exist.
+	GetSeries(context.Context, *GetSeriesRequest) (*Series, error)
+	// ListSeriesWorks returns the Works of a Series, in order.
+	// It returns a NotFound error if the Series does not exist.
+	ListSeriesWorks(context.Context, *ListSeriesWorksRequest) (*ListSeriesWorksResponse, error)
+	// CreateSubject adds a Subject to the subject taxonomy and returns it.
+	// It returns an AlreadyExists error if a Subject with the same code exists.
+	CreateSubject(context.Context, *CreateSubjectRequest) (*Subject, error)
+	// GetSubject returns the Subject with the code provided.
+	GetSubject(context.Context, *GetSubjectRequest) (*Subject, error)
+	// UpdateSubject renames or moves a Subject, and returns it.
+	// A Subject can't be moved under itself or its subjects.
+	UpdateSubject(context.Context, *UpdateSubjectRequest) (*Subject, error)
+	// DeleteSubject removes a Subject from the taxonomy and returns it.
+	// It returns a FailedPrecondition error if any Subjects are under
+	// it, or any Books have it.
+	DeleteSubject(context.Context, *DeleteSubjectRequest) (*Subject, error)
+	// BrowseSubjects returns the Subjects under a Subject, or the top
+	// level Subjects, with the number of Books about each. The Books
+	// themselves are returned by QueryBooks with the subject code.
+	BrowseSubjects(context.Context, *BrowseSubjectsRequest) (*BrowseSubjectsResponse, error)
+	// TagBook applies a tag to a Book on behalf of a member,
+	// and returns the Book. It returns an AlreadyExists error
+	// if the member has already applied the tag to the Book.
+	TagBook(context.Context, *TagBookRequest) (*Book, error)
+	// UntagBook removes a tag a member applied to a Book, and returns
+	// the Book. It returns a NotFound error if the member does not
+	// exist or has not applied the tag to the Book, and a
+	// FailedPrecondition error if the member is suspended.
+	UntagBook(context.Context, *UntagBookRequest) (*Book, error)
+	// ListTags returns the tags applied to Books, with the number
+	// of Books tagged with each. Deleted Books are not counted.
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	// MakeCollection takes a stream of books and returns a Book collection.
+	// Books are identified by their ISBN and resolved to the Books in the
+	// library. Duplicates are dropped. If any ISBN is invalid or unknown,
+	// it returns an InvalidArgument error with a google.rpc.BadRequest
+	// detail listing every bad entry, and no collection is made.
+	// The collection is stored, with the owner and name provided in
+	// the "owner" and "collection-name" request metadata. The owner
+	// must be the ID of a member, else an InvalidArgument error is returned.
+	MakeCollection(BookService_MakeCollectionServer) error
+	// GetCollection returns a Collection that was made with MakeCollection.
+	// It returns a NotFound error if the Collection does not exist.
+	GetCollection(context.Context, *GetCollectionRequest) (*Collection, error)
+	// ListCollections returns a page of Collections, oldest first.
+	ListCollections(context.Context, *ListCollectionsRequest) (*ListCollectionsResponse, error)
+	// UpdateCollection renames a Collection or changes its Books,
+	// and returns the updated Collection. Books are resolved like in
+	// MakeCollection, with the same InvalidArgument error for bad ISBNs.
+	// It returns a NotFound error if the Collection does not exist, an
+	// Unauthenticated error if the "owner" request metadata is not the ID
+	// of a member, and a PermissionDenied error if the Collection has an
+	// owner other than that member. The metadata is not authenticated.
+	UpdateCollection(context.Context, *UpdateCollectionRequest) (*Collection, error)
+	// DeleteCollection deletes a Collection and returns it.
+	// It returns a NotFound error if the Collection does not exist, an
+	// Unauthenticated error if the "owner" request metadata is not the ID
+	// of a member, and a PermissionDenied error if the Collection has an
+	// owner other than that member. The metadata is not authenticated.
+	DeleteCollection(context.Context, *DeleteCollectionRequest) (*Collection, error)
+	// ExportCollection renders a collection or the result of a query
+	// as a file in the format requested, streamed in chunks.
+	// It returns an InvalidArgument error if neither is set,
+	// and a NotFound error if the Collection does not exist.
+	ExportCollection(*ExportCollectionRequest, BookService_ExportCollectionServer) error
+	// WatchBooks streams the changes made to the Books matching the filter,
+	// except changes to only their copies, available copies, average rating
+	// and review count. Watches can be resumed with the resume token of the
+	// last event received. If the changes after that event are no longer
+	// retained, it returns a ResourceExhausted error, and the client should
+	// start a new watch without a resume token and then reload the Books.
+	WatchBooks(*WatchBooksRequest, BookService_WatchBooksServer) error
+	// BookChat allows discussion about books between members.
+	// It returns a NotFound error if the member does not exist,
+	// and a PermissionDenied error if the member is suspended.
+	BookChat(BookService_BookChatServer) error
+}
+
+func RegisterBookServiceServer(s *grpc.Server, srv BookServiceServer) {
+	s.RegisterService(&_BookService_serviceDesc, srv)
+}
+
+func _BookService_GetBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).GetBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.BookService/GetBook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).GetBook(ctx, req.(*GetBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_QueryBooks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryBooksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BookServiceServer).QueryBooks(m, &bookServiceQueryBooksServer{stream})
+}
+
+type BookService_QueryBooksServer interface {
+	Send(*Book) error
+	grpc.ServerStream
+}
+
+type bookServiceQueryBooksServer struct {
+	grpc.ServerStream
+}
+
+func (x *bookServiceQueryBooksServer) Send(m *Book) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BookService_ListBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).ListBooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.BookService/ListBooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).ListBooks(ctx, req.(*ListBooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_SearchBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchBooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).SearchBooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.BookService/SearchBooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).SearchBooks(ctx, req.(*SearchBooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_RecommendBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecommendBooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).RecommendBooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.BookService/RecommendBooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).RecommendBooks(ctx, req.(*RecommendBooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_CreateBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).CreateBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.BookService/CreateBook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).CreateBook(ctx, req.(*CreateBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_UpdateBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).UpdateBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.BookService/UpdateBook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).UpdateBook(ctx, req.(*UpdateBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_DeleteBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).DeleteBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.BookService/DeleteBook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).DeleteBook(ctx, req.(*DeleteBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_RestoreBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).RestoreBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.BookService/RestoreBook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).RestoreBook(ctx, req.(*RestoreBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_ListBookRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBookRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).ListBookRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.BookService/ListBookRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).ListBookRevisions(ctx, req.(*ListBookRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_CreateAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).CreateAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.BookService/CreateAuthor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).CreateAuthor(ctx, req.(*CreateAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_GetAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).GetAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.BookService/GetAuthor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).GetAuthor(ctx, req.(*GetAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_UpdateAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).UpdateAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.BookService/UpdateAuthor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).UpdateAuthor(ctx, req.(*UpdateAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_ListAuthorBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuthorBooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).ListAuthorBooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.BookService/ListAuthorBooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).ListAuthorBooks(ctx, req.(*ListAuthorBooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_UploadCover_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(BookServiceServer).UploadCover(&bookServiceUploadCoverServer{stream})
+}
+
+type BookService_UploadCoverServer interface {
+	SendAndClose(*Book) error
+	Recv() (*UploadCoverRequest, error)
+	grpc.ServerStream
+}
+
+type bookServiceUploadCoverServer struct {
+	grpc.ServerStream
+}
+
+func (x *bookServiceUploadCoverServer) SendAndClose(m *Book) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *bookServiceUploadCoverServer) Recv() (*UploadCoverRequest, error) {
+	m := new(UploadCoverRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _BookService_CreateWork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWorkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).CreateWork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.BookService/CreateWork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).CreateWork(ctx, req.(*CreateWorkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_GetWork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).GetWork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.BookService/GetWork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).GetWork(ctx, req.(*GetWorkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_UpdateWork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).UpdateWork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.BookService/UpdateWork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).UpdateWork(ctx, req.(*UpdateWorkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_ListEditions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEditionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).ListEditions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.BookService/ListEditions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).ListEditions(ctx, req.(*ListEditionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_CreateSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).CreateSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.BookService/CreateSeries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).CreateSeries(ctx, req.(*CreateSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_GetSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).GetSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.BookService/GetSeries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).GetSeries(ctx, req.(*GetSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_ListSeriesWorks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSeriesWorksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).ListSeriesWorks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.BookService/ListSeriesWorks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).ListSeriesWorks(ctx, req.(*ListSeriesWorksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_CreateSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSubjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).CreateSubject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.BookService/CreateSubject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).CreateSubject(ctx, req.(*CreateSubjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_GetSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).GetSubject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.BookService/GetSubject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).GetSubject(ctx, req.(*GetSubjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_UpdateSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSubjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).UpdateSubject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.BookService/UpdateSubject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).UpdateSubject(ctx, req.(*UpdateSubjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_DeleteSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSubjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).DeleteSubject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.BookService/DeleteSubject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).DeleteSubject(ctx, req.(*DeleteSubjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_BrowseSubjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BrowseSubjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).BrowseSubjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.BookService/BrowseSubjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).BrowseSubjects(ctx, req.(*BrowseSubjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_TagBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).TagBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.BookService/TagBook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).TagBook(ctx, req.(*TagBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_UntagBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UntagBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).UntagBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.BookService/UntagBook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).UntagBook(ctx, req.(*UntagBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.BookService/ListTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_MakeCollection_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(BookServiceServer).MakeCollection(&bookServiceMakeCollectionServer{stream})
+}
+
+type BookService_MakeCollectionServer interface {
+	SendAndClose(*Collection) error
+	Recv() (*Book, error)
+	grpc.ServerStream
+}
+
+type bookServiceMakeCollectionServer struct {
+	grpc.ServerStream
+}
+
+func (x *bookServiceMakeCollectionServer) SendAndClose(m *Collection) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *bookServiceMakeCollectionServer) Recv() (*Book, error) {
+	m := new(Book)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _BookService_GetCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).GetCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.BookService/GetCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).GetCollection(ctx, req.(*GetCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_ListCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).ListCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.BookService/ListCollections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).ListCollections(ctx, req.(*ListCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_UpdateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).UpdateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.BookService/UpdateCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).UpdateCollection(ctx, req.(*UpdateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_DeleteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).DeleteCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.BookService/DeleteCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).DeleteCollection(ctx, req.(*DeleteCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_ExportCollection_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportCollectionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BookServiceServer).ExportCollection(m, &bookServiceExportCollectionServer{stream})
+}
+
+type BookService_ExportCollectionServer interface {
+	Send(*ExportChunk) error
+	grpc.ServerStream
+}
+
+type bookServiceExportCollectionServer struct {
+	grpc.ServerStream
+}
+
+func (x *bookServiceExportCollectionServer) Send(m *ExportChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BookService_WatchBooks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchBooksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BookServiceServer).WatchBooks(m, &bookServiceWatchBooksServer{stream})
+}
+
+type BookService_WatchBooksServer interface {
+	Send(*BookEvent) error
+	grpc.ServerStream
+}
+
+type bookServiceWatchBooksServer struct {
+	grpc.ServerStream
+}
+
+func (x *bookServiceWatchBooksServer) Send(m *BookEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BookService_BookChat_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(BookServiceServer).BookChat(&bookServiceBookChatServer{stream})
+}
+
+type BookService_BookChatServer interface {
+	Send(*BookResponse) error
+	Recv() (*BookMessage, error)
+	grpc.ServerStream
+}
+
+type bookServiceBookChatServer struct {
+	grpc.ServerStream
+}
+
+func (x *bookServiceBookChatServer) Send(m *BookResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *bookServiceBookChatServer) Recv() (*BookMessage, error) {
+	m := new(BookMessage)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var _BookService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "library.BookService",
+	HandlerType: (*BookServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBook",
+			Handler:    _BookService_GetBook_Handler,
+		},
+		{
+			MethodName: "ListBooks",
+			Handler:    _BookService_ListBooks_Handler,
+		},
+		{
+			MethodName: "SearchBooks",
+			Handler:    _BookService_SearchBooks_Handler,
+		},
+		{
+			MethodName: "RecommendBooks",
+			Handler:    _BookService_RecommendBooks_Handler,
+		},
+		{
+			MethodName: "CreateBook",
+			Handler:    _BookService_CreateBook_Handler,
+		},
+		{
+			MethodName: "UpdateBook",
+			Handler:    _BookService_UpdateBook_Handler,
+		},
+		{
+			MethodName: "DeleteBook",
+			Handler:    _BookService_DeleteBook_Handler,
+		},
+		{
+			MethodName: "RestoreBook",
+			Handler:    _BookService_RestoreBook_Handler,
+		},
+		{
+			MethodName: "ListBookRevisions",
+			Handler:    _BookService_ListBookRevisions_Handler,
+		},
+		{
+			MethodName: "CreateAuthor",
+			Handler:    _BookService_CreateAuthor_Handler,
+		},
+		{
+			MethodName: "GetAuthor",
+			Handler:    _BookService_GetAuthor_Handler,
+		},
+		{
+			MethodName: "UpdateAuthor",
+			Handler:    _BookService_UpdateAuthor_Handler,
+		},
+		{
+			MethodName: "ListAuthorBooks",
+			Handler:    _BookService_ListAuthorBooks_Handler,
+		},
+		{
+			MethodName: "CreateWork",
+			Handler:    _BookService_CreateWork_Handler,
+		},
+		{
+			MethodName: "GetWork",
+			Handler:    _BookService_GetWork_Handler,
+		},
+		{
+			MethodName: "UpdateWork",
+			Handler:    _BookService_UpdateWork_Handler,
+		},
+		{
+			MethodName: "ListEditions",
+			Handler:    _BookService_ListEditions_Handler,
+		},
+		{
+			MethodName: "CreateSeries",
+			Handler:    _BookService_CreateSeries_Handler,
+		},
+		{
+			MethodName: "GetSeries",
+			Handler:    _BookService_GetSeries_Handler,
+		},
+		{
+			MethodName: "ListSeriesWorks",
+			Handler:    _BookService_ListSeriesWorks_Handler,
+		},
+		{
+			MethodName: "CreateSubject",
+			Handler:    _BookService_CreateSubject_Handler,
+		},
+		{
+			MethodName: "GetSubject",
+			Handler:    _BookService_GetSubject_Handler,
+		},
+		{
+			MethodName: "UpdateSubject",
+			Handler:    _BookService_UpdateSubject_Handler,
+		},
+		{
+			MethodName: "DeleteSubject",
+			Handler:    _BookService_DeleteSubject_Handler,
+		},
+		{
+			MethodName: "BrowseSubjects",
+			Handler:    _BookService_BrowseSubjects_Handler,
+		},
+		{
+			MethodName: "TagBook",
+			Handler:    _BookService_TagBook_Handler,
+		},
+		{
+			MethodName: "UntagBook",
+			Handler:    _BookService_UntagBook_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _BookService_ListTags_Handler,
+		},
+		{
+			MethodName: "GetCollection",
+			Handler:    _BookService_GetCollection_Handler,
+		},
+		{
+			MethodName: "ListCollections",
+			Handler:    _BookService_ListCollections_Handler,
+		},
+		{
+			MethodName: "UpdateCollection",
+			Handler:    _BookService_UpdateCollection_Handler,
+		},
+		{
+			MethodName: "DeleteCollection",
+			Handler:    _BookService_DeleteCollection_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "QueryBooks",
+			Handler:       _BookService_QueryBooks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadCover",
+			Handler:       _BookService_UploadCover_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "MakeCollection",
+			Handler:       _BookService_MakeCollection_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportCollection",
+			Handler:       _BookService_ExportCollection_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchBooks",
+			Handler:       _BookService_WatchBooks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "BookChat",
+			Handler:       _BookService_BookChat_Handler,
+			ServerStreams: true,
 			ClientStreams: true,
 		},
+	},
+	Metadata: "proto/library/book_service.proto",
+}
+
+// Client API for MemberService service
+
+type MemberServiceClient interface {
+	// RegisterMember adds a member to the library and returns
+	// the Member, with its ID and card number.
+	RegisterMember(ctx context.Context, in *RegisterMemberRequest, opts ...grpc.CallOption) (*Member, error)
+	// GetMember returns a Member by ID or card number.
+	// It returns a NotFound error if the Member does not exist.
+	GetMember(ctx context.Context, in *GetMemberRequest, opts ...grpc.CallOption) (*Member, error)
+	// UpdateMember updates the profile fields of a Member selected
+	// by the update mask, and returns the updated Member.
+	// It returns a NotFound error if the Member does not exist.
+	UpdateMember(ctx context.Context, in *UpdateMemberRequest, opts ...grpc.CallOption) (*Member, error)
+	// SuspendMember suspends a Member and returns it.
+	// It returns a NotFound error if the Member does not exist.
+	SuspendMember(ctx context.Context, in *SuspendMemberRequest, opts ...grpc.CallOption) (*Member, error)
+	// ReinstateMember reinstates a suspended Member and returns it.
+	// It returns a NotFound error if the Member does not exist.
+	ReinstateMember(ctx context.Context, in *ReinstateMemberRequest, opts ...grpc.CallOption) (*Member, error)
+	// DeleteMember removes a Member from the library and returns it.
+	// It returns a NotFound error if the Member does not exist, and a
+	// FailedPrecondition error if the Member has books on loan or open holds,
+	// owns collections, has written reviews or has tagged books.
+	DeleteMember(ctx context.Context, in *DeleteMemberRequest, opts ...grpc.CallOption) (*Member, error)
+}
+
+type memberServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMemberServiceClient(cc *grpc.ClientConn) MemberServiceClient {
+	return &memberServiceClient{cc}
+}
+
+func (c *memberServiceClient) RegisterMember(ctx context.Context, in *RegisterMemberRequest, opts ...grpc.CallOption) (*Member, error) {
+	out := new(Member)
+	err := grpc.Invoke(ctx, "/library.MemberService/RegisterMember", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) GetMember(ctx context.Context, in *GetMemberRequest, opts ...grpc.CallOption) (*Member, error) {
+	out := new(Member)
+	err := grpc.Invoke(ctx, "/library.MemberService/GetMember", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) UpdateMember(ctx context.Context, in *UpdateMemberRequest, opts ...grpc.CallOption) (*Member, error) {
+	out := new(Member)
+	err := grpc.Invoke(ctx, "/library.MemberService/UpdateMember", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) SuspendMember(ctx context.Context, in *SuspendMemberRequest, opts ...grpc.CallOption) (*Member, error) {
+	out := new(Member)
+	err := grpc.Invoke(ctx, "/library.MemberService/SuspendMember", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) ReinstateMember(ctx context.Context, in *ReinstateMemberRequest, opts ...grpc.CallOption) (*Member, error) {
+	out := new(Member)
+	err := grpc.Invoke(ctx, "/library.MemberService/ReinstateMember", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) DeleteMember(ctx context.Context, in *DeleteMemberRequest, opts ...grpc.CallOption) (*Member, error) {
+	out := new(Member)
+	err := grpc.Invoke(ctx, "/library.MemberService/DeleteMember", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for MemberService service
+
+type MemberServiceServer interface {
+	// RegisterMember adds a member to the library and returns
+	// the Member, with its ID and card number.
+	RegisterMember(context.Context, *RegisterMemberRequest) (*Member, error)
+	// GetMember returns a Member by ID or card number.
+	// It returns a NotFound error if the Member does not exist.
+	GetMember(context.Context, *GetMemberRequest) (*Member, error)
+	// UpdateMember updates the profile fields of a Member selected
+	// by the update mask, and returns the updated Member.
+	// It returns a NotFound error if the Member does not exist.
+	UpdateMember(context.Context, *UpdateMemberRequest) (*Member, error)
+	// SuspendMember suspends a Member and returns it.
+	// It returns a NotFound error if the Member does not exist.
+	SuspendMember(context.Context, *SuspendMemberRequest) (*Member, error)
+	// ReinstateMember reinstates a suspended Member and returns it.
+	// It returns a NotFound error if the Member does not exist.
+	ReinstateMember(context.Context, *ReinstateMemberRequest) (*Member, error)
+	// DeleteMember removes a Member from the library and returns it.
+	// It returns a NotFound error if the Member does not exist, and a
+	// FailedPrecondition error if the Member has books on loan or open holds,
+	// owns collections, has written reviews or has tagged books.
+	DeleteMember(context.Context, *DeleteMemberRequest) (*Member, error)
+}
+
+func RegisterMemberServiceServer(s *grpc.Server, srv MemberServiceServer) {
+	s.RegisterService(&_MemberService_serviceDesc, srv)
+}
+
+func _MemberService_RegisterMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).RegisterMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.MemberService/RegisterMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).RegisterMember(ctx, req.(*RegisterMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_GetMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).GetMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.MemberService/GetMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).GetMember(ctx, req.(*GetMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_UpdateMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).UpdateMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.MemberService/UpdateMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).UpdateMember(ctx, req.(*UpdateMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_SuspendMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).SuspendMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.MemberService/SuspendMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).SuspendMember(ctx, req.(*SuspendMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_ReinstateMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReinstateMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).ReinstateMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.MemberService/ReinstateMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).ReinstateMember(ctx, req.(*ReinstateMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_DeleteMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).DeleteMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.MemberService/DeleteMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).DeleteMember(ctx, req.(*DeleteMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _MemberService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "library.MemberService",
+	HandlerType: (*MemberServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterMember",
+			Handler:    _MemberService_RegisterMember_Handler,
+		},
+		{
+			MethodName: "GetMember",
+			Handler:    _MemberService_GetMember_Handler,
+		},
+		{
+			MethodName: "UpdateMember",
+			Handler:    _MemberService_UpdateMember_Handler,
+		},
+		{
+			MethodName: "SuspendMember",
+			Handler:    _MemberService_SuspendMember_Handler,
+		},
+		{
+			MethodName: "ReinstateMember",
+			Handler:    _MemberService_ReinstateMember_Handler,
+		},
+		{
+			MethodName: "DeleteMember",
+			Handler:    _MemberService_DeleteMember_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/library/book_service.proto",
+}
+
+// Client API for ReviewService service
+
+type ReviewServiceClient interface {
+	// CreateReview adds a review of a Book and returns it.
+	// It returns a NotFound error if the Book or member does not exist,
+	// an InvalidArgument error if the rating is not from 1 to 5, and an
+	// AlreadyExists error if the member has already reviewed the Book.
+	CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*Review, error)
+	// ListReviews returns a page of Reviews, newest first.
+	ListReviews(ctx context.Context, in *ListReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error)
+	// DeleteReview removes a Review and returns it.
+	// It returns a NotFound error if the Review does not exist,
+	// and a PermissionDenied error if it was written by another member.
+	DeleteReview(ctx context.Context, in *DeleteReviewRequest, opts ...grpc.CallOption) (*Review, error)
+}
+
+type reviewServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewReviewServiceClient(cc *grpc.ClientConn) ReviewServiceClient {
+	return &reviewServiceClient{cc}
+}
+
+func (c *reviewServiceClient) CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*Review, error) {
+	out := new(Review)
+	err := grpc.Invoke(ctx, "/library.ReviewService/CreateReview", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) ListReviews(ctx context.Context, in *ListReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error) {
+	out := new(ListReviewsResponse)
+	err := grpc.Invoke(ctx, "/library.ReviewService/ListReviews", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) DeleteReview(ctx context.Context, in *DeleteReviewRequest, opts ...grpc.CallOption) (*Review, error) {
+	out := new(Review)
+	err := grpc.Invoke(ctx, "/library.ReviewService/DeleteReview", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for ReviewService service
+
+type ReviewServiceServer interface {
+	// CreateReview adds a review of a Book and returns it.
+	// It returns a NotFound error if the Book or member does not exist,
+	// an InvalidArgument error if the rating is not from 1 to 5, and an
+	// AlreadyExists error if the member has already reviewed the Book.
+	CreateReview(context.Context, *CreateReviewRequest) (*Review, error)
+	// ListReviews returns a page of Reviews, newest first.
+	ListReviews(context.Context, *ListReviewsRequest) (*ListReviewsResponse, error)
+	// DeleteReview removes a Review and returns it.
+	// It returns a NotFound error if the Review does not exist,
+	// and a PermissionDenied error if it was written by another member.
+	DeleteReview(context.Context, *DeleteReviewRequest) (*Review, error)
+}
+
+func RegisterReviewServiceServer(s *grpc.Server, srv ReviewServiceServer) {
+	s.RegisterService(&_ReviewService_serviceDesc, srv)
+}
+
+func _ReviewService_CreateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).CreateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.ReviewService/CreateReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).CreateReview(ctx, req.(*CreateReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_ListReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).ListReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.ReviewService/ListReviews",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).ListReviews(ctx, req.(*ListReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_DeleteReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).DeleteReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.ReviewService/DeleteReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).DeleteReview(ctx, req.(*DeleteReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ReviewService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "library.ReviewService",
+	HandlerType: (*ReviewServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateReview",
+			Handler:    _ReviewService_CreateReview_Handler,
+		},
+		{
+			MethodName: "ListReviews",
+			Handler:    _ReviewService_ListReviews_Handler,
+		},
+		{
+			MethodName: "DeleteReview",
+			Handler:    _ReviewService_DeleteReview_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/library/book_service.proto",
+}
+
+// Client API for LendingService service
+
+type LendingServiceClient interface {
+	// Checkout lends a copy of a Book to a member and returns the Loan.
+	// Members with a ready Hold on the Book check out the copy set aside.
+	// If a barcode is provided, that Copy is marked as on loan.
+	// It returns a NotFound error if the Book, Copy or Member does not
+	// exist, and a FailedPrecondition error if no copies are available,
+	// the Copy is set aside for another member's Hold, or the Member
+	// is suspended or has reached their borrowing limit.
+	Checkout(ctx context.Context, in *CheckoutRequest, opts ...grpc.CallOption) (*Loan, error)
+	// Return ends a Loan and makes the copy available again,
+	// at the branch it was returned to.
+	// It returns a NotFound error if the Loan does not exist, and
+	// a FailedPrecondition error if it has already been returned.
+	Return(ctx context.Context, in *ReturnRequest, opts ...grpc.CallOption) (*Loan, error)
+	// Renew extends a Loan by a loan period, from its due time,
+	// or from now if it is overdue.
+	// It returns a NotFound error if the Loan does not exist, and a
+	// FailedPrecondition error if it has been returned, renewed the
+	// maximum number of times, or other members hold the Book.
+	Renew(ctx context.Context, in *RenewRequest, opts ...grpc.CallOption) (*Loan, error)
+	// ListLoans returns a page of Loans, oldest first.
+	// Only current loans are listed, unless include_returned is set.
+	ListLoans(ctx context.Context, in *ListLoansRequest, opts ...grpc.CallOption) (*ListLoansResponse, error)
+	// PlaceHold queues a member for a Book whose copies are all
+	// on loan, and returns the Hold. Returned copies are set aside
+	// for the first Hold in the queue, which the member may check out
+	// until the Hold expires. It returns a FailedPrecondition error if
+	// a copy is available or the member is suspended, and an
+	// AlreadyExists error if the member already has a Hold on the Book.
+	PlaceHold(ctx context.Context, in *PlaceHoldRequest, opts ...grpc.CallOption) (*Hold, error)
+	// CancelHold cancels a Hold and returns it. Any copy set aside
+	// for the Hold is offered to the next member in the queue.
+	// It returns a NotFound error if the Hold does not exist, and a
+	// FailedPrecondition error if it is no longer waiting or ready.
+	CancelHold(ctx context.Context, in *CancelHoldRequest, opts ...grpc.CallOption) (*Hold, error)
+	// ListHolds returns a page of Holds, oldest first. Only waiting
+	// and ready holds are listed, unless include_closed is set.
+	ListHolds(ctx context.Context, in *ListHoldsRequest, opts ...grpc.CallOption) (*ListHoldsResponse, error)
+	// WatchHolds streams the open Holds of a member, followed
+	// by every change to them, such as a copy being set aside.
+	// It returns a NotFound error if the member does not exist,
+	// and a FailedPrecondition error if the member is suspended.
+	WatchHolds(ctx context.Context, in *WatchHoldsRequest, opts ...grpc.CallOption) (LendingService_WatchHoldsClient, error)
+	// CreateBranch adds a branch to the library and
+	// returns the Branch, with its ID.
+	CreateBranch(ctx context.Context, in *CreateBranchRequest, opts ...grpc.CallOption) (*Branch, error)
+	// GetBranch returns the Branch with the ID provided.
+	GetBranch(ctx context.Context, in *GetBranchRequest, opts ...grpc.CallOption) (*Branch, error)
+	// ListBranches returns every Branch of the library.
+	ListBranches(ctx context.Context, in *ListBranchesRequest, opts ...grpc.CallOption) (*ListBranchesResponse, error)
+	// AddCopy adds a Copy of a Book to a Branch, and returns it.
+	// The copies of the Book increase by one. It returns a NotFound
+	// error if the Book does not exist, and an AlreadyExists error
+	// if a Copy with the same barcode exists.
+	AddCopy(ctx context.Context, in *AddCopyRequest, opts ...grpc.CallOption) (*Copy, error)
+	// GetCopy returns the Copy with the barcode provided.
+	GetCopy(ctx context.Context, in *GetCopyRequest, opts ...grpc.CallOption) (*Copy, error)
+	// GetAvailability returns how many copies of a Book each Branch has,
+	// and how many of them are available or on their way to the Branch.
+	GetAvailability(ctx context.Context, in *GetAvailabilityRequest, opts ...grpc.CallOption) (*Availability, error)
+	// TransferCopy sends an available Copy to another Branch, and
+	// returns it in transit. It can't be checked out until it is
+	// received. It returns a FailedPrecondition error if the Copy
+	// is not available, or is needed for a ready Hold.
+	TransferCopy(ctx context.Context, in *TransferCopyRequest, opts ...grpc.CallOption) (*Copy, error)
+	// ReceiveCopy marks a Copy in transit as arrived at the Branch
+	// it was sent to, and returns it available again. It returns a
+	// FailedPrecondition error if the Copy is not in transit.
+	ReceiveCopy(ctx context.Context, in *ReceiveCopyRequest, opts ...grpc.CallOption) (*Copy, error)
+}
+
+type lendingServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewLendingServiceClient(cc *grpc.ClientConn) LendingServiceClient {
+	return &lendingServiceClient{cc}
+}
+
+func (c *lendingServiceClient) Checkout(ctx context.Context, in *CheckoutRequest, opts ...grpc.CallOption) (*Loan, error) {
+	out := new(Loan)
+	err := grpc.Invoke(ctx, "/library.LendingService/Checkout", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lendingServiceClient) Return(ctx context.Context, in *ReturnRequest, opts ...grpc.CallOption) (*Loan, error) {
+	out := new(Loan)
+	err := grpc.Invoke(ctx, "/library.LendingService/Return", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lendingServiceClient) Renew(ctx context.Context, in *RenewRequest, opts ...grpc.CallOption) (*Loan, error) {
+	out := new(Loan)
+	err := grpc.Invoke(ctx, "/library.LendingService/Renew", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lendingServiceClient) ListLoans(ctx context.Context, in *ListLoansRequest, opts ...grpc.CallOption) (*ListLoansResponse, error) {
+	out := new(ListLoansResponse)
+	err := grpc.Invoke(ctx, "/library.LendingService/ListLoans", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lendingServiceClient) PlaceHold(ctx context.Context, in *PlaceHoldRequest, opts ...grpc.CallOption) (*Hold, error) {
+	out := new(Hold)
+	err := grpc.Invoke(ctx, "/library.LendingService/PlaceHold", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lendingServiceClient) CancelHold(ctx context.Context, in *CancelHoldRequest, opts ...grpc.CallOption) (*Hold, error) {
+	out := new(Hold)
+	err := grpc.Invoke(ctx, "/library.LendingService/CancelHold", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lendingServiceClient) ListHolds(ctx context.Context, in *ListHoldsRequest, opts ...grpc.CallOption) (*ListHoldsResponse, error) {
+	out := new(ListHoldsResponse)
+	err := grpc.Invoke(ctx, "/library.LendingService/ListHolds", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lendingServiceClient) WatchHolds(ctx context.Context, in *WatchHoldsRequest, opts ...grpc.CallOption) (LendingService_WatchHoldsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_LendingService_serviceDesc.Streams[0], c.cc, "/library.LendingService/WatchHolds", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lendingServiceWatchHoldsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LendingService_WatchHoldsClient interface {
+	Recv() (*Hold, error)
+	grpc.ClientStream
+}
+
+type lendingServiceWatchHoldsClient struct {
+	grpc.ClientStream
+}
+
+func (x *lendingServiceWatchHoldsClient) Recv() (*Hold, error) {
+	m := new(Hold)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lendingServiceClient) CreateBranch(ctx context.Context, in *CreateBranchRequest, opts ...grpc.CallOption) (*Branch, error) {
+	out := new(Branch)
+	err := grpc.Invoke(ctx, "/library.LendingService/CreateBranch", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lendingServiceClient) GetBranch(ctx context.Context, in *GetBranchRequest, opts ...grpc.CallOption) (*Branch, error) {
+	out := new(Branch)
+	err := grpc.Invoke(ctx, "/library.LendingService/GetBranch", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lendingServiceClient) ListBranches(ctx context.Context, in *ListBranchesRequest, opts ...grpc.CallOption) (*ListBranchesResponse, error) {
+	out := new(ListBranchesResponse)
+	err := grpc.Invoke(ctx, "/library.LendingService/ListBranches", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lendingServiceClient) AddCopy(ctx context.Context, in *AddCopyRequest, opts ...grpc.CallOption) (*Copy, error) {
+	out := new(Copy)
+	err := grpc.Invoke(ctx, "/library.LendingService/AddCopy", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lendingServiceClient) GetCopy(ctx context.Context, in *GetCopyRequest, opts ...grpc.CallOption) (*Copy, error) {
+	out := new(Copy)
+	err := grpc.Invoke(ctx, "/library.LendingService/GetCopy", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lendingServiceClient) GetAvailability(ctx context.Context, in *GetAvailabilityRequest, opts ...grpc.CallOption) (*Availability, error) {
+	out := new(Availability)
+	err := grpc.Invoke(ctx, "/library.LendingService/GetAvailability", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lendingServiceClient) TransferCopy(ctx context.Context, in *TransferCopyRequest, opts ...grpc.CallOption) (*Copy, error) {
+	out := new(Copy)
+	err := grpc.Invoke(ctx, "/library.LendingService/TransferCopy", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lendingServiceClient) ReceiveCopy(ctx context.Context, in *ReceiveCopyRequest, opts ...grpc.CallOption) (*Copy, error) {
+	out := new(Copy)
+	err := grpc.Invoke(ctx, "/library.LendingService/ReceiveCopy", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for LendingService service
+
+type LendingServiceServer interface {
+	// Checkout lends a copy of a Book to a member and returns the Loan.
+	// Members with a ready Hold on the Book check out the copy set aside.
+	// If a barcode is provided, that Copy is marked as on loan.
+	// It returns a NotFound error if the Book, Copy or Member does not
+	// exist, and a FailedPrecondition error if no copies are available,
+	// the Copy is set aside for another member's Hold, or the Member
+	// is suspended or has reached their borrowing limit.
+	Checkout(context.Context, *CheckoutRequest) (*Loan, error)
+	// Return ends a Loan and makes the copy available again,
+	// at the branch it was returned to.
+	// It returns a NotFound error if the Loan does not exist, and
+	// a FailedPrecondition error if it has already been returned.
+	Return(context.Context, *ReturnRequest) (*Loan, error)
+	// Renew extends a Loan by a loan period, from its due time,
+	// or from now if it is overdue.
+	// It returns a NotFound error if the Loan does not exist, and a
+	// FailedPrecondition error if it has been returned, renewed the
+	// maximum number of times, or other members hold the Book.
+	Renew(context.Context, *RenewRequest) (*Loan, error)
+	// ListLoans returns a page of Loans, oldest first.
+	// Only current loans are listed, unless include_returned is set.
+	ListLoans(context.Context, *ListLoansRequest) (*ListLoansResponse, error)
+	// PlaceHold queues a member for a Book whose copies are all
+	// on loan, and returns the Hold. Returned copies are set aside
+	// for the first Hold in the queue, which the member may check out
+	// until the Hold expires. It returns a FailedPrecondition error if
+	// a copy is available or the member is suspended, and an
+	// AlreadyExists error if the member already has a Hold on the Book.
+	PlaceHold(context.Context, *PlaceHoldRequest) (*Hold, error)
+	// CancelHold cancels a Hold and returns it. Any copy set aside
+	// for the Hold is offered to the next member in the queue.
+	// It returns a NotFound error if the Hold does not exist, and a
+	// FailedPrecondition error if it is no longer waiting or ready.
+	CancelHold(context.Context, *CancelHoldRequest) (*Hold, error)
+	// ListHolds returns a page of Holds, oldest first. Only waiting
+	// and ready holds are listed, unless include_closed is set.
+	ListHolds(context.Context, *ListHoldsRequest) (*ListHoldsResponse, error)
+	// WatchHolds streams the open Holds of a member, followed
+	// by every change to them, such as a copy being set aside.
+	// It returns a NotFound error if the member does not exist,
+	// and a FailedPrecondition error if the member is suspended.
+	WatchHolds(*WatchHoldsRequest, LendingService_WatchHoldsServer) error
+	// CreateBranch adds a branch to the library and
+	// returns the Branch, with its ID.
+	CreateBranch(context.Context, *CreateBranchRequest) (*Branch, error)
+	// GetBranch returns the Branch with the ID provided.
+	GetBranch(context.Context, *GetBranchRequest) (*Branch, error)
+	// ListBranches returns every Branch of the library.
+	ListBranches(context.Context, *ListBranchesRequest) (*ListBranchesResponse, error)
+	// AddCopy adds a Copy of a Book to a Branch, and returns it.
+	// The copies of the Book increase by one. It returns a NotFound
+	// error if the Book does not exist, and an AlreadyExists error
+	// if a Copy with the same barcode exists.
+	AddCopy(context.Context, *AddCopyRequest) (*Copy, error)
+	// GetCopy returns the Copy with the barcode provided.
+	GetCopy(context.Context, *GetCopyRequest) (*Copy, error)
+	// GetAvailability returns how many copies of a Book each Branch has,
+	// and how many of them are available or on their way to the Branch.
+	GetAvailability(context.Context, *GetAvailabilityRequest) (*Availability, error)
+	// TransferCopy sends an available Copy to another Branch, and
+	// returns it in transit. It can't be checked out until it is
+	// received. It returns a FailedPrecondition error if the Copy
+	// is not available, or is needed for a ready Hold.
+	TransferCopy(context.Context, *TransferCopyRequest) (*Copy, error)
+	// ReceiveCopy marks a Copy in transit as arrived at the Branch
+	// it was sent to, and returns it available again. It returns a
+	// FailedPrecondition error if the Copy is not in transit.
+	ReceiveCopy(context.Context, *ReceiveCopyRequest) (*Copy, error)
+}
+
+func RegisterLendingServiceServer(s *grpc.Server, srv LendingServiceServer) {
+	s.RegisterService(&_LendingService_serviceDesc, srv)
+}
+
+func _LendingService_Checkout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LendingServiceServer).Checkout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.LendingService/Checkout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LendingServiceServer).Checkout(ctx, req.(*CheckoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LendingService_Return_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LendingServiceServer).Return(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.LendingService/Return",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LendingServiceServer).Return(ctx, req.(*ReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LendingService_Renew_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LendingServiceServer).Renew(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.LendingService/Renew",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LendingServiceServer).Renew(ctx, req.(*RenewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LendingService_ListLoans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLoansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LendingServiceServer).ListLoans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.LendingService/ListLoans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LendingServiceServer).ListLoans(ctx, req.(*ListLoansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LendingService_PlaceHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LendingServiceServer).PlaceHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.LendingService/PlaceHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LendingServiceServer).PlaceHold(ctx, req.(*PlaceHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LendingService_CancelHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LendingServiceServer).CancelHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.LendingService/CancelHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LendingServiceServer).CancelHold(ctx, req.(*CancelHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LendingService_ListHolds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHoldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LendingServiceServer).ListHolds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.LendingService/ListHolds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LendingServiceServer).ListHolds(ctx, req.(*ListHoldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LendingService_WatchHolds_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchHoldsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LendingServiceServer).WatchHolds(m, &lendingServiceWatchHoldsServer{stream})
+}
+
+type LendingService_WatchHoldsServer interface {
+	Send(*Hold) error
+	grpc.ServerStream
+}
+
+type lendingServiceWatchHoldsServer struct {
+	grpc.ServerStream
+}
+
+func (x *lendingServiceWatchHoldsServer) Send(m *Hold) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _LendingService_CreateBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LendingServiceServer).CreateBranch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.LendingService/CreateBranch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LendingServiceServer).CreateBranch(ctx, req.(*CreateBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LendingService_GetBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LendingServiceServer).GetBranch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.LendingService/GetBranch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LendingServiceServer).GetBranch(ctx, req.(*GetBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LendingService_ListBranches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBranchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LendingServiceServer).ListBranches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.LendingService/ListBranches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LendingServiceServer).ListBranches(ctx, req.(*ListBranchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LendingService_AddCopy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCopyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LendingServiceServer).AddCopy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.LendingService/AddCopy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LendingServiceServer).AddCopy(ctx, req.(*AddCopyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LendingService_GetCopy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCopyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LendingServiceServer).GetCopy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.LendingService/GetCopy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LendingServiceServer).GetCopy(ctx, req.(*GetCopyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LendingService_GetAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LendingServiceServer).GetAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.LendingService/GetAvailability",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LendingServiceServer).GetAvailability(ctx, req.(*GetAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LendingService_TransferCopy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferCopyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LendingServiceServer).TransferCopy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.LendingService/TransferCopy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LendingServiceServer).TransferCopy(ctx, req.(*TransferCopyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LendingService_ReceiveCopy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceiveCopyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LendingServiceServer).ReceiveCopy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/library.LendingService/ReceiveCopy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LendingServiceServer).ReceiveCopy(ctx, req.(*ReceiveCopyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _LendingService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "library.LendingService",
+	HandlerType: (*LendingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Checkout",
+			Handler:    _LendingService_Checkout_Handler,
+		},
 		{
-			StreamName:    "BookChat",
-			Handler:       _BookService_BookChat_Handler,
+			MethodName: "Return",
+			Handler:    _LendingService_Return_Handler,
+		},
+		{
+			MethodName: "Renew",
+			Handler:    _LendingService_Renew_Handler,
+		},
+		{
+			MethodName: "ListLoans",
+			Handler:    _LendingService_ListLoans_Handler,
+		},
+		{
+			MethodName: "PlaceHold",
+			Handler:    _LendingService_PlaceHold_Handler,
+		},
+		{
+			MethodName: "CancelHold",
+			Handler:    _LendingService_CancelHold_Handler,
+		},
+		{
+			MethodName: "ListHolds",
+			Handler:    _LendingService_ListHolds_Handler,
+		},
+		{
+			MethodName: "CreateBranch",
+			Handler:    _LendingService_CreateBranch_Handler,
+		},
+		{
+			MethodName: "GetBranch",
+			Handler:    _LendingService_GetBranch_Handler,
+		},
+		{
+			MethodName: "ListBranches",
+			Handler:    _LendingService_ListBranches_Handler,
+		},
+		{
+			MethodName: "AddCopy",
+			Handler:    _LendingService_AddCopy_Handler,
+		},
+		{
+			MethodName: "GetCopy",
+			Handler:    _LendingService_GetCopy_Handler,
+		},
+		{
+			MethodName: "GetAvailability",
+			Handler:    _LendingService_GetAvailability_Handler,
+		},
+		{
+			MethodName: "TransferCopy",
+			Handler:    _LendingService_TransferCopy_Handler,
+		},
+		{
+			MethodName: "ReceiveCopy",
+			Handler:    _LendingService_ReceiveCopy_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchHolds",
+			Handler:       _LendingService_WatchHolds_Handler,
 			ServerStreams: true,
-			ClientStreams: true,
 		},
 	},
 	Metadata: "proto/library/book_service.proto",
@@ -738,45 +7285,295 @@ var _BookService_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("proto/library/book_service.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 630 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xb5, 0xfb, 0x95, 0x66, 0xda, 0x84, 0x74, 0x5b, 0xc0, 0xf2, 0xa5, 0x91, 0x8b, 0x84, 0x85,
-	0x84, 0x53, 0xd2, 0x03, 0x95, 0x10, 0x82, 0x24, 0xad, 0x08, 0x54, 0x55, 0x82, 0x29, 0x1c, 0xb8,
-	0x44, 0xb6, 0x33, 0xb5, 0xdd, 0xda, 0x5e, 0xb3, 0xbb, 0x81, 0xe6, 0xcc, 0x2f, 0xe2, 0xdf, 0x21,
-	0x4e, 0xc8, 0x5e, 0x3b, 0xa9, 0x29, 0x42, 0xe2, 0xb6, 0x6f, 0xe6, 0xbd, 0xf5, 0xbc, 0x37, 0x5a,
-	0x43, 0x3b, 0x65, 0x54, 0xd0, 0x4e, 0x14, 0xba, 0xcc, 0x61, 0xf3, 0x8e, 0x4b, 0xe9, 0xf5, 0x84,
-	0x23, 0xfb, 0x1a, 0x7a, 0x68, 0xe5, 0x2d, 0x52, 0x2b, 0x7a, 0xfa, 0xbe, 0x4f, 0xa9, 0x1f, 0x61,
-	0x27, 0x2f, 0xbb, 0xb3, 0xcb, 0x8e, 0x08, 0x63, 0xe4, 0xc2, 0x89, 0x53, 0xc9, 0xd4, 0x8f, 0xfd,
-	0x50, 0x04, 0x33, 0xd7, 0xf2, 0x68, 0xdc, 0xb9, 0xa2, 0x81, 0x93, 0xb8, 0xcc, 0x49, 0xa6, 0x01,
-	0x65, 0x5c, 0x2c, 0x45, 0xf2, 0x7b, 0x3e, 0x4d, 0x03, 0x64, 0x57, 0x5c, 0x2a, 0x8d, 0x7d, 0xa8,
-	0x8f, 0x67, 0x6e, 0x14, 0xf2, 0x00, 0x19, 0x21, 0xb0, 0x96, 0x38, 0x31, 0x6a, 0x6a, 0x5b, 0x35,
-	0xeb, 0x76, 0x7e, 0x36, 0x7e, 0xac, 0xc0, 0x5a, 0x9f, 0xd2, 0xeb, 0xac, 0x19, 0x72, 0x37, 0xc9,
-	0x9b, 0xab, 0x76, 0x7e, 0x26, 0x7b, 0xb0, 0x2e, 0x42, 0x11, 0xa1, 0xb6, 0x92, 0x2b, 0x24, 0x20,
-	0x0f, 0x60, 0xc3, 0x99, 0x89, 0x80, 0x32, 0x6d, 0x35, 0x2f, 0x17, 0x88, 0x58, 0x50, 0xcf, 0x5d,
-	0x8a, 0x79, 0x8a, 0xda, 0x5a, 0x5b, 0x35, 0x9b, 0xdd, 0x1d, 0xab, 0xf0, 0x68, 0x65, 0xdf, 0xb8,
-	0x98, 0xa7, 0x68, 0x6f, 0xba, 0xc5, 0x89, 0x3c, 0x86, 0x26, 0xc7, 0xe8, 0x72, 0x92, 0x16, 0x03,
-	0x4e, 0xb5, 0xf5, 0xb6, 0x6a, 0x6e, 0x0e, 0x15, 0xbb, 0x91, 0xd5, 0xcb, 0xb9, 0xa7, 0xa4, 0x0b,
-	0xf5, 0x92, 0xc3, 0xb4, 0x8d, 0xb6, 0x6a, 0x6e, 0x75, 0xc9, 0xe2, 0xe2, 0x85, 0xbd, 0xa1, 0x62,
-	0x2f, 0x69, 0xe4, 0x14, 0x5a, 0x39, 0xf0, 0x1c, 0x11, 0xd2, 0x64, 0x32, 0x75, 0x04, 0x6a, 0xb5,
-	0x5c, 0xaa, 0x5b, 0x32, 0x6e, 0xab, 0x4c, 0xce, 0xba, 0x28, 0xe3, 0xb6, 0xef, 0xdd, 0xd2, 0x9c,
-	0x38, 0x02, 0xfb, 0xbb, 0xb0, 0x53, 0xdc, 0x19, 0x26, 0xfe, 0x24, 0x46, 0x11, 0xd0, 0xa9, 0xf1,
-	0x08, 0x9a, 0x6f, 0x50, 0x64, 0x8e, 0x6c, 0xfc, 0x32, 0x43, 0x2e, 0xfe, 0x16, 0x9e, 0x71, 0x0c,
-	0x3b, 0xef, 0x67, 0xc8, 0xe6, 0x19, 0x8f, 0x97, 0xc4, 0x03, 0x68, 0xc8, 0xb4, 0x26, 0x29, 0xc3,
-	0xcb, 0xf0, 0xa6, 0xd8, 0xc5, 0xb6, 0x2c, 0x8e, 0xf3, 0x9a, 0xf1, 0x0c, 0x60, 0x40, 0xa3, 0x08,
-	0xbd, 0x6c, 0x0c, 0x72, 0x00, 0xeb, 0x59, 0x64, 0x5c, 0x53, 0xdb, 0xab, 0xe6, 0x56, 0xb7, 0x51,
-	0x89, 0xd4, 0x96, 0x3d, 0xe3, 0x1d, 0x6c, 0x65, 0xf0, 0x1c, 0x39, 0x77, 0x7c, 0x24, 0x7b, 0xb7,
-	0x37, 0x3d, 0x54, 0xe4, 0xae, 0x89, 0x0e, 0xb5, 0x58, 0x12, 0xe4, 0x42, 0x87, 0x8a, 0x5d, 0x16,
-	0xfa, 0x75, 0xa8, 0x79, 0x34, 0x11, 0x98, 0x08, 0xc3, 0x84, 0x6d, 0xe9, 0x8d, 0xa7, 0x34, 0xe1,
-	0x48, 0xb4, 0x3f, 0x64, 0x0b, 0xd1, 0x93, 0xe7, 0xb0, 0x59, 0xee, 0x95, 0x34, 0xa0, 0x3e, 0xec,
-	0xd9, 0x27, 0x83, 0xd1, 0xa7, 0x53, 0xbb, 0xa5, 0x64, 0x70, 0xdc, 0x1b, 0x9f, 0xda, 0xfd, 0xde,
-	0xe0, 0xac, 0xa5, 0x66, 0xb0, 0xf7, 0xf1, 0xe4, 0xed, 0xa8, 0x3f, 0x1a, 0x9d, 0xb5, 0x56, 0xba,
-	0x3f, 0x55, 0x39, 0xef, 0x07, 0xf9, 0x20, 0xc8, 0x11, 0xd4, 0x8a, 0x44, 0xc9, 0xc3, 0x85, 0xbf,
-	0x6a, 0xc6, 0x7a, 0xd5, 0xb8, 0xa1, 0x90, 0x17, 0x00, 0xcb, 0x80, 0x89, 0xbe, 0x68, 0xdf, 0x49,
-	0xfd, 0x8e, 0xf4, 0x50, 0x25, 0xc7, 0xd0, 0x3c, 0x77, 0xae, 0xf1, 0x56, 0xce, 0x55, 0x92, 0xbe,
-	0xbb, 0x80, 0x4b, 0x8e, 0xa1, 0x98, 0x2a, 0x79, 0x29, 0x4d, 0x0f, 0x02, 0x47, 0x90, 0xbd, 0x8a,
-	0xa6, 0x48, 0x5f, 0xbf, 0x5f, 0x5d, 0x51, 0x91, 0x63, 0x26, 0x3e, 0x54, 0xfb, 0xdf, 0xd5, 0x5f,
-	0xaf, 0x5f, 0xfd, 0xe3, 0x3d, 0xfb, 0x2c, 0xf5, 0xbe, 0xa1, 0xfb, 0x14, 0x6f, 0x9c, 0x38, 0x8d,
-	0xb0, 0xe3, 0x45, 0x21, 0x26, 0xc5, 0x33, 0x2f, 0xff, 0x26, 0x9f, 0xff, 0xe7, 0x82, 0xec, 0xa7,
-	0x83, 0xac, 0x7a, 0x81, 0xbb, 0x91, 0xc3, 0xa3, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa6, 0x8e,
-	0x45, 0x37, 0xa6, 0x04, 0x00, 0x00,
+	// 4639 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x7b, 0xcd, 0x73, 0x1b, 0xc7,
+	0x72, 0x38, 0x17, 0x00, 0xf1, 0xd1, 0xf8, 0x20, 0x38, 0xa4, 0x28, 0x18, 0xb6, 0x2c, 0x7a, 0x5d,
+	0x7e, 0xa6, 0x24, 0x3f, 0x4a, 0xa6, 0xca, 0x1f, 0x2a, 0x3d, 0x5b, 0x02, 0x40, 0x48, 0xa4, 0x45,
+	0x91, 0xfc, 0x2d, 0x29, 0xf9, 0xf9, 0x97, 0x54, 0xc1, 0x4b, 0xec, 0x10, 0x58, 0x71, 0x81, 0x85,
+	0x77, 0x17, 0x92, 0xe8, 0x97, 0x4a, 0x2a, 0x95, 0xaa, 0xa4, 0x52, 0xa9, 0xfc, 0x05, 0x39, 0xa5,
+	0x2a, 0xa9, 0x7a, 0x39, 0xbc, 0x5b, 0xee, 0x39, 0xbc, 0x3f, 0x20, 0xa7, 0x1c, 0xdf, 0x35, 0x7f,
+	0x44, 0x4e, 0xa9, 0xf9, 0xda, 0x9d, 0xd9, 0x5d, 0x90, 0x90, 0xe4, 0x9c, 0x88, 0xed, 0xee, 0xe9,
+	0xe9, 0x99, 0xe9, 0xee, 0xe9, 0xe9, 0x6e, 0xc2, 0xfa, 0xc4, 0x73, 0x03, 0xf7, 0xb6, 0x63, 0x9f,
+	0x78, 0xa6, 0x77, 0x7e, 0xfb, 0xc4, 0x75, 0xcf, 0x7a, 0x3e, 0xf6, 0x5e, 0xda, 0x7d, 0xbc, 0x49,
+	0x51, 0xa8, 0xc0, 0x71, 0xcd, 0xf5, 0x81, 0xeb, 0x0e, 0x1c, 0x7c, 0x9b, 0x82, 0x4f, 0xa6, 0xa7,
+	0xb7, 0x4f, 0x6d, 0xec, 0x58, 0xbd, 0x91, 0xe9, 0x9f, 0x31, 0xd2, 0xe6, 0xf5, 0x38, 0x45, 0x60,
+	0x8f, 0xb0, 0x1f, 0x98, 0xa3, 0x09, 0x27, 0xf8, 0x30, 0x4e, 0xf0, 0xca, 0x33, 0x27, 0x13, 0xec,
+	0xf9, 0x1c, 0xff, 0xf5, 0xc0, 0x0e, 0x86, 0xd3, 0x93, 0xcd, 0xbe, 0x3b, 0xba, 0xfd, 0xc2, 0x1d,
+	0x9a, 0xe3, 0x13, 0xcf, 0x1c, 0x5b, 0x43, 0xd7, 0xf3, 0x83, 0x68, 0x0c, 0x93, 0x78, 0xe0, 0x4e,
+	0x86, 0xd8, 0x7b, 0xc1, 0x47, 0xea, 0xd7, 0xa1, 0x74, 0x38, 0x3d, 0x71, 0x6c, 0x7f, 0x88, 0x3d,
+	0x84, 0x20, 0x37, 0x36, 0x47, 0xb8, 0xa1, 0xad, 0x6b, 0x1b, 0x25, 0x83, 0xfe, 0xd6, 0xff, 0x4e,
+	0x83, 0x7c, 0x6b, 0x1a, 0x0c, 0x5d, 0x0f, 0xd5, 0x20, 0x63, 0x5b, 0x1c, 0x99, 0xb1, 0xad, 0x90,
+	0x3c, 0x13, 0x91, 0xa3, 0xf7, 0xa1, 0xe4, 0xbb, 0x5e, 0xd0, 0xa3, 0x88, 0x2c, 0x45, 0x14, 0x09,
+	0x60, 0x9f, 0x20, 0xaf, 0x01, 0x9c, 0xd8, 0x5e, 0x30, 0xec, 0x9d, 0x63, 0xd3, 0x6b, 0xe4, 0xd6,
+	0xb5, 0x8d, 0x45, 0xa3, 0x44, 0x21, 0x3f, 0x60, 0xd3, 0x23, 0x68, 0x0b, 0x9b, 0x02, 0xbd, 0xc8,
+	0xd0, 0x14, 0x42, 0xd0, 0xfa, 0x3f, 0x6a, 0x90, 0xfb, 0xde, 0xf5, 0xce, 0x12, 0x72, 0xac, 0xc2,
+	0x62, 0x60, 0x07, 0x8e, 0x10, 0x84, 0x7d, 0x10, 0x6e, 0x26, 0x95, 0xbb, 0x67, 0x5b, 0x7e, 0x23,
+	0xbb, 0x9e, 0xdd, 0x28, 0x19, 0x25, 0x06, 0xd9, 0xb5, 0x7c, 0x2a, 0x28, 0xf6, 0x6c, 0xec, 0xf7,
+	0x6c, 0xab, 0x91, 0xe3, 0x82, 0x52, 0xc0, 0xae, 0x85, 0x3e, 0x86, 0x2a, 0x47, 0x8e, 0xa7, 0xa3,
+	0x13, 0x2c, 0x84, 0xa9, 0x30, 0xe0, 0x3e, 0x85, 0xe9, 0x9f, 0x41, 0xfe, 0x88, 0x7e, 0xcf, 0xb3,
+	0x31, 0xba, 0x01, 0x85, 0xa3, 0xe9, 0xc9, 0x0b, 0xdc, 0x0f, 0x08, 0xba, 0xef, 0x5a, 0xe1, 0x36,
+	0x93, 0xdf, 0xa9, 0x7b, 0x79, 0x1d, 0xca, 0x13, 0xd3, 0xc3, 0xe3, 0xa0, 0x47, 0xc9, 0xd9, 0x6e,
+	0x02, 0x03, 0x75, 0x5c, 0x0b, 0xeb, 0x5b, 0x50, 0x3c, 0x36, 0x07, 0x1d, 0x77, 0x3a, 0x0e, 0x50,
+	0x1d, 0xb2, 0x81, 0x39, 0xe0, 0x3c, 0xc9, 0x4f, 0xb2, 0x2d, 0x7d, 0x82, 0xa2, 0x3c, 0x17, 0x0d,
+	0xf6, 0xa1, 0x9f, 0x41, 0x65, 0xcf, 0xed, 0x9b, 0x8e, 0xfd, 0xb3, 0x19, 0xd8, 0xee, 0x98, 0x2c,
+	0xd5, 0x31, 0xc7, 0x83, 0xa9, 0x39, 0xc0, 0x3d, 0x49, 0xaa, 0x8a, 0x00, 0x92, 0x89, 0x66, 0xec,
+	0xf0, 0x3a, 0x94, 0x2d, 0xec, 0xf7, 0x3d, 0x7b, 0x42, 0x38, 0x71, 0xf9, 0x64, 0x90, 0xfe, 0x9f,
+	0x05, 0xc8, 0xb5, 0x5d, 0xf7, 0x0c, 0x7d, 0x0c, 0x65, 0x07, 0x0f, 0xcc, 0xfe, 0x79, 0xcf, 0xf6,
+	0x4f, 0xc6, 0x74, 0x8e, 0x6c, 0x3b, 0xd3, 0xd0, 0x0c, 0x60, 0xe0, 0x5d, 0xff, 0x64, 0x3c, 0x63,
+	0x96, 0x26, 0xe4, 0xd9, 0xa9, 0xb1, 0x09, 0xe8, 0x28, 0x0e, 0x41, 0x9b, 0x50, 0xa2, 0x96, 0x17,
+	0x9c, 0x4f, 0x30, 0x3d, 0xc4, 0xda, 0xd6, 0xf2, 0x26, 0xb7, 0xbb, 0x4d, 0x32, 0xf1, 0xf1, 0xf9,
+	0x04, 0x1b, 0xc5, 0x13, 0xfe, 0x0b, 0x7d, 0x0a, 0x35, 0x1f, 0x3b, 0xa7, 0xbd, 0x09, 0x57, 0x79,
+	0x8b, 0x1e, 0x6c, 0x71, 0x67, 0xc1, 0xa8, 0x12, 0xb8, 0xb0, 0x04, 0x0b, 0x6d, 0x41, 0x49, 0xd0,
+	0x78, 0x8d, 0xfc, 0xba, 0xb6, 0x51, 0xde, 0x42, 0x21, 0x63, 0x41, 0xe6, 0xed, 0x2c, 0x18, 0x11,
+	0x19, 0xea, 0x42, 0x9d, 0x7e, 0xf4, 0xe9, 0xc6, 0xf6, 0x2c, 0x33, 0xc0, 0x8d, 0x02, 0x1d, 0xda,
+	0xdc, 0x64, 0xf6, 0xbb, 0x29, 0x6c, 0x71, 0xf3, 0x58, 0x18, 0xb8, 0xb1, 0x24, 0x8d, 0xd9, 0x36,
+	0x03, 0xaa, 0x09, 0x74, 0x8f, 0x8a, 0x4c, 0x13, 0xc8, 0x6f, 0xb4, 0x06, 0x79, 0xf2, 0xf7, 0xf3,
+	0x3b, 0x8d, 0x12, 0x85, 0xf2, 0x2f, 0x42, 0x8b, 0xc9, 0xa9, 0x03, 0xa3, 0x25, 0xbf, 0xd1, 0x7d,
+	0x72, 0x2a, 0x0e, 0x0e, 0x70, 0x8f, 0x78, 0x91, 0x46, 0xf9, 0x52, 0x09, 0x80, 0x91, 0x13, 0x00,
+	0x99, 0xa8, 0xef, 0x4e, 0x6c, 0xec, 0x37, 0x2a, 0x54, 0x69, 0xf8, 0x17, 0xba, 0x01, 0x75, 0xf3,
+	0xa5, 0x69, 0x3b, 0xe6, 0x89, 0x83, 0x7b, 0x0c, 0xd6, 0xa8, 0x52, 0x8a, 0xa5, 0x10, 0xde, 0x61,
+	0xa4, 0x9f, 0x40, 0xcd, 0x7c, 0x89, 0x3d, 0xa2, 0x4f, 0x9e, 0x19, 0xd8, 0xe3, 0x41, 0xa3, 0xb6,
+	0xae, 0x6d, 0x68, 0x46, 0x95, 0x43, 0x0d, 0x0a, 0x44, 0x1f, 0x41, 0xc5, 0xc3, 0x2f, 0x6d, 0xfc,
+	0xaa, 0xc7, 0x94, 0x74, 0x89, 0x72, 0x2b, 0x33, 0x18, 0x53, 0x69, 0xd5, 0x82, 0xeb, 0x71, 0x0b,
+	0xbe, 0x0a, 0x85, 0x57, 0xae, 0x77, 0x46, 0xec, 0x77, 0x99, 0xed, 0x0a, 0xf9, 0xdc, 0xb5, 0x88,
+	0x69, 0xf7, 0xdd, 0x97, 0xd8, 0xeb, 0x4d, 0x3d, 0xa7, 0x81, 0x98, 0x69, 0x53, 0xc0, 0x33, 0xcf,
+	0xa1, 0xa6, 0xcd, 0xec, 0x90, 0xaa, 0xbb, 0xdf, 0x58, 0xa1, 0x7c, 0x2b, 0x1c, 0x48, 0xd4, 0x9d,
+	0xac, 0x21, 0x17, 0x98, 0x03, 0xbf, 0xb1, 0xba, 0x9e, 0xdd, 0x28, 0x4b, 0x2a, 0x25, 0xac, 0xcd,
+	0xa0, 0xe8, 0xa4, 0xed, 0x5c, 0x49, 0xb1, 0x9d, 0x8f, 0xa1, 0xea, 0x7a, 0xf6, 0xc0, 0x1e, 0x9b,
+	0x0e, 0x53, 0xfe, 0x35, 0x46, 0x24, 0x80, 0x54, 0xf5, 0x63, 0xa6, 0x74, 0x35, 0x61, 0x4a, 0xe8,
+	0x3e, 0x54, 0x1d, 0xc9, 0x6e, 0xfd, 0x46, 0x83, 0xca, 0x76, 0x25, 0x94, 0x4d, 0xb6, 0x6a, 0x43,
+	0xa5, 0x45, 0x77, 0xa1, 0xc4, 0x01, 0xd8, 0x6a, 0xbc, 0xb7, 0xae, 0xcd, 0x1e, 0x18, 0xd1, 0xb5,
+	0x57, 0x60, 0x99, 0x2b, 0xb7, 0x3d, 0x1e, 0xf4, 0x46, 0x38, 0x18, 0xba, 0x96, 0xfe, 0x33, 0xd4,
+	0x1e, 0xe3, 0x80, 0x98, 0x96, 0x81, 0x7f, 0x9a, 0x62, 0x3f, 0x98, 0xcf, 0xb4, 0x85, 0x52, 0x67,
+	0x24, 0xa5, 0xbe, 0x0d, 0x8b, 0xa6, 0xdf, 0x73, 0x4f, 0x1b, 0xd9, 0x4b, 0x55, 0x34, 0x67, 0xfa,
+	0x07, 0xa7, 0xfa, 0xdf, 0xe7, 0x60, 0xf9, 0xff, 0x4d, 0xb1, 0x77, 0x4e, 0xa6, 0xf7, 0xa3, 0xf9,
+	0xab, 0x5c, 0x4b, 0x26, 0x1e, 0x3e, 0xb5, 0x5f, 0x0b, 0x07, 0xc6, 0x80, 0x87, 0x14, 0x86, 0xee,
+	0x00, 0x84, 0x8e, 0xc2, 0x6f, 0x64, 0xd6, 0xb3, 0xe9, 0x9e, 0xa2, 0x24, 0x3c, 0x85, 0x8f, 0x3a,
+	0xb0, 0x14, 0x7a, 0x89, 0x9e, 0x79, 0x1a, 0x60, 0x6f, 0x0e, 0x39, 0x6b, 0xe1, 0x90, 0xd6, 0x69,
+	0x20, 0xb9, 0x04, 0xca, 0xe4, 0x04, 0x9f, 0xba, 0x1e, 0x73, 0x53, 0xf3, 0xb8, 0x04, 0x32, 0xa6,
+	0x4d, 0x87, 0xa0, 0x0f, 0x64, 0x6f, 0xb4, 0x48, 0x97, 0x17, 0x01, 0x50, 0x2b, 0xe1, 0xd4, 0xf2,
+	0x33, 0xa6, 0x68, 0xbb, 0xae, 0xf3, 0xdc, 0x74, 0xa6, 0x38, 0xee, 0xee, 0xde, 0x83, 0xa2, 0xeb,
+	0x59, 0xd8, 0xeb, 0x9d, 0x9c, 0x53, 0x97, 0x55, 0x32, 0x0a, 0xf4, 0xbb, 0x7d, 0x1e, 0x9d, 0x52,
+	0x71, 0xbe, 0x53, 0x42, 0xb7, 0x60, 0xb9, 0xef, 0x3a, 0x8e, 0x39, 0xf1, 0x71, 0x0f, 0x5b, 0x36,
+	0x53, 0x56, 0xe2, 0xb6, 0x8a, 0x46, 0x5d, 0x20, 0xba, 0x1c, 0x9e, 0xb4, 0x46, 0x48, 0xb1, 0x46,
+	0xc4, 0xad, 0xb1, 0x4c, 0x71, 0xf4, 0xb7, 0xfe, 0xd7, 0x1a, 0xd4, 0xf7, 0x6c, 0x3f, 0x50, 0x54,
+	0xe1, 0x7d, 0x28, 0x4d, 0x88, 0x2d, 0xfa, 0xf6, 0xcf, 0xec, 0x1e, 0x5b, 0x34, 0x8a, 0x04, 0x70,
+	0x64, 0xff, 0x4c, 0xe3, 0x01, 0x8a, 0x0c, 0xdc, 0x33, 0x2c, 0x14, 0x91, 0x92, 0x1f, 0x13, 0x00,
+	0xf1, 0x7c, 0xa7, 0xb6, 0x23, 0x8e, 0xb9, 0x64, 0xf0, 0x2f, 0x65, 0x6b, 0x72, 0xca, 0xd6, 0xe8,
+	0x3f, 0xc2, 0xb2, 0x24, 0x82, 0x3f, 0x71, 0xc7, 0x3e, 0x31, 0xf7, 0x45, 0xa2, 0x44, 0x7e, 0x43,
+	0xa3, 0xf6, 0x59, 0x55, 0x94, 0xcc, 0x60, 0x38, 0xf4, 0x2b, 0x58, 0x1a, 0xe3, 0xd7, 0x41, 0x2f,
+	0x21, 0x50, 0x95, 0x80, 0x0f, 0x85, 0x50, 0xfa, 0x43, 0x40, 0x47, 0xd8, 0xf4, 0xfa, 0x43, 0x65,
+	0x99, 0xab, 0xb0, 0xf8, 0x13, 0x31, 0x03, 0xae, 0xe9, 0xec, 0x83, 0x40, 0x1d, 0x7b, 0x64, 0x87,
+	0xd7, 0x3d, 0xfd, 0xd0, 0x1f, 0xc1, 0x8a, 0xc2, 0x81, 0x4b, 0x79, 0x1b, 0x0a, 0x1e, 0xf6, 0xa7,
+	0x4e, 0x20, 0xe4, 0x8c, 0xdc, 0x01, 0x23, 0x37, 0x28, 0xd6, 0x10, 0x54, 0xfa, 0xef, 0xa0, 0x22,
+	0x23, 0xd0, 0x47, 0x90, 0x23, 0x4b, 0xa1, 0x22, 0x24, 0x56, 0x49, 0x51, 0x44, 0x20, 0xbf, 0x4f,
+	0x34, 0x3e, 0x43, 0xfd, 0x3f, 0xfb, 0x40, 0x5b, 0x00, 0x43, 0x7b, 0x30, 0x74, 0xec, 0xc1, 0x30,
+	0x60, 0x61, 0x99, 0x7c, 0xb5, 0xee, 0x08, 0x94, 0x21, 0x51, 0xe9, 0x36, 0x94, 0x42, 0x04, 0x61,
+	0x4b, 0x03, 0x68, 0xb1, 0x7a, 0xfa, 0x81, 0x1a, 0x50, 0xf0, 0xc7, 0xf6, 0x64, 0x82, 0x03, 0xbe,
+	0x93, 0xe2, 0x13, 0x7d, 0x06, 0x85, 0x91, 0x19, 0xf4, 0x87, 0x38, 0x39, 0xdb, 0x31, 0x7e, 0x1d,
+	0x18, 0xe6, 0x78, 0x80, 0x0d, 0x41, 0xa2, 0xdf, 0x85, 0x52, 0x08, 0xa5, 0x2b, 0x08, 0x4c, 0x2f,
+	0xe0, 0xba, 0xc4, 0x3e, 0x48, 0xa4, 0x85, 0xc7, 0x16, 0xdf, 0x66, 0xf2, 0x53, 0x7f, 0x01, 0x57,
+	0x0c, 0xdc, 0x77, 0x47, 0x23, 0x3c, 0xb6, 0x62, 0x27, 0x95, 0x0b, 0x9d, 0x62, 0x69, 0x67, 0x81,
+	0x3b, 0xbe, 0x6b, 0x50, 0x1a, 0x61, 0x12, 0x42, 0xf6, 0x6c, 0xc6, 0x86, 0xa0, 0x8a, 0x0c, 0xb4,
+	0x6b, 0x45, 0x07, 0x99, 0x95, 0x0e, 0xb2, 0x9d, 0x87, 0x9c, 0x8f, 0xb1, 0xa5, 0xff, 0x19, 0xac,
+	0xc5, 0xe7, 0xe2, 0x67, 0xda, 0x82, 0x25, 0x4f, 0x60, 0xf8, 0x1d, 0xc1, 0xce, 0xf6, 0x6a, 0xb8,
+	0x60, 0x43, 0xc1, 0x1b, 0x71, 0x7a, 0xfd, 0x8f, 0x1a, 0xd4, 0x54, 0x9a, 0x79, 0x0e, 0xfa, 0x4b,
+	0xc8, 0x7b, 0xd8, 0xf4, 0x5d, 0xa6, 0xc4, 0xb5, 0xad, 0x0f, 0x67, 0xcc, 0xb7, 0x69, 0x50, 0x2a,
+	0x83, 0x53, 0x47, 0x0a, 0x92, 0x95, 0x14, 0x44, 0x7f, 0x08, 0x79, 0x46, 0x87, 0x96, 0xa1, 0x6a,
+	0x74, 0x5b, 0xdb, 0xbd, 0xe3, 0x83, 0xc7, 0xdd, 0xe3, 0x9d, 0xae, 0x51, 0x5f, 0x40, 0x4b, 0x50,
+	0x3e, 0x6a, 0x3d, 0xed, 0xf6, 0x5a, 0xcf, 0x8e, 0x77, 0x0e, 0x8c, 0xba, 0x86, 0x10, 0xd4, 0x28,
+	0xa0, 0x7d, 0x70, 0xf0, 0xa4, 0x77, 0xfc, 0xc3, 0x61, 0xb7, 0x9e, 0xd1, 0xbf, 0x84, 0xe5, 0x8e,
+	0x87, 0xcd, 0x00, 0xcb, 0xd7, 0xd4, 0xe5, 0xeb, 0xd0, 0x7d, 0x58, 0x7e, 0x36, 0xb1, 0xde, 0x78,
+	0x1c, 0x89, 0xb8, 0xa6, 0x74, 0x1c, 0x7d, 0xd3, 0x35, 0x32, 0x33, 0x1c, 0xe5, 0x23, 0xa2, 0xa8,
+	0x4f, 0x4d, 0xff, 0xcc, 0x00, 0x46, 0x4e, 0x7e, 0xeb, 0xdf, 0xc2, 0x0a, 0x13, 0x96, 0x3d, 0xb2,
+	0xc4, 0xb4, 0x9f, 0x86, 0x51, 0x2f, 0x9b, 0x78, 0x29, 0x9c, 0x98, 0xd3, 0x71, 0xb4, 0xae, 0x43,
+	0xfd, 0x31, 0x0e, 0xd4, 0xc1, 0xb1, 0xf7, 0x88, 0xfe, 0x3b, 0x58, 0x61, 0x0b, 0x7b, 0xbb, 0x39,
+	0xde, 0x6d, 0x81, 0x87, 0x80, 0x9e, 0x4d, 0x1c, 0xd7, 0xb4, 0x3a, 0x24, 0x04, 0x13, 0x73, 0x23,
+	0xd9, 0x32, 0xb8, 0x5d, 0x88, 0x68, 0x36, 0x23, 0x45, 0xb3, 0x08, 0x72, 0x96, 0x19, 0x98, 0x54,
+	0x45, 0x2a, 0x06, 0xfd, 0x1d, 0x9d, 0x2f, 0x79, 0x0d, 0x4a, 0xe7, 0x44, 0xc2, 0xbf, 0xc4, 0x39,
+	0x51, 0x1a, 0x8a, 0xd2, 0xd7, 0x69, 0xec, 0x22, 0x0f, 0x8a, 0x6f, 0x54, 0xa8, 0x01, 0x6f, 0xc6,
+	0xf9, 0xdd, 0x36, 0x68, 0x13, 0x56, 0xc8, 0x35, 0x22, 0xee, 0x44, 0x31, 0xad, 0x14, 0xde, 0x6a,
+	0x72, 0x78, 0xab, 0xdf, 0x87, 0x55, 0x95, 0xfe, 0x0d, 0x6e, 0x9e, 0x48, 0xdd, 0xd8, 0xd3, 0x55,
+	0x52, 0x05, 0xf6, 0xb6, 0x4d, 0xa8, 0x02, 0xa7, 0xe3, 0x68, 0xae, 0x6e, 0xea, 0xe0, 0xf8, 0x2e,
+	0x7e, 0x01, 0x6b, 0x44, 0x40, 0x46, 0x44, 0x76, 0x49, 0xbe, 0xa0, 0xa3, 0x47, 0xb7, 0xa6, 0x3e,
+	0xba, 0xf5, 0x6f, 0xe1, 0x6a, 0x62, 0x58, 0xb4, 0x34, 0xb2, 0xf8, 0xe4, 0xd2, 0xe8, 0x19, 0x30,
+	0x9c, 0xde, 0x86, 0x55, 0xbe, 0x34, 0x16, 0x3c, 0x88, 0x49, 0x6f, 0x42, 0x81, 0x87, 0x13, 0x7c,
+	0x71, 0xf5, 0x68, 0x71, 0x9c, 0x52, 0x10, 0xe8, 0x9f, 0xc2, 0x32, 0x59, 0x9e, 0xca, 0x20, 0xe5,
+	0xbd, 0xae, 0xff, 0x15, 0xac, 0x32, 0x4d, 0x79, 0xfb, 0xc9, 0xde, 0x4d, 0x6b, 0x6e, 0xc2, 0xea,
+	0x36, 0x7d, 0xb7, 0xcd, 0x21, 0xec, 0xd7, 0x70, 0xa5, 0xed, 0xb9, 0xaf, 0x7c, 0x41, 0x1b, 0x9e,
+	0x47, 0x2c, 0xc3, 0xa0, 0x25, 0x32, 0x0c, 0x4f, 0x60, 0x2d, 0x3e, 0x92, 0x1f, 0xc9, 0xe7, 0x50,
+	0xe4, 0xeb, 0x48, 0x09, 0x21, 0x44, 0xf4, 0x46, 0x9e, 0x4a, 0x21, 0x99, 0xfe, 0x97, 0x50, 0x91,
+	0x31, 0x6f, 0xb4, 0x57, 0xd7, 0x78, 0x00, 0x2f, 0x67, 0x34, 0x68, 0xb4, 0xce, 0x58, 0x29, 0x71,
+	0x24, 0xa1, 0xc8, 0xf2, 0x84, 0x8d, 0x34, 0x9f, 0xbe, 0x0f, 0xb5, 0x63, 0x73, 0x20, 0x3b, 0xf7,
+	0x34, 0x2f, 0xb4, 0x06, 0x79, 0x76, 0x15, 0x73, 0x3f, 0xc4, 0xbf, 0x44, 0x82, 0x25, 0x1b, 0x26,
+	0x58, 0xf4, 0x43, 0xa8, 0x3f, 0x1b, 0x07, 0xbf, 0x24, 0xc7, 0x1b, 0xb0, 0x44, 0x4c, 0xe0, 0xd8,
+	0x1c, 0x84, 0x47, 0x14, 0x0d, 0xd6, 0xe4, 0xc1, 0xfa, 0x3d, 0xa8, 0x47, 0xa4, 0xfc, 0x4c, 0xc4,
+	0xb3, 0x55, 0xbb, 0xf0, 0xd9, 0xaa, 0xff, 0xc4, 0xec, 0x93, 0x39, 0xf9, 0x78, 0x00, 0x1d, 0xbe,
+	0xb8, 0x85, 0x7d, 0x8a, 0x07, 0xb7, 0x1a, 0x5d, 0x67, 0x2e, 0x8c, 0xae, 0xb3, 0xb1, 0xe8, 0x5a,
+	0x3f, 0x85, 0xab, 0x89, 0x29, 0xff, 0x2f, 0x02, 0xe6, 0x1f, 0x61, 0x99, 0x59, 0xc5, 0x2f, 0xf2,
+	0x42, 0x15, 0x17, 0x52, 0x36, 0xba, 0x90, 0xf4, 0x0d, 0x40, 0x06, 0xf6, 0x03, 0xd7, 0xc3, 0x97,
+	0x1c, 0xbb, 0xfe, 0xc7, 0x0c, 0x54, 0x18, 0xcd, 0x4b, 0xdb, 0xb7, 0xdd, 0x88, 0x9d, 0x16, 0xb1,
+	0x43, 0x2d, 0x28, 0xf7, 0x87, 0x24, 0xd8, 0x64, 0x39, 0x2c, 0x16, 0x40, 0xad, 0xab, 0x7b, 0xc0,
+	0xc7, 0x6f, 0x76, 0x28, 0x21, 0x7d, 0xa8, 0x42, 0x3f, 0xfc, 0x1d, 0x46, 0x28, 0xd9, 0x0b, 0x23,
+	0x14, 0x31, 0x0b, 0xc9, 0x09, 0x5d, 0xfe, 0x04, 0x15, 0xfc, 0x49, 0x4e, 0x08, 0x41, 0x6e, 0xea,
+	0x87, 0x0f, 0x4f, 0xfa, 0x9b, 0x24, 0x79, 0x18, 0x85, 0xd5, 0xa3, 0xf1, 0xb7, 0xdf, 0xc8, 0xd3,
+	0xc7, 0x59, 0x95, 0x43, 0xa9, 0xcf, 0xf2, 0xf5, 0x16, 0x40, 0x24, 0x34, 0x2a, 0x43, 0xa1, 0x63,
+	0x74, 0x5b, 0xc7, 0xdd, 0xed, 0xfa, 0x02, 0xf9, 0x78, 0x76, 0xb8, 0x4d, 0x3f, 0x34, 0xf2, 0xb1,
+	0xdd, 0xdd, 0xeb, 0x92, 0x8f, 0x0c, 0xaa, 0x40, 0xd1, 0xe8, 0x1e, 0x1d, 0x1f, 0x18, 0xdd, 0xed,
+	0x7a, 0x56, 0x7f, 0x01, 0x0d, 0xf1, 0xc8, 0x12, 0x1b, 0xe1, 0x5f, 0x64, 0x6c, 0xef, 0xa2, 0xa5,
+	0xaf, 0xe1, 0xbd, 0x94, 0xb9, 0xb8, 0x9e, 0xde, 0x85, 0x92, 0x27, 0x80, 0x09, 0x8f, 0x27, 0x0f,
+	0x31, 0x22, 0xba, 0xb9, 0xf5, 0xf6, 0xf7, 0x1a, 0x40, 0xc7, 0x75, 0x1c, 0xdc, 0xe7, 0x49, 0xd9,
+	0x39, 0x6c, 0x82, 0x5d, 0xbb, 0x19, 0x39, 0x0d, 0xee, 0xbe, 0x1a, 0x87, 0x0f, 0x58, 0xf6, 0x11,
+	0x26, 0x96, 0x73, 0x52, 0x62, 0x99, 0xa8, 0x03, 0xbd, 0x29, 0x99, 0x3a, 0x2c, 0xce, 0xa1, 0x0e,
+	0x94, 0x9c, 0x00, 0xf4, 0x5f, 0xc1, 0xea, 0x63, 0x1c, 0x44, 0xc2, 0xce, 0x8a, 0x02, 0x5e, 0x30,
+	0x2f, 0x13, 0x11, 0xca, 0xef, 0x57, 0x26, 0xa8, 0x26, 0x0b, 0xfa, 0x6e, 0x07, 0x77, 0x35, 0x31,
+	0x17, 0x3f, 0xb6, 0x2f, 0xa0, 0xdc, 0x8f, 0xc0, 0x7c, 0x43, 0x57, 0xc2, 0x0d, 0x95, 0xd6, 0x21,
+	0xd3, 0xcd, 0x7d, 0x70, 0xff, 0xa0, 0xc1, 0x55, 0x16, 0x08, 0x24, 0x77, 0xe4, 0x2e, 0x40, 0xc4,
+	0x92, 0x5f, 0x71, 0xa9, 0x33, 0x4b, 0x64, 0xef, 0x16, 0x14, 0xdc, 0x80, 0xab, 0xcc, 0xfd, 0x5d,
+	0x7e, 0x3c, 0xff, 0xa1, 0xc1, 0xd5, 0xee, 0xeb, 0x89, 0xeb, 0xa5, 0x1c, 0xe5, 0x27, 0x50, 0x8d,
+	0x24, 0x0a, 0xeb, 0x23, 0x3b, 0x0b, 0x46, 0x25, 0x02, 0xef, 0x92, 0x24, 0x39, 0xcf, 0x43, 0x08,
+	0x21, 0xc5, 0xd2, 0x12, 0x49, 0xba, 0x9d, 0x05, 0x91, 0xa5, 0xf8, 0x35, 0xe4, 0x4f, 0x5d, 0x6f,
+	0x64, 0xb2, 0x1b, 0xba, 0x26, 0x99, 0x10, 0x13, 0xe6, 0x11, 0x45, 0x1a, 0x9c, 0xa8, 0x5d, 0x84,
+	0xbc, 0xef, 0x4e, 0xbd, 0x3e, 0xfe, 0x2e, 0x57, 0xd4, 0xea, 0x19, 0x79, 0xa7, 0xf4, 0x1f, 0xa1,
+	0xcc, 0x17, 0x30, 0x9c, 0x8e, 0xcf, 0x48, 0x42, 0xb9, 0xef, 0x8e, 0x03, 0x12, 0xcc, 0x50, 0x57,
+	0xca, 0x96, 0x5a, 0xe6, 0x30, 0xea, 0x80, 0x9a, 0x50, 0x3c, 0xb5, 0x1d, 0x2c, 0x15, 0x5a, 0xc2,
+	0xef, 0xd4, 0x87, 0xc6, 0x3e, 0x2c, 0x7f, 0x4f, 0xf2, 0x02, 0xca, 0x1d, 0x19, 0x25, 0x8a, 0x34,
+	0x25, 0x51, 0x44, 0x13, 0xda, 0xfe, 0x74, 0xa4, 0xaa, 0x4b, 0x99, 0xc1, 0x98, 0xb2, 0xfc, 0x97,
+	0x06, 0x25, 0xc2, 0xab, 0xfb, 0x12, 0x8f, 0x03, 0x74, 0x0b, 0x72, 0xa1, 0xa0, 0x35, 0xe9, 0x91,
+	0x1e, 0x52, 0x6c, 0x52, 0x57, 0x9f, 0x0b, 0x64, 0x27, 0x9f, 0x99, 0xed, 0xe4, 0x9b, 0x50, 0x14,
+	0x8e, 0x87, 0xae, 0x22, 0x6b, 0x84, 0xdf, 0x09, 0xe1, 0x72, 0x49, 0xe1, 0xee, 0x41, 0x8e, 0x6e,
+	0x52, 0x05, 0x8a, 0xe4, 0x31, 0xfd, 0xb4, 0x65, 0x3c, 0xa9, 0x2f, 0xa0, 0x12, 0x2c, 0xb6, 0xb6,
+	0xb7, 0x85, 0x93, 0x16, 0x1e, 0x3b, 0x23, 0x7b, 0xec, 0xac, 0xfe, 0x87, 0x0c, 0xe4, 0xf6, 0x5c,
+	0x73, 0x9c, 0x56, 0x08, 0x4b, 0x5c, 0xaa, 0x51, 0x40, 0x93, 0x55, 0xa2, 0xa1, 0x07, 0x50, 0xed,
+	0x0f, 0x71, 0xff, 0xcc, 0x9d, 0x06, 0xf3, 0xde, 0x52, 0x15, 0x31, 0x80, 0x80, 0xd0, 0x17, 0x50,
+	0xb4, 0xa6, 0x73, 0xbb, 0xb4, 0x82, 0x35, 0x65, 0xd7, 0x1b, 0xdd, 0xb6, 0x31, 0x7e, 0x65, 0x3a,
+	0x3e, 0x4d, 0x9c, 0x2e, 0x1a, 0xe1, 0x37, 0x31, 0x46, 0x0f, 0x07, 0x53, 0x6f, 0xcc, 0xb8, 0x5e,
+	0x5e, 0xcd, 0x01, 0x46, 0x4e, 0x19, 0x37, 0xa0, 0x70, 0x62, 0x7a, 0x34, 0xb0, 0x66, 0xb5, 0x1c,
+	0xf1, 0xa9, 0x7f, 0x0f, 0x4b, 0x1d, 0x2e, 0xf9, 0xdb, 0xc4, 0x8d, 0x12, 0xe3, 0xac, 0xca, 0xf8,
+	0x37, 0x50, 0x35, 0xa8, 0x00, 0x33, 0xac, 0x9e, 0x38, 0x59, 0x52, 0x1a, 0xee, 0x0f, 0xc3, 0xd4,
+	0x93, 0x51, 0x64, 0x80, 0x5d, 0x4b, 0xff, 0x10, 0x2a, 0x06, 0x59, 0xf9, 0x2c, 0x97, 0xf1, 0xaf,
+	0x3c, 0xe7, 0x4a, 0x8e, 0xfa, 0xb2, 0xf8, 0x34, 0xf5, 0xe8, 0x6f, 0x40, 0xdd, 0x1e, 0xf7, 0x9d,
+	0xa9, 0x85, 0x7b, 0x6c, 0x9f, 0xb0, 0x45, 0x57, 0x50, 0x34, 0x96, 0x38, 0xdc, 0xe0, 0x60, 0xf5,
+	0x36, 0xc8, 0x5d, 0x78, 0x1b, 0x2c, 0xc6, 0x6f, 0x03, 0x9e, 0x97, 0xe5, 0x62, 0x46, 0x61, 0xa6,
+	0xe3, 0x9a, 0xe1, 0x0d, 0x50, 0x95, 0xca, 0x1f, 0xe6, 0xd8, 0x60, 0xb8, 0xb9, 0xbd, 0xfe, 0xbf,
+	0x64, 0x21, 0xb7, 0xe3, 0x3a, 0xd6, 0x3b, 0x29, 0x7c, 0xec, 0x16, 0xce, 0xbd, 0xc9, 0x2d, 0x8c,
+	0x6e, 0xd0, 0xd4, 0x64, 0xc0, 0x34, 0xbd, 0x26, 0x5d, 0x2b, 0x44, 0xac, 0xcd, 0x23, 0x82, 0x32,
+	0x18, 0x05, 0x51, 0xf0, 0x89, 0xeb, 0xd3, 0x64, 0x81, 0x50, 0x70, 0xf1, 0x8d, 0xee, 0x01, 0x78,
+	0xd8, 0xb4, 0xce, 0xe7, 0xd5, 0xef, 0x12, 0xa5, 0xa6, 0x12, 0xdc, 0x87, 0x32, 0x7e, 0x3d, 0xb1,
+	0x3d, 0x2e, 0xfe, 0xe5, 0xe5, 0x01, 0x60, 0xe4, 0x71, 0xdb, 0x28, 0xa9, 0x2a, 0xfc, 0x1d, 0x2c,
+	0x52, 0xe9, 0x89, 0x87, 0xf9, 0xbe, 0xb5, 0x7b, 0xbc, 0xbb, 0xff, 0x98, 0xb9, 0x21, 0x92, 0x0a,
+	0xfc, 0xa1, 0xae, 0xa1, 0x2a, 0x94, 0x1e, 0x3d, 0xdb, 0x7b, 0xb4, 0xbb, 0xb7, 0x47, 0x1d, 0x51,
+	0x15, 0x4a, 0x9d, 0xd6, 0x7e, 0xa7, 0x4b, 0x3f, 0xb3, 0x64, 0x54, 0xf7, 0xb7, 0x87, 0xbb, 0x24,
+	0x76, 0xcc, 0xe9, 0xdf, 0x42, 0xfd, 0xd0, 0x31, 0xfb, 0x98, 0xec, 0xc9, 0x5b, 0x18, 0x9a, 0xfe,
+	0x31, 0x2c, 0x77, 0xcc, 0x71, 0x1f, 0x3b, 0x32, 0x83, 0xb8, 0x55, 0xfc, 0x33, 0xb7, 0x0a, 0x42,
+	0xf3, 0x56, 0x56, 0xf1, 0x09, 0xd4, 0x84, 0x55, 0xf4, 0x1d, 0xd7, 0x0f, 0x6d, 0xa2, 0xca, 0xa1,
+	0x1d, 0x0a, 0xfc, 0x25, 0x2c, 0x82, 0x8b, 0x18, 0x59, 0xc4, 0x90, 0x00, 0x12, 0x16, 0x41, 0x57,
+	0xcb, 0x70, 0x73, 0x5b, 0xc4, 0x2d, 0x7e, 0x55, 0xce, 0xb3, 0x0b, 0xfa, 0x23, 0xc8, 0xb7, 0xa9,
+	0xd3, 0x99, 0xab, 0xa5, 0xa4, 0x01, 0x05, 0xd3, 0xb2, 0x3c, 0xec, 0xfb, 0xc2, 0xdd, 0xf1, 0x4f,
+	0xfd, 0xdf, 0x33, 0x90, 0xeb, 0xb8, 0x93, 0x73, 0x59, 0x9d, 0x34, 0x45, 0x9d, 0x52, 0x37, 0x5c,
+	0x71, 0x82, 0x59, 0xd5, 0x09, 0x46, 0x86, 0x95, 0x8b, 0x19, 0x16, 0x99, 0x48, 0x35, 0xac, 0x2d,
+	0xb8, 0x62, 0x61, 0x3f, 0xb0, 0xc7, 0xac, 0xe0, 0x1f, 0xf1, 0x64, 0xfb, 0xbf, 0x22, 0x21, 0xdb,
+	0x82, 0xfd, 0x03, 0xa8, 0x06, 0x9e, 0x39, 0xf6, 0x4f, 0xb1, 0xc7, 0xec, 0x26, 0x7f, 0xf9, 0x2d,
+	0x27, 0x06, 0x10, 0x90, 0xde, 0x12, 0xf6, 0x51, 0x85, 0x52, 0xeb, 0x79, 0x6b, 0x77, 0xaf, 0xd5,
+	0xde, 0xeb, 0xb2, 0xf7, 0xd4, 0xc1, 0x7e, 0x6f, 0xef, 0xa0, 0xb5, 0x5f, 0xd7, 0x50, 0x0d, 0x60,
+	0x77, 0xbf, 0x77, 0x6c, 0xb4, 0xf6, 0x8f, 0x76, 0x8f, 0xc3, 0x27, 0x55, 0xd7, 0x78, 0x4e, 0xaf,
+	0xeb, 0x30, 0x07, 0xc8, 0xa4, 0x92, 0x72, 0x80, 0x6c, 0x09, 0x89, 0x1c, 0x20, 0xa7, 0xe3, 0x68,
+	0x9e, 0x03, 0x54, 0x07, 0xc7, 0xad, 0xe2, 0x0a, 0x4b, 0x6a, 0x32, 0xa2, 0x30, 0x55, 0xa8, 0x77,
+	0x60, 0x55, 0x05, 0x73, 0x5d, 0xbc, 0x05, 0xfc, 0x04, 0xb0, 0x50, 0xc7, 0xc4, 0xec, 0x21, 0x81,
+	0x7e, 0x17, 0x6a, 0x2d, 0xcb, 0x22, 0xe7, 0x21, 0xa5, 0x68, 0xfb, 0xee, 0xe4, 0x3c, 0x91, 0xa2,
+	0xa5, 0x34, 0x14, 0xa5, 0xdf, 0xa4, 0xc9, 0x5f, 0x79, 0xd0, 0x4c, 0xa5, 0xd1, 0x3f, 0x83, 0x35,
+	0x92, 0x53, 0x67, 0x8d, 0x0d, 0xb6, 0x63, 0x07, 0xe7, 0x17, 0xbd, 0xf3, 0xff, 0x56, 0x83, 0x8a,
+	0x4c, 0x9b, 0x46, 0x94, 0xda, 0x40, 0x91, 0x49, 0x6f, 0xa0, 0xf8, 0x4a, 0xda, 0x0b, 0x56, 0xb1,
+	0x7a, 0x3f, 0xb6, 0x17, 0x8a, 0x64, 0xd1, 0xbe, 0xfc, 0x9b, 0x06, 0x28, 0x49, 0x30, 0xf7, 0xb9,
+	0x4a, 0xcd, 0x1f, 0x99, 0x4b, 0x9b, 0x3f, 0xb2, 0xe9, 0xb2, 0x7f, 0x0a, 0xe4, 0x26, 0x77, 0x47,
+	0xa4, 0x63, 0x80, 0x53, 0x32, 0x57, 0x55, 0x13, 0x60, 0x46, 0xa8, 0xef, 0xc1, 0xca, 0x31, 0x57,
+	0xeb, 0xb9, 0xce, 0xe4, 0xe2, 0xc8, 0x65, 0x93, 0x24, 0x65, 0xfa, 0xd8, 0x7e, 0x89, 0xe7, 0x3b,
+	0xe0, 0x11, 0x94, 0x49, 0xe0, 0xfc, 0x14, 0xfb, 0xbe, 0x39, 0x20, 0x1e, 0x46, 0xea, 0x7b, 0x23,
+	0x99, 0x21, 0x52, 0xaa, 0x23, 0x10, 0xd4, 0x84, 0xc2, 0x88, 0x11, 0x85, 0x85, 0x3a, 0x01, 0x50,
+	0xcb, 0x78, 0xd9, 0x78, 0x19, 0xaf, 0x5d, 0x82, 0x02, 0x7f, 0x7b, 0xe8, 0x1b, 0x22, 0x11, 0xc4,
+	0xb5, 0xbd, 0x11, 0xe3, 0x1a, 0xf2, 0xd4, 0xff, 0xa0, 0x91, 0xea, 0x17, 0x69, 0x81, 0x79, 0xa7,
+	0xd0, 0x62, 0x0d, 0xf2, 0xbc, 0xf7, 0x86, 0xed, 0x3e, 0xff, 0x22, 0x3c, 0x02, 0xfc, 0x3a, 0x10,
+	0xa9, 0x1c, 0xf2, 0x3b, 0x1e, 0x86, 0xe4, 0xdf, 0x28, 0x19, 0x10, 0xba, 0x12, 0x26, 0xb4, 0xe4,
+	0x4a, 0x58, 0x23, 0x4f, 0x42, 0xe5, 0x38, 0x1d, 0x47, 0xeb, 0x7f, 0x01, 0x88, 0xf8, 0x03, 0x06,
+	0xf5, 0xdf, 0x26, 0x18, 0x56, 0xae, 0xc5, 0xec, 0x85, 0xd7, 0x62, 0x2e, 0x7e, 0x2d, 0x0e, 0x61,
+	0x45, 0x99, 0x9d, 0x1f, 0xcf, 0x0d, 0x52, 0x1c, 0xa7, 0xa0, 0x84, 0x2f, 0xe2, 0xe2, 0x0b, 0xfc,
+	0xdc, 0xd7, 0xe3, 0x37, 0xb0, 0xc2, 0x1e, 0xe6, 0xea, 0x3e, 0xc5, 0xcf, 0x78, 0x56, 0x20, 0xf2,
+	0xa7, 0x0c, 0xe4, 0x9f, 0xb2, 0xf5, 0xc6, 0x87, 0x5c, 0x87, 0x72, 0xdf, 0xf4, 0x2c, 0xd1, 0xa8,
+	0xc8, 0xc6, 0x01, 0x01, 0xb1, 0x36, 0xc5, 0xf0, 0x4a, 0xcd, 0x4a, 0x57, 0xea, 0x2a, 0x2c, 0xe2,
+	0x91, 0x69, 0x3b, 0x7c, 0x4b, 0xd8, 0x07, 0x81, 0x4e, 0x86, 0xee, 0x18, 0x73, 0xf5, 0x60, 0x1f,
+	0xc4, 0xa4, 0x4f, 0x5c, 0xcf, 0x73, 0x5f, 0x11, 0x9b, 0x66, 0x85, 0x69, 0x16, 0x45, 0xd6, 0x42,
+	0xf0, 0x1e, 0x81, 0xa2, 0x5b, 0xe2, 0xe6, 0x2c, 0xc4, 0x5e, 0xf6, 0x4c, 0x72, 0xf5, 0xee, 0xbc,
+	0x05, 0xcb, 0xfe, 0xd4, 0x9f, 0xe0, 0x31, 0x79, 0x9e, 0xf6, 0x78, 0xf9, 0x98, 0x3d, 0x93, 0xea,
+	0x11, 0x82, 0x17, 0x82, 0x63, 0x2a, 0x5a, 0x7a, 0x23, 0x15, 0xd5, 0xc5, 0x85, 0x09, 0x90, 0x6f,
+	0x75, 0x8e, 0x77, 0x9f, 0x93, 0xdb, 0xb2, 0x0a, 0xa5, 0xa3, 0x67, 0x47, 0x87, 0xdd, 0x7d, 0xfa,
+	0xb4, 0xd5, 0x1f, 0x92, 0x02, 0xfe, 0xc0, 0xf6, 0x03, 0xec, 0x31, 0x61, 0x25, 0x45, 0x96, 0x22,
+	0x18, 0x59, 0x13, 0x38, 0x9d, 0x38, 0xa1, 0x0e, 0xbd, 0x13, 0xd5, 0xc1, 0x6f, 0x7a, 0x54, 0x51,
+	0x9d, 0xf6, 0xed, 0x84, 0x78, 0xd7, 0x42, 0xf4, 0xea, 0x11, 0xdd, 0x78, 0xeb, 0xe2, 0x55, 0xac,
+	0x29, 0xd5, 0xfe, 0x92, 0xa8, 0xe6, 0xeb, 0x1b, 0xa4, 0x31, 0xc1, 0x1e, 0xd3, 0xe3, 0xbd, 0x90,
+	0x83, 0xfe, 0x89, 0x30, 0x86, 0x0b, 0xc9, 0x6e, 0x7e, 0x05, 0x45, 0xd1, 0x98, 0x45, 0xce, 0x6b,
+	0xa7, 0x65, 0x6c, 0x77, 0x0e, 0x9e, 0xd3, 0x36, 0x80, 0x2a, 0x94, 0x0e, 0x5b, 0x87, 0x5d, 0xa3,
+	0xdd, 0xea, 0x3c, 0x61, 0x4f, 0x82, 0xd6, 0xb3, 0xed, 0xdd, 0x03, 0x92, 0xb7, 0xa8, 0x67, 0x6e,
+	0x7e, 0x09, 0x15, 0x39, 0x99, 0x84, 0x0a, 0x90, 0xed, 0x1c, 0x3d, 0xaf, 0x2f, 0xa0, 0x22, 0xe4,
+	0xbe, 0x3b, 0x3a, 0x20, 0x01, 0x12, 0x40, 0xbe, 0xbd, 0xdb, 0x3e, 0xee, 0xfe, 0xb6, 0x9e, 0x21,
+	0x68, 0x63, 0xf7, 0xa8, 0x9e, 0xdd, 0xfa, 0xfd, 0x2a, 0xbb, 0x16, 0x8e, 0x58, 0x1f, 0x37, 0xba,
+	0x0b, 0x05, 0xde, 0xeb, 0x86, 0xa2, 0xec, 0x8c, 0xda, 0xfd, 0xd6, 0x54, 0x33, 0x31, 0xfa, 0x02,
+	0xba, 0x0f, 0x10, 0xa5, 0xbf, 0xd0, 0x05, 0x39, 0xb1, 0xc4, 0xd0, 0x3b, 0x1a, 0xda, 0x86, 0x52,
+	0xd8, 0x51, 0x84, 0xde, 0x8b, 0x9e, 0xa8, 0xb1, 0x46, 0xa7, 0x66, 0x33, 0x0d, 0xc5, 0xbc, 0x97,
+	0xbe, 0x80, 0xbe, 0x83, 0xb2, 0xd4, 0xf3, 0x83, 0xde, 0x8f, 0xb5, 0xf6, 0x28, 0x9c, 0x3e, 0x48,
+	0x47, 0x86, 0xbc, 0x8e, 0xa4, 0x86, 0x10, 0xc6, 0x2e, 0xa5, 0xbb, 0x43, 0xe1, 0x78, 0x7d, 0x26,
+	0x3e, 0x64, 0x7a, 0x0f, 0x20, 0x6a, 0xd0, 0x90, 0xf6, 0x28, 0xd1, 0xb5, 0x91, 0xdc, 0xde, 0x7b,
+	0x00, 0x51, 0x8f, 0x86, 0x34, 0x34, 0xd1, 0xb8, 0x91, 0x3a, 0x34, 0xaa, 0x0d, 0x49, 0x43, 0x13,
+	0x05, 0xa3, 0xb4, 0x43, 0x2d, 0x4b, 0x45, 0x1f, 0x69, 0x47, 0x93, 0xa5, 0xa0, 0xe4, 0xe0, 0x3f,
+	0x8f, 0xda, 0xc4, 0xc2, 0xaa, 0x02, 0xfa, 0x28, 0x71, 0x82, 0xf1, 0xea, 0x46, 0x53, 0xbf, 0x88,
+	0x24, 0xdc, 0xcb, 0x07, 0x50, 0x91, 0xfb, 0x47, 0xd0, 0x07, 0xb1, 0xdd, 0x54, 0x5a, 0x3e, 0x9a,
+	0xf1, 0x16, 0x0f, 0xba, 0x2d, 0xa5, 0xb0, 0x81, 0x44, 0xd2, 0xb9, 0x78, 0x53, 0x49, 0xda, 0xd0,
+	0x07, 0x50, 0x91, 0xfb, 0x4a, 0xa4, 0xb9, 0x53, 0xda, 0x4d, 0xd2, 0x18, 0x3c, 0x67, 0xf5, 0x4e,
+	0xa9, 0x2c, 0x88, 0xae, 0x2b, 0xab, 0x4e, 0xd6, 0x28, 0x9b, 0xeb, 0xb3, 0x09, 0xc2, 0x4d, 0xf9,
+	0x06, 0xca, 0x52, 0xcf, 0x89, 0x74, 0x5e, 0xc9, 0x4e, 0x94, 0xc4, 0x79, 0x6d, 0x68, 0x91, 0x7e,
+	0xd2, 0x7f, 0x37, 0x88, 0xeb, 0xa7, 0xd4, 0x1b, 0xd2, 0x54, 0x3b, 0x11, 0xf4, 0x05, 0xee, 0x33,
+	0xe8, 0x38, 0xc5, 0x67, 0x5c, 0x38, 0x28, 0x54, 0xea, 0xd8, 0x7c, 0x89, 0x5e, 0x94, 0xe4, 0xd0,
+	0xa7, 0x50, 0x91, 0x9b, 0x41, 0xa4, 0x23, 0x48, 0xe9, 0x29, 0x69, 0x5e, 0x9b, 0x81, 0x4d, 0x6a,
+	0x13, 0xff, 0xcf, 0x86, 0xb8, 0x36, 0x29, 0x8d, 0x1f, 0xcd, 0x78, 0x97, 0x48, 0xa8, 0x4d, 0x7c,
+	0xb4, 0xa2, 0x4d, 0x97, 0x0e, 0xe5, 0xca, 0x20, 0xf5, 0x7f, 0xc4, 0x94, 0x21, 0xd9, 0x50, 0xd2,
+	0x5c, 0x9f, 0x4d, 0x10, 0xae, 0xa9, 0x0d, 0x55, 0xa5, 0x2f, 0x04, 0x5d, 0x8b, 0x2f, 0x4a, 0xe9,
+	0xa0, 0x68, 0x26, 0xba, 0x10, 0xf4, 0x05, 0xf4, 0x1b, 0x80, 0xa8, 0x2f, 0x44, 0x3a, 0xa1, 0x44,
+	0xb3, 0x48, 0xea, 0xe8, 0x36, 0x54, 0x95, 0x66, 0x11, 0x49, 0x82, 0xb4, 0x26, 0x92, 0x59, 0x3c,
+	0x94, 0x7e, 0x0f, 0x89, 0x47, 0x5a, 0x1f, 0x48, 0x2a, 0x8f, 0x23, 0xa8, 0xa9, 0xdd, 0x1c, 0x92,
+	0x33, 0x4f, 0x6d, 0x10, 0x69, 0x5e, 0x9f, 0x89, 0x0f, 0xb7, 0xf7, 0x2e, 0x14, 0x78, 0x57, 0x85,
+	0xa4, 0xf1, 0x6a, 0x9f, 0x45, 0xd2, 0x27, 0x7e, 0x05, 0xa5, 0xb0, 0x75, 0x42, 0x52, 0x93, 0x78,
+	0x3b, 0x45, 0x72, 0x60, 0x0b, 0x8a, 0xa2, 0xed, 0x01, 0x35, 0x94, 0xc3, 0x97, 0x9a, 0x26, 0x9a,
+	0xef, 0xa5, 0x60, 0x42, 0x81, 0xbf, 0x86, 0xda, 0x53, 0xf3, 0x4c, 0x2a, 0x91, 0x21, 0x75, 0x96,
+	0x66, 0x5a, 0x8d, 0x8e, 0xfa, 0x85, 0x2e, 0x54, 0x95, 0xd2, 0xa7, 0x74, 0x06, 0x69, 0x25, 0xd1,
+	0x19, 0x8c, 0x84, 0xa2, 0x77, 0xa4, 0x72, 0xa3, 0xaa, 0xe8, 0xc9, 0x9a, 0x69, 0x73, 0x7d, 0x36,
+	0x41, 0xb8, 0xb0, 0x27, 0x50, 0x8f, 0x97, 0x22, 0xd1, 0x7a, 0x4c, 0xd3, 0xe6, 0x16, 0xf2, 0x09,
+	0xd4, 0xe3, 0xa5, 0x44, 0x89, 0xd9, 0x8c, 0x2a, 0xe3, 0x2c, 0x66, 0xfb, 0x50, 0x8f, 0xd7, 0x1a,
+	0x25, 0x66, 0x33, 0xca, 0x90, 0xcd, 0xd5, 0x38, 0x05, 0xa9, 0xf3, 0xd1, 0x38, 0xe9, 0x21, 0x40,
+	0x54, 0x98, 0x93, 0xcc, 0x31, 0x51, 0xad, 0x6b, 0xa2, 0x64, 0x59, 0x8d, 0x72, 0xf8, 0x86, 0x05,
+	0x97, 0x9d, 0xa1, 0x19, 0xa0, 0x55, 0x85, 0x86, 0x27, 0x05, 0x9a, 0xf1, 0xe2, 0xbe, 0xd8, 0xe6,
+	0x0d, 0xed, 0x8e, 0xb6, 0xf5, 0x4f, 0x59, 0xa8, 0xb2, 0xe8, 0x55, 0x04, 0x8b, 0x1d, 0xa8, 0xa9,
+	0x4f, 0x08, 0x25, 0x50, 0x4a, 0x79, 0x5b, 0x34, 0xe3, 0x61, 0x7c, 0xe8, 0x3d, 0xf9, 0x78, 0xc5,
+	0x7b, 0x5e, 0x3a, 0x34, 0xbc, 0x8b, 0xf9, 0xe8, 0xf8, 0x5d, 0x7c, 0x29, 0x83, 0x16, 0x54, 0x95,
+	0xf8, 0x5f, 0x52, 0xee, 0xb4, 0x77, 0x41, 0x1a, 0x8b, 0x2e, 0x2c, 0xc5, 0x9e, 0x00, 0x48, 0x8e,
+	0x06, 0xd3, 0x1e, 0x07, 0x33, 0x96, 0x22, 0xbf, 0x0f, 0xa4, 0xa5, 0xa4, 0x3c, 0x1b, 0x52, 0x18,
+	0x6c, 0xfd, 0x49, 0x83, 0x2a, 0x7b, 0x68, 0x8b, 0xd3, 0x09, 0xef, 0x35, 0x06, 0x4e, 0xdc, 0x6b,
+	0xca, 0xb3, 0xbc, 0x19, 0x7f, 0xef, 0xb3, 0x98, 0x5a, 0x4a, 0x15, 0x48, 0x11, 0x45, 0x32, 0x7d,
+	0xd1, 0xfc, 0x20, 0x1d, 0x29, 0x5f, 0xb2, 0x72, 0x32, 0x20, 0xb1, 0xbe, 0xcb, 0x84, 0xd9, 0xfa,
+	0xef, 0x02, 0xd4, 0xf6, 0xf0, 0xd8, 0xb2, 0xc7, 0x03, 0xb1, 0xc0, 0x2f, 0xa0, 0x28, 0x4a, 0x8a,
+	0x92, 0x5f, 0x8c, 0x55, 0x19, 0x9b, 0x6a, 0xd5, 0x4b, 0x5f, 0x40, 0x9f, 0x43, 0x9e, 0x95, 0xdc,
+	0xd0, 0x9a, 0x34, 0x8d, 0x54, 0x41, 0x4c, 0x0e, 0xb9, 0x0d, 0x8b, 0xb4, 0x4a, 0x88, 0xae, 0x48,
+	0x23, 0xa2, 0xaa, 0x61, 0x72, 0x00, 0x7f, 0xd4, 0xec, 0xd1, 0x0a, 0x9b, 0xea, 0x99, 0xe5, 0x4a,
+	0x62, 0xb3, 0x99, 0x86, 0x0a, 0x37, 0xed, 0x2b, 0x28, 0x85, 0xb5, 0x1c, 0x89, 0x4b, 0xbc, 0xbe,
+	0xd3, 0x54, 0xcb, 0x18, 0xfc, 0xb1, 0x11, 0x16, 0x71, 0xe4, 0x60, 0x2e, 0x5e, 0xd9, 0x49, 0x0e,
+	0xe5, 0x92, 0xef, 0xd0, 0x4a, 0x88, 0x2a, 0xb9, 0x5c, 0xe7, 0x68, 0x36, 0xd3, 0x50, 0xa1, 0xe4,
+	0xf7, 0xb9, 0xb3, 0x62, 0x6c, 0x62, 0xce, 0x4a, 0xe1, 0x13, 0x17, 0xe0, 0x8e, 0x16, 0x29, 0x2e,
+	0x2f, 0x98, 0xc4, 0x15, 0x57, 0xc9, 0xc2, 0x37, 0xe3, 0xa9, 0xdd, 0xd0, 0xa5, 0xf0, 0xd1, 0x8a,
+	0x4b, 0xb9, 0x74, 0x28, 0x8f, 0x2d, 0x45, 0xb2, 0x3e, 0x16, 0x5b, 0xc6, 0x52, 0xfb, 0xcd, 0x6b,
+	0x33, 0xb0, 0x72, 0xa0, 0xc0, 0xd3, 0xf6, 0x52, 0xa0, 0xa0, 0x26, 0xf2, 0x9b, 0x6a, 0xea, 0x3e,
+	0x8c, 0xa7, 0x63, 0x83, 0xd4, 0x44, 0x7e, 0x72, 0xd0, 0x13, 0x58, 0x8a, 0xe5, 0xef, 0x25, 0x3f,
+	0x94, 0x9e, 0xd9, 0x97, 0xdc, 0xbd, 0x8c, 0xa5, 0x6f, 0x89, 0x8a, 0x9c, 0xa9, 0x96, 0x76, 0x21,
+	0x25, 0x81, 0x9d, 0x94, 0x85, 0x3e, 0x1d, 0xc3, 0xd4, 0xb4, 0xf2, 0x74, 0x8c, 0x27, 0xac, 0x13,
+	0x83, 0xdb, 0x7f, 0xa3, 0xfd, 0xcf, 0xc3, 0x07, 0x17, 0xfc, 0x6f, 0xf7, 0xc0, 0x9b, 0xf4, 0x5f,
+	0xe1, 0x93, 0x5f, 0xe3, 0xd7, 0xe6, 0x68, 0xe2, 0xe0, 0xdb, 0x7d, 0xc7, 0xc6, 0x63, 0xfe, 0x2f,
+	0xdf, 0xe2, 0x7f, 0xd3, 0xff, 0xff, 0x9b, 0x30, 0x20, 0xff, 0xc2, 0x8e, 0x3d, 0x95, 0xc1, 0x49,
+	0x9e, 0x7e, 0xde, 0xfd, 0xdf, 0x01, 0x00, 0x4d, 0xd7, 0xae, 0xcd, 0xf4, 0x3e, 0x00, 0x00,
 }
@@ -0,0 +1,100 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// queryMatcher returns a predicate matching the books that satisfy
+// all the filters in req, except the subjects, which are matched
+// with subjectMatcher. It returns an InvalidArgument error if
+// any of the filters are invalid.
+func queryMatcher(req *library.QueryBooksRequest) (func(*library.Book) bool, error) {
+	var matchers []func(*library.Book) bool
+
+	if prefix := req.GetAuthorPrefix(); prefix != "" {
+		matchers = append(matchers, func(bk *library.Book) bool {
+			return strings.HasPrefix(bk.GetAuthor(), prefix)
+		})
+	}
+
+	if len(req.GetBookTypes()) > 0 {
+		types := map[library.BookType]bool{}
+		for _, t := range req.GetBookTypes() {
+			if _, ok := library.BookType_name[int32(t)]; !ok {
+				return nil, status.Errorf(codes.InvalidArgument, "Unknown book type %d", t)
+			}
+			types[t] = true
+		}
+		matchers = append(matchers, func(bk *library.Book) bool {
+			return types[bk.GetBookType()]
+		})
+	}
+
+	var after, before time.Time
+	var err error
+	if req.GetPublishedAfter() != nil {
+		after, err = ptypes.Timestamp(req.GetPublishedAfter())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid published_after: %v", err)
+		}
+		matchers = append(matchers, func(bk *library.Book) bool {
+			published, err := ptypes.Timestamp(bk.GetPublicationDate())
+			return err == nil && !published.Before(after)
+		})
+	}
+	if req.GetPublishedBefore() != nil {
+		before, err = ptypes.Timestamp(req.GetPublishedBefore())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid published_before: %v", err)
+		}
+		matchers = append(matchers, func(bk *library.Book) bool {
+			published, err := ptypes.Timestamp(bk.GetPublicationDate())
+			return err == nil && published.Before(before)
+		})
+	}
+	if req.GetPublishedAfter() != nil && req.GetPublishedBefore() != nil && !after.Before(before) {
+		return nil, status.Error(codes.InvalidArgument, "published_before must be after published_after")
+	}
+
+	if publisher := req.GetPublisher(); publisher != "" {
+		matchers = append(matchers, func(bk *library.Book) bool {
+			return bk.GetPublisher() != nil && strings.EqualFold(bk.GetPublisher().GetName(), publisher)
+		})
+	}
+
+	if req.GetSelfPublished() != nil {
+		selfPublished := req.GetSelfPublished().GetValue()
+		matchers = append(matchers, func(bk *library.Book) bool {
+			return bk.GetSelfPublished() == selfPublished
+		})
+	}
+
+	for _, t := range req.GetTags() {
+		tag, err := normalizeTag(t)
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, func(bk *library.Book) bool {
+			return hasTag(bk, tag)
+		})
+	}
+
+	return func(bk *library.Book) bool {
+		for _, m := range matchers {
+			if !m(bk) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
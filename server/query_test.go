@@ -0,0 +1,107 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+func yearStart(year int) *timestamp.Timestamp {
+	ts, _ := ptypes.TimestampProto(time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC))
+	return ts
+}
+
+func TestQueryBooks(t *testing.T) {
+	s, _ := newTestBookService()
+
+	tests := []struct {
+		name  string
+		query *library.QueryBooksRequest
+		want  []string
+	}{
+		{
+			name:  "author prefix",
+			query: &library.QueryBooksRequest{AuthorPrefix: "George"},
+			want:  []string{"Nineteen Eighty-Four", "Animal Farm"},
+		},
+		{
+			name: "book types",
+			query: &library.QueryBooksRequest{
+				BookTypes: []library.BookType{library.BookType_AUDIOBOOK, library.BookType_PAPERBACK},
+			},
+			want: []string{"Nineteen Eighty-Four", "Alice's Adventures in Wonderland", "Still Alice"},
+		},
+		{
+			name: "publication dates",
+			query: &library.QueryBooksRequest{
+				PublishedAfter:  yearStart(1932),
+				PublishedBefore: yearStart(1949),
+			},
+			want: []string{"Brave New World", "Animal Farm"},
+		},
+		{
+			name:  "publisher ignoring case",
+			query: &library.QueryBooksRequest{Publisher: "secker & warburg"},
+			want:  []string{"Nineteen Eighty-Four", "Animal Farm"},
+		},
+		{
+			name:  "self published",
+			query: &library.QueryBooksRequest{SelfPublished: &wrappers.BoolValue{Value: true}},
+			want:  []string{"Still Alice"},
+		},
+		{
+			name:  "not self published",
+			query: &library.QueryBooksRequest{SelfPublished: &wrappers.BoolValue{Value: false}, AuthorPrefix: "L"},
+			want:  []string{"Alice's Adventures in Wonderland"},
+		},
+		{
+			name: "combined and ordered",
+			query: &library.QueryBooksRequest{
+				PublishedAfter: yearStart(1900),
+				OrderBy:        "publication_date desc",
+			},
+			want: []string{"Still Alice", "Nineteen Eighty-Four", "Animal Farm", "Brave New World"},
+		},
+	}
+	for _, tt := range tests {
+		stream := &bookStream{testServerStream: testServerStream{ctx: context.Background()}}
+		err := s.QueryBooks(tt.query, stream)
+		if err != nil {
+			t.Errorf("%s: QueryBooks returned error: %v", tt.name, err)
+			continue
+		}
+		if got := bookTitles(stream.books); !equalStrings(got, tt.want) {
+			t.Errorf("%s: QueryBooks returned %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestQueryBooksInvalid(t *testing.T) {
+	s, _ := newTestBookService()
+	for _, query := range []*library.QueryBooksRequest{
+		{BookTypes: []library.BookType{42}},
+		{PublishedAfter: yearStart(1949), PublishedBefore: yearStart(1932)},
+		{PublishedAfter: &timestamp.Timestamp{Nanos: -1}},
+		{OrderBy: "rating"},
+	} {
+		stream := &bookStream{testServerStream: testServerStream{ctx: context.Background()}}
+		err := s.QueryBooks(query, stream)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("QueryBooks(%v) returned error %v, want InvalidArgument", query, err)
+		}
+		if len(stream.books) != 0 {
+			t.Errorf("QueryBooks(%v) sent %d books, want none", query, len(stream.books))
+		}
+	}
+}
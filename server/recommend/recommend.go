@@ -0,0 +1,191 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+// Package recommend implements an item-to-item co-occurrence model.
+// Items are related by how often they appear in the same baskets,
+// such as the Collections of the library, or the Books borrowed
+// by a member.
+package recommend
+
+import (
+	"math"
+	"sort"
+	"sync"
+)
+
+// Result is an item related to the items of a query.
+type Result struct {
+	// Item is the related item.
+	Item string
+	// Score is the similarity of the item to the
+	// items of the query, from 0 to 1.
+	Score float64
+}
+
+// Model counts how often pairs of items appear in the same basket.
+// It is updated incrementally as baskets change.
+// It is safe for concurrent use.
+type Model struct {
+	mu      sync.RWMutex
+	baskets map[string]map[string]bool
+	// freq is the number of baskets containing each item.
+	freq map[string]int
+	// pairs is the number of baskets containing each pair of items.
+	pairs map[string]map[string]int
+}
+
+// NewModel returns an empty Model.
+func NewModel() *Model {
+	return &Model{
+		baskets: map[string]map[string]bool{},
+		freq:    map[string]int{},
+		pairs:   map[string]map[string]int{},
+	}
+}
+
+// SetBasket sets the items of the basket with the ID provided,
+// creating it if necessary. Duplicate items are ignored.
+func (m *Model) SetBasket(id string, items []string) {
+	want := make(map[string]bool, len(items))
+	for _, item := range items {
+		want[item] = true
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for item := range m.baskets[id] {
+		if !want[item] {
+			m.remove(id, item)
+		}
+	}
+	for item := range want {
+		m.add(id, item)
+	}
+	if len(m.baskets[id]) == 0 {
+		delete(m.baskets, id)
+	}
+}
+
+// AddToBasket adds item to the basket with the ID
+// provided, creating it if necessary.
+func (m *Model) AddToBasket(id, item string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.add(id, item)
+}
+
+// RemoveBasket removes the basket with the ID provided.
+func (m *Model) RemoveBasket(id string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for item := range m.baskets[id] {
+		m.remove(id, item)
+	}
+	delete(m.baskets, id)
+}
+
+// Basket returns the items of the basket with the ID provided, sorted.
+func (m *Model) Basket(id string) []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var items []string
+	for item := range m.baskets[id] {
+		items = append(items, item)
+	}
+	sort.Strings(items)
+	return items
+}
+
+// Related returns the items appearing in a basket together
+// with any of the items provided, most similar first. The items
+// provided are not included. The similarity of two items is the
+// cosine similarity of their basket memberships, and the score
+// of an item is its mean similarity to the items provided.
+func (m *Model) Related(items []string) []Result {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	query := map[string]bool{}
+	for _, item := range items {
+		query[item] = true
+	}
+	scores := map[string]float64{}
+	for item := range query {
+		for other, n := range m.pairs[item] {
+			if query[other] {
+				continue
+			}
+			scores[other] += float64(n) / math.Sqrt(float64(m.freq[item]*m.freq[other]))
+		}
+	}
+
+	results := make([]Result, 0, len(scores))
+	for item, score := range scores {
+		results = append(results, Result{
+			Item:  item,
+			Score: score / float64(len(query)),
+		})
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].Item < results[j].Item
+	})
+	return results
+}
+
+// add adds item to the basket with the ID provided,
+// counting it with the other items of the basket.
+// The caller must hold m.mu.
+func (m *Model) add(id, item string) {
+	basket, ok := m.baskets[id]
+	if !ok {
+		basket = map[string]bool{}
+		m.baskets[id] = basket
+	}
+	if basket[item] {
+		return
+	}
+	for other := range basket {
+		m.count(item, other, 1)
+		m.count(other, item, 1)
+	}
+	basket[item] = true
+	m.freq[item]++
+}
+
+// remove removes item from the basket with the ID provided.
+// The caller must hold m.mu.
+func (m *Model) remove(id, item string) {
+	basket := m.baskets[id]
+	if !basket[item] {
+		return
+	}
+	delete(basket, item)
+	for other := range basket {
+		m.count(item, other, -1)
+		m.count(other, item, -1)
+	}
+	m.freq[item]--
+	if m.freq[item] == 0 {
+		delete(m.freq, item)
+	}
+}
+
+// count adds delta to the count of the pair a, b.
+// The caller must hold m.mu.
+func (m *Model) count(a, b string, delta int) {
+	counts, ok := m.pairs[a]
+	if !ok {
+		counts = map[string]int{}
+		m.pairs[a] = counts
+	}
+	counts[b] += delta
+	if counts[b] == 0 {
+		delete(counts, b)
+	}
+	if len(counts) == 0 {
+		delete(m.pairs, a)
+	}
+}
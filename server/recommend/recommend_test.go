@@ -0,0 +1,72 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package recommend
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestRelated(t *testing.T) {
+	m := NewModel()
+	m.SetBasket("alice", []string{"a", "b", "c"})
+	m.SetBasket("bob", []string{"a", "b"})
+	m.SetBasket("carol", []string{"c", "d", "d"})
+
+	tests := []struct {
+		items []string
+		want  []Result
+	}{
+		{items: nil, want: []Result{}},
+		{items: []string{"e"}, want: []Result{}},
+		{items: []string{"a"}, want: []Result{
+			{Item: "b", Score: 1},
+			{Item: "c", Score: 1 / math.Sqrt(4)},
+		}},
+		{items: []string{"d"}, want: []Result{
+			{Item: "c", Score: 1 / math.Sqrt(2)},
+		}},
+		{items: []string{"a", "d"}, want: []Result{
+			{Item: "c", Score: (1/math.Sqrt(4) + 1/math.Sqrt(2)) / 2},
+			{Item: "b", Score: 1 / 2.0},
+		}},
+	}
+	for _, tt := range tests {
+		got := m.Related(tt.items)
+		if len(got) != len(tt.want) {
+			t.Errorf("Related(%q) = %v, want %v", tt.items, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i].Item != tt.want[i].Item || math.Abs(got[i].Score-tt.want[i].Score) > 1e-9 {
+				t.Errorf("Related(%q) = %v, want %v", tt.items, got, tt.want)
+				break
+			}
+		}
+	}
+}
+
+func TestBaskets(t *testing.T) {
+	m := NewModel()
+	m.SetBasket("alice", []string{"b", "a", "a"})
+	m.AddToBasket("alice", "c")
+	if got, want := m.Basket("alice"), []string{"a", "b", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Basket = %q, want %q", got, want)
+	}
+
+	m.SetBasket("alice", []string{"c"})
+	if got := m.Related([]string{"a"}); len(got) != 0 {
+		t.Errorf("Related after SetBasket = %v, want none", got)
+	}
+
+	m.SetBasket("bob", []string{"c", "d"})
+	m.RemoveBasket("bob")
+	if got := m.Basket("bob"); got != nil {
+		t.Errorf("Basket after RemoveBasket = %q, want none", got)
+	}
+	if got := m.Related([]string{"c"}); len(got) != 0 {
+		t.Errorf("Related after RemoveBasket = %v, want none", got)
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+	"github.com/johanbrandhorst/grpcweb-example/server/recommend"
+)
+
+// Basket IDs of the recommendation model. Each Collection is a basket,
+// and so are the Books borrowed by each member.
+const (
+	collectionBasket = "collection/"
+	memberBasket     = "member/"
+)
+
+func (s *BookService) RecommendBooks(ctx context.Context, req *library.RecommendBooksRequest) (*library.RecommendBooksResponse, error) {
+	limit := int(req.GetLimit())
+	switch {
+	case limit < 0:
+		return nil, status.Error(codes.InvalidArgument, "The limit must not be negative")
+	case limit == 0:
+		limit = defaultPageSize
+	case limit > maxPageSize:
+		limit = maxPageSize
+	}
+
+	model, err := s.recommender(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// seeds are the ISBNs of the Books to recommend similar Books to
+	var seeds []string
+	switch {
+	case req.GetIsbn() != "":
+		id, err := requestIsbn(req.GetIsbn(), 0)
+		if err != nil {
+			return nil, err
+		}
+		_, err = s.store.GetBook(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		seeds = []string{id}
+	case req.GetMemberId() != "":
+		_, err := s.members.GetMember(ctx, req.GetMemberId())
+		if err != nil {
+			return nil, err
+		}
+		seeds = model.Basket(memberBasket + req.GetMemberId())
+		collections, err := s.collections.QueryCollections(ctx, func(c *library.Collection) bool {
+			return c.GetOwner() == req.GetMemberId()
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range collections {
+			for _, bk := range c.GetBooks() {
+				seeds = append(seeds, bk.GetIsbn())
+			}
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "The ISBN or member ID must be set")
+	}
+
+	resp := &library.RecommendBooksResponse{}
+	// seen holds the seeds and the Books recommended so far
+	seen := map[string]bool{}
+	for _, id := range seeds {
+		seen[id] = true
+	}
+	for _, res := range model.Related(seeds) {
+		if len(resp.Recommendations) == limit {
+			return resp, nil
+		}
+		bk, err := s.store.GetBook(ctx, res.Item)
+		if status.Code(err) == codes.NotFound {
+			// Deleted since it was collected or borrowed
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		seen[bk.GetIsbn()] = true
+		s.localize(ctx, bk)
+		resp.Recommendations = append(resp.Recommendations, &library.Recommendation{
+			Book:   bk,
+			Reason: library.Recommendation_READ_TOGETHER,
+			Score:  res.Score,
+		})
+	}
+
+	// Too little is known about the seeds, so fall back
+	// to Books by the same authors, then of the same types.
+	authors := map[string]bool{}
+	types := map[library.BookType]bool{}
+	for _, id := range seeds {
+		bk, err := s.store.GetBook(ctx, id)
+		if status.Code(err) == codes.NotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range bk.GetAuthorIds() {
+			authors[a] = true
+		}
+		types[bk.GetBookType()] = true
+	}
+	fallbacks := []struct {
+		reason library.Recommendation_Reason
+		match  func(*library.Book) bool
+	}{
+		{library.Recommendation_SAME_AUTHOR, func(bk *library.Book) bool {
+			for _, id := range bk.GetAuthorIds() {
+				if authors[id] {
+					return true
+				}
+			}
+			return false
+		}},
+		{library.Recommendation_SAME_BOOK_TYPE, func(bk *library.Book) bool { return types[bk.GetBookType()] }},
+	}
+	for _, fb := range fallbacks {
+		books, err := s.store.QueryBooks(ctx, func(bk *library.Book) bool {
+			return !seen[bk.GetIsbn()] && fb.match(bk)
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, bk := range books {
+			if len(resp.Recommendations) == limit {
+				return resp, nil
+			}
+			seen[bk.GetIsbn()] = true
+			s.localize(ctx, bk)
+			resp.Recommendations = append(resp.Recommendations, &library.Recommendation{
+				Book:   bk,
+				Reason: fb.reason,
+			})
+		}
+	}
+
+	return resp, nil
+}
+
+// recommender returns the recommendation model, building it from
+// the stored Collections on first use, and adding the Loans made
+// since it was last used.
+func (s *BookService) recommender(ctx context.Context) (*recommend.Model, error) {
+	s.recsMu.Lock()
+	defer s.recsMu.Unlock()
+	if s.recs == nil {
+		collections, err := s.collections.QueryCollections(ctx, func(*library.Collection) bool { return true })
+		if err != nil {
+			return nil, err
+		}
+		model := recommend.NewModel()
+		for _, c := range collections {
+			model.SetBasket(collectionBasket+c.GetId(), collectionIsbns(c))
+		}
+		s.recs = model
+		s.loansSeen = 0
+	}
+
+	if s.loans == nil {
+		return s.recs, nil
+	}
+	// Only the loans added since the model was last used need to be counted
+	loans, n, err := s.loans.LoansSince(ctx, s.loansSeen)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range loans {
+		s.recs.AddToBasket(memberBasket+l.GetMember(), l.GetIsbn())
+	}
+	s.loansSeen = n
+
+	return s.recs, nil
+}
+
+// recollect updates the recommendation model with the
+// current state of the Collection with the ID provided.
+// It must be called after every write to the CollectionStore.
+func (s *BookService) recollect(ctx context.Context, id string) {
+	s.recsMu.Lock()
+	defer s.recsMu.Unlock()
+	if s.recs == nil {
+		// Will be built from the store on first use
+		return
+	}
+
+	c, err := s.collections.GetCollection(ctx, id)
+	switch {
+	case err == nil:
+		s.recs.SetBasket(collectionBasket+id, collectionIsbns(c))
+	case status.Code(err) == codes.NotFound:
+		s.recs.RemoveBasket(collectionBasket + id)
+	default:
+		// Can't tell what state the collection is in,
+		// rebuild the model on next use.
+		s.recs = nil
+	}
+}
+
+// collectionIsbns returns the ISBNs of the Books of c.
+func collectionIsbns(c *library.Collection) []string {
+	isbns := make([]string, 0, len(c.GetBooks()))
+	for _, bk := range c.GetBooks() {
+		isbns = append(isbns, bk.GetIsbn())
+	}
+	return isbns
+}
@@ -0,0 +1,107 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// recommended returns the titles and reasons of recs.
+func recommended(recs []*library.Recommendation) ([]string, []library.Recommendation_Reason) {
+	var titles []string
+	var reasons []library.Recommendation_Reason
+	for _, r := range recs {
+		titles = append(titles, r.GetBook().GetTitle())
+		reasons = append(reasons, r.GetReason())
+	}
+	return titles, reasons
+}
+
+func TestRecommendBooks(t *testing.T) {
+	ctx := context.Background()
+	members := &MemoryMemberStore{}
+	store := NewMemoryBookStore(Fixtures()...)
+	s := NewBookService(store, WithMemberStore(members), WithLoanStore(store))
+	lending := NewLendingService(store, members)
+	addMember(t, members, "alice", 5)
+
+	for _, books := range [][]*library.Book{
+		{{Isbn: "9780140009729"}, {Isbn: "9780140008388"}},
+		{{Isbn: "9780140009729"}, {Isbn: "9780140008388"}, {Isbn: "9780060929879"}},
+	} {
+		_, err := makeCollection(s, books)
+		if err != nil {
+			t.Fatalf("MakeCollection returned error: %v", err)
+		}
+	}
+
+	resp, err := s.RecommendBooks(ctx, &library.RecommendBooksRequest{
+		Seed:  &library.RecommendBooksRequest_Isbn{Isbn: "9780140009729"},
+		Limit: 3,
+	})
+	if err != nil {
+		t.Fatalf("RecommendBooks returned error: %v", err)
+	}
+	titles, reasons := recommended(resp.GetRecommendations())
+	wantTitles := []string{"Animal Farm", "Brave New World", "Still Alice"}
+	wantReasons := []library.Recommendation_Reason{
+		library.Recommendation_READ_TOGETHER,
+		library.Recommendation_READ_TOGETHER,
+		library.Recommendation_SAME_BOOK_TYPE,
+	}
+	if !equalStrings(titles, wantTitles) || len(reasons) != len(wantReasons) {
+		t.Fatalf("RecommendBooks returned %q, want %q", titles, wantTitles)
+	}
+	for i := range reasons {
+		if reasons[i] != wantReasons[i] {
+			t.Errorf("%s is recommended for %v, want %v", titles[i], reasons[i], wantReasons[i])
+		}
+	}
+
+	// Loans made after the model was built are added to it
+	for _, isbn := range []string{"9780140301694", "9781501107733"} {
+		_, err := lending.Checkout(ctx, &library.CheckoutRequest{Isbn: isbn, Member: "alice"})
+		if err != nil {
+			t.Fatalf("Checkout returned error: %v", err)
+		}
+	}
+	resp, err = s.RecommendBooks(ctx, &library.RecommendBooksRequest{
+		Seed:  &library.RecommendBooksRequest_Isbn{Isbn: "9780140301694"},
+		Limit: 1,
+	})
+	if err != nil {
+		t.Fatalf("RecommendBooks returned error: %v", err)
+	}
+	titles, reasons = recommended(resp.GetRecommendations())
+	if !equalStrings(titles, []string{"Still Alice"}) || reasons[0] != library.Recommendation_READ_TOGETHER {
+		t.Errorf("RecommendBooks returned %q for %v, want Still Alice read together", titles, reasons)
+	}
+
+	resp, err = s.RecommendBooks(ctx, &library.RecommendBooksRequest{
+		Seed:  &library.RecommendBooksRequest_MemberId{MemberId: "alice"},
+		Limit: 1,
+	})
+	if err != nil {
+		t.Fatalf("RecommendBooks returned error: %v", err)
+	}
+	if len(resp.GetRecommendations()) != 1 {
+		t.Errorf("RecommendBooks for a member returned %d recommendations, want 1", len(resp.GetRecommendations()))
+	}
+
+	for _, req := range []*library.RecommendBooksRequest{
+		{},
+		{Seed: &library.RecommendBooksRequest_Isbn{Isbn: "9780140009729"}, Limit: -1},
+	} {
+		_, err := s.RecommendBooks(ctx, req)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("RecommendBooks(%v) returned error %v, want InvalidArgument", req, err)
+		}
+	}
+}
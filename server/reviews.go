@@ -0,0 +1,209 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"sort"
+
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// ReviewService implements library.ReviewServiceServer.
+type ReviewService struct {
+	store    ReviewStore
+	members  MemberStore
+	tokenKey []byte
+}
+
+// NewReviewService returns a ReviewService backed by the ReviewStore
+// provided, collecting the reviews of the Members in the MemberStore.
+func NewReviewService(store ReviewStore, members MemberStore, opts ...ReviewOption) *ReviewService {
+	s := &ReviewService{
+		store:   store,
+		members: members,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.tokenKey == nil {
+		s.tokenKey = newTokenKey()
+	}
+	return s
+}
+
+// reviewPageToken is the content of the page
+// tokens handed out by ListReviews.
+type reviewPageToken struct {
+	// Isbn and Member are the filters of the request that
+	// created the token. They may not change between pages.
+	Isbn   string `json:"i,omitempty"`
+	Member string `json:"m,omitempty"`
+	// Last is the key of the last Review on the previous page.
+	Last listKey `json:"l"`
+}
+
+func (reviewPageToken) kind() string { return "reviews" }
+
+func (s *ReviewService) CreateReview(ctx context.Context, req *library.CreateReviewRequest) (*library.Review, error) {
+	ctx, err := requestActor(ctx, s.members)
+	if err != nil {
+		return nil, err
+	}
+	src := req.GetReview()
+	id, err := requestIsbn(src.GetIsbn(), 0)
+	if err != nil {
+		return nil, err
+	}
+	if src.GetRating() < 1 || src.GetRating() > 5 {
+		return nil, status.Error(codes.InvalidArgument, "The rating must be from 1 to 5")
+	}
+
+	review := &library.Review{
+		Isbn:       id,
+		Member:     src.GetMember(),
+		Rating:     src.GetRating(),
+		Text:       src.GetText(),
+		CreateTime: ptypes.TimestampNow(),
+	}
+	review.Id, err = newID("review")
+	if err != nil {
+		return nil, err
+	}
+
+	err = useActiveMember(ctx, s.members, src.GetMember(), func(*library.Member) error {
+		_, err := s.store.UpdateReviews(ctx, id, func(br *BookReviews) error {
+			for _, r := range br.Reviews {
+				if r.GetMember() == review.GetMember() {
+					return status.Error(codes.AlreadyExists, "The member has already reviewed the book")
+				}
+			}
+			br.Reviews = append(br.Reviews, review)
+			setRatings(br)
+			return nil
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return review, nil
+}
+
+func (s *ReviewService) ListReviews(ctx context.Context, req *library.ListReviewsRequest) (*library.ListReviewsResponse, error) {
+	var id string
+	if req.GetIsbn() != "" {
+		var err error
+		id, err = requestIsbn(req.GetIsbn(), 0)
+		if err != nil {
+			return nil, err
+		}
+	}
+	pageSize, err := parsePageSize(req.GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+
+	var last *listKey
+	if req.GetPageToken() != "" {
+		var token reviewPageToken
+		if !decodeToken(s.tokenKey, req.GetPageToken(), &token) {
+			return nil, status.Error(codes.InvalidArgument, "Invalid page token")
+		}
+		if token.Isbn != id || token.Member != req.GetMember() {
+			return nil, status.Error(codes.InvalidArgument, "The filters must not change between pages")
+		}
+		last = &token.Last
+	}
+
+	reviews, err := s.store.QueryReviews(ctx, func(r *library.Review) bool {
+		return (id == "" || r.GetIsbn() == id) &&
+			(req.GetMember() == "" || r.GetMember() == req.GetMember()) &&
+			(last == nil || keyOfReview(r).less(*last))
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Newest first
+	sort.Slice(reviews, func(i, j int) bool {
+		return keyOfReview(reviews[j]).less(keyOfReview(reviews[i]))
+	})
+
+	resp := &library.ListReviewsResponse{}
+	if len(reviews) > pageSize {
+		reviews = reviews[:pageSize]
+		resp.NextPageToken, err = encodeToken(s.tokenKey, reviewPageToken{
+			Isbn:   id,
+			Member: req.GetMember(),
+			Last:   keyOfReview(reviews[len(reviews)-1]),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	resp.Reviews = reviews
+
+	return resp, nil
+}
+
+func (s *ReviewService) DeleteReview(ctx context.Context, req *library.DeleteReviewRequest) (*library.Review, error) {
+	ctx, err := requestActor(ctx, s.members)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetMember() == "" {
+		return nil, status.Error(codes.InvalidArgument, "The member must not be empty")
+	}
+	review, err := s.store.GetReview(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.store.UpdateReviews(ctx, review.GetIsbn(), func(br *BookReviews) error {
+		for i, r := range br.Reviews {
+			if r.GetId() == req.GetId() {
+				if r.GetMember() != req.GetMember() {
+					return status.Error(codes.PermissionDenied, "Only the member who wrote the review can delete it")
+				}
+				review = r
+				br.Reviews = append(br.Reviews[:i], br.Reviews[i+1:]...)
+				setRatings(br)
+				return nil
+			}
+		}
+		return status.Error(codes.NotFound, "Review could not be found")
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return review, nil
+}
+
+// setRatings sets the average rating and
+// review count of the Book of br.
+func setRatings(br *BookReviews) {
+	var sum int32
+	for _, r := range br.Reviews {
+		sum += r.GetRating()
+	}
+	br.Book.ReviewCount = int32(len(br.Reviews))
+	br.Book.AverageRating = 0
+	if len(br.Reviews) > 0 {
+		br.Book.AverageRating = float64(sum) / float64(len(br.Reviews))
+	}
+}
+
+func keyOfReview(r *library.Review) listKey {
+	return listKey{
+		Seconds: r.GetCreateTime().GetSeconds(),
+		Nanos:   r.GetCreateTime().GetNanos(),
+		ID:      r.GetId(),
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+func TestReviewRatings(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryBookStore(Fixtures()...)
+	members := &MemoryMemberStore{}
+	s := NewReviewService(store, members)
+	addMember(t, members, "alice", 5)
+	addMember(t, members, "bob", 5)
+	const isbn = "9780140009729"
+
+	read, err := store.GetBook(ctx, isbn)
+	if err != nil {
+		t.Fatalf("GetBook returned error: %v", err)
+	}
+	var reviews []*library.Review
+	for _, r := range []*library.Review{
+		{Isbn: isbn, Member: "alice", Rating: 5},
+		{Isbn: "0-14-000972-8", Member: "bob", Rating: 2},
+	} {
+		review, err := s.CreateReview(ctx, &library.CreateReviewRequest{Review: r})
+		if err != nil {
+			t.Fatalf("CreateReview returned error: %v", err)
+		}
+		reviews = append(reviews, review)
+	}
+	_, err = s.CreateReview(ctx, &library.CreateReviewRequest{
+		Review: &library.Review{Isbn: isbn, Member: "alice", Rating: 1},
+	})
+	if status.Code(err) != codes.AlreadyExists {
+		t.Errorf("second CreateReview returned error %v, want AlreadyExists", err)
+	}
+	_, err = s.CreateReview(ctx, &library.CreateReviewRequest{
+		Review: &library.Review{Isbn: "9780140008388", Member: "alice", Rating: 6},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("CreateReview with rating 6 returned error %v, want InvalidArgument", err)
+	}
+
+	bk, err := store.GetBook(ctx, isbn)
+	if err != nil {
+		t.Fatalf("GetBook returned error: %v", err)
+	}
+	if bk.GetReviewCount() != 2 || bk.GetAverageRating() != 3.5 {
+		t.Errorf("book has %d reviews rated %v, want 2 rated 3.5", bk.GetReviewCount(), bk.GetAverageRating())
+	}
+	// Reviews don't create revisions of the Book
+	if bk.GetEtag() != read.GetEtag() {
+		t.Errorf("book has etag %q after CreateReview, want %q", bk.GetEtag(), read.GetEtag())
+	}
+	history, err := store.BookHistory(ctx, isbn)
+	if err != nil {
+		t.Fatalf("BookHistory returned error: %v", err)
+	}
+	if len(history) != 1 {
+		t.Errorf("BookHistory returned %d changes after CreateReview, want 1", len(history))
+	}
+
+	_, err = s.DeleteReview(ctx, &library.DeleteReviewRequest{Id: reviews[0].GetId(), Member: "bob"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("DeleteReview by another member returned error %v, want PermissionDenied", err)
+	}
+	_, err = s.DeleteReview(ctx, &library.DeleteReviewRequest{Id: reviews[0].GetId(), Member: "alice"})
+	if err != nil {
+		t.Fatalf("DeleteReview returned error: %v", err)
+	}
+	bk, err = store.GetBook(ctx, isbn)
+	if err != nil {
+		t.Fatalf("GetBook returned error: %v", err)
+	}
+	if bk.GetReviewCount() != 1 || bk.GetAverageRating() != 2 || bk.GetEtag() != read.GetEtag() {
+		t.Errorf("book has %d reviews rated %v and etag %q, want 1 rated 2 and etag %q",
+			bk.GetReviewCount(), bk.GetAverageRating(), bk.GetEtag(), read.GetEtag())
+	}
+}
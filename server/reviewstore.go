@@ -0,0 +1,136 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// BookReviews is a Book and its Reviews, oldest first.
+type BookReviews struct {
+	Book    *library.Book
+	Reviews []*library.Review
+}
+
+// ReviewStore is the storage backend for the Reviews of the ReviewService.
+// The ratings of the Reviews of a Book are aggregated on the Book, so a
+// ReviewStore stores Reviews together with the Books of a BookStore, and
+// updates a Book and its Reviews in one transaction. Like UpdateBook,
+// changes to only the AverageRating and ReviewCount of a Book keep
+// its Etag and are not recorded as changes to the BookStore.
+// Implementations must be safe for concurrent use.
+// Errors returned should be gRPC status errors, as they
+// are passed on to the client unchanged.
+type ReviewStore interface {
+	// GetReview returns the Review with the ID provided.
+	// If no such Review exists, it returns a NotFound error.
+	GetReview(ctx context.Context, id string) (*library.Review, error)
+	// QueryReviews returns all Reviews for which match returns
+	// true, in the order they were added to the store.
+	QueryReviews(ctx context.Context, match func(*library.Review) bool) ([]*library.Review, error)
+	// UpdateReviews calls update with the Book with the ISBN provided and
+	// its Reviews, and stores the result, atomically with respect to other
+	// writes. Reviews may be added and removed, but their ISBNs can't be
+	// changed. If update returns an error, nothing is changed and the
+	// error is returned. If no such Book exists, it returns a NotFound error.
+	UpdateReviews(ctx context.Context, isbn string, update func(*BookReviews) error) (*BookReviews, error)
+}
+
+// GetReview implements ReviewStore.
+func (s *MemoryBookStore) GetReview(ctx context.Context, id string) (*library.Review, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	i, ok := s.reviewIndex[id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "Review could not be found")
+	}
+	return cloneReview(s.reviews[i]), nil
+}
+
+// QueryReviews implements ReviewStore.
+func (s *MemoryBookStore) QueryReviews(ctx context.Context, match func(*library.Review) bool) ([]*library.Review, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var reviews []*library.Review
+	for _, r := range s.reviews {
+		if match(r) {
+			reviews = append(reviews, cloneReview(r))
+		}
+	}
+	return reviews, nil
+}
+
+// UpdateReviews implements ReviewStore.
+func (s *MemoryBookStore) UpdateReviews(ctx context.Context, isbn string, update func(*BookReviews) error) (*BookReviews, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	i, ok := s.index[isbn]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "Book could not be found")
+	}
+
+	br := &BookReviews{Book: cloneBook(s.books[i])}
+	for _, r := range s.reviews {
+		if r.GetIsbn() == isbn {
+			br.Reviews = append(br.Reviews, cloneReview(r))
+		}
+	}
+
+	err := update(br)
+	if err != nil {
+		return nil, err
+	}
+	if br.Book.GetIsbn() != isbn {
+		return nil, status.Error(codes.InvalidArgument, "The ISBN of a book can't be changed")
+	}
+	updated := map[string]*library.Review{}
+	for _, r := range br.Reviews {
+		if r.GetIsbn() != isbn {
+			return nil, status.Error(codes.InvalidArgument, "The ISBN of a review can't be changed")
+		}
+		if j, ok := s.reviewIndex[r.GetId()]; ok && s.reviews[j].GetIsbn() != isbn {
+			return nil, status.Errorf(codes.AlreadyExists, "A review with ID %s already exists", r.GetId())
+		}
+		updated[r.GetId()] = r
+	}
+
+	if !proto.Equal(br.Book, s.books[i]) {
+		s.update(ctx, i, br.Book)
+	}
+	// Replace the Reviews of the Book, keeping the order of the others
+	reviews := s.reviews[:0]
+	for _, r := range s.reviews {
+		if r.GetIsbn() != isbn {
+			reviews = append(reviews, r)
+			continue
+		}
+		if u, ok := updated[r.GetId()]; ok {
+			reviews = append(reviews, cloneReview(u))
+			delete(updated, r.GetId())
+		}
+	}
+	for _, r := range br.Reviews {
+		if _, ok := updated[r.GetId()]; ok {
+			reviews = append(reviews, cloneReview(r))
+		}
+	}
+	s.reviews = reviews
+	s.reviewIndex = make(map[string]int, len(reviews))
+	for j, r := range reviews {
+		s.reviewIndex[r.GetId()] = j
+	}
+
+	return br, nil
+}
+
+// cloneReview returns a deep copy of r, so that callers
+// can't modify the contents of the store.
+func cloneReview(r *library.Review) *library.Review {
+	return proto.Clone(r).(*library.Review)
+}
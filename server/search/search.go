@@ -0,0 +1,278 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+// Package search implements a full-text index over
+// the title, author and publisher of books.
+package search
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// BM25 parameters. See https://en.wikipedia.org/wiki/Okapi_BM25.
+const (
+	k1 = 1.2
+	b  = 0.75
+)
+
+// prefixWeight is the weight of a query term matching
+// a longer term in the index, relative to an exact match.
+const prefixWeight = 0.5
+
+// snippetLen is the maximum length, in runes, of a highlight snippet.
+const snippetLen = 80
+
+// field is a searchable field of a Book.
+type field struct {
+	name   string
+	weight float64
+	get    func(*library.Book) string
+}
+
+var fields = []field{
+	{name: "title", weight: 3, get: (*library.Book).GetTitle},
+	{name: "author", weight: 2, get: (*library.Book).GetAuthor},
+	{name: "publisher", weight: 1, get: func(bk *library.Book) string {
+		return bk.GetPublisher().GetName()
+	}},
+}
+
+// document is the indexed content of a Book.
+type document struct {
+	texts  []string
+	tokens [][]Token
+}
+
+// Result is a Book matching a search query.
+type Result struct {
+	// Isbn is the ISBN of the matching Book.
+	Isbn string
+	// Score is the relevance of the Book to the query.
+	// Higher scores are more relevant.
+	Score float64
+	// Highlights contain the fields of the Book that matched.
+	Highlights []Highlight
+}
+
+// Highlight is an excerpt of a field matching a search query.
+type Highlight struct {
+	// Field is the name of the field.
+	Field string
+	// Snippet is the excerpt of the field.
+	Snippet string
+	// Matches are the parts of the snippet matching the query.
+	Matches []Range
+}
+
+// Range is a range of runes in a text, from Start to End exclusive.
+type Range struct {
+	Start, End int
+}
+
+// Index is an inverted index of Books.
+// It is safe for concurrent use.
+type Index struct {
+	mu   sync.RWMutex
+	docs map[string]*document
+	// postings maps a term to the term frequencies,
+	// by field, of each document containing it.
+	postings map[string]map[string][]int
+	// totalLen is the total number of tokens in each field.
+	totalLen []int
+}
+
+// NewIndex returns an empty Index.
+func NewIndex() *Index {
+	return &Index{
+		docs:     map[string]*document{},
+		postings: map[string]map[string][]int{},
+		totalLen: make([]int, len(fields)),
+	}
+}
+
+// Add indexes bk, replacing any existing entry with the same ISBN.
+func (idx *Index) Add(bk *library.Book) {
+	doc := &document{
+		texts:  make([]string, len(fields)),
+		tokens: make([][]Token, len(fields)),
+	}
+	for i, f := range fields {
+		doc.texts[i] = f.get(bk)
+		doc.tokens[i] = Tokenize(doc.texts[i])
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.remove(bk.GetIsbn())
+	idx.docs[bk.GetIsbn()] = doc
+	for i, toks := range doc.tokens {
+		idx.totalLen[i] += len(toks)
+		for _, tok := range toks {
+			docs, ok := idx.postings[tok.Term]
+			if !ok {
+				docs = map[string][]int{}
+				idx.postings[tok.Term] = docs
+			}
+			tfs, ok := docs[bk.GetIsbn()]
+			if !ok {
+				tfs = make([]int, len(fields))
+				docs[bk.GetIsbn()] = tfs
+			}
+			tfs[i]++
+		}
+	}
+}
+
+// Remove removes the Book with the ISBN provided from the index.
+func (idx *Index) Remove(isbn string) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.remove(isbn)
+}
+
+// remove removes isbn from the index. The caller must hold idx.mu.
+func (idx *Index) remove(isbn string) {
+	doc, ok := idx.docs[isbn]
+	if !ok {
+		return
+	}
+	for i, toks := range doc.tokens {
+		idx.totalLen[i] -= len(toks)
+		for _, tok := range toks {
+			docs := idx.postings[tok.Term]
+			delete(docs, isbn)
+			if len(docs) == 0 {
+				delete(idx.postings, tok.Term)
+			}
+		}
+	}
+	delete(idx.docs, isbn)
+}
+
+// Search returns up to limit Books matching query, most relevant first.
+// A Book matches if any of the terms in the query match a term in
+// the Book exactly, or are a prefix of a term in the Book.
+// Results are ranked using BM25, weighting matches in the
+// title higher than the author, and the author higher than the publisher.
+func (idx *Index) Search(query string, limit int) []Result {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	// Expand the query terms into the indexed terms they match
+	weights := map[string]float64{}
+	for _, tok := range Tokenize(query) {
+		for term := range idx.postings {
+			w := 0.0
+			switch {
+			case term == tok.Term:
+				w = 1
+			case strings.HasPrefix(term, tok.Term):
+				w = prefixWeight
+			}
+			if w > weights[term] {
+				weights[term] = w
+			}
+		}
+	}
+
+	n := float64(len(idx.docs))
+	scores := map[string]float64{}
+	for term, w := range weights {
+		docs := idx.postings[term]
+		df := float64(len(docs))
+		idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+		for isbn, tfs := range docs {
+			doc := idx.docs[isbn]
+			for i, tf := range tfs {
+				if tf == 0 {
+					continue
+				}
+				avgLen := float64(idx.totalLen[i]) / n
+				tfNorm := float64(tf) * (k1 + 1) /
+					(float64(tf) + k1*(1-b+b*float64(len(doc.tokens[i]))/avgLen))
+				scores[isbn] += w * fields[i].weight * idf * tfNorm
+			}
+		}
+	}
+
+	results := make([]Result, 0, len(scores))
+	for isbn, score := range scores {
+		results = append(results, Result{Isbn: isbn, Score: score})
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].Isbn < results[j].Isbn
+	})
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	for i := range results {
+		results[i].Highlights = idx.docs[results[i].Isbn].highlight(weights)
+	}
+
+	return results
+}
+
+// highlight returns the fields of doc containing any of terms.
+func (doc *document) highlight(terms map[string]float64) []Highlight {
+	var hls []Highlight
+	for i, toks := range doc.tokens {
+		var matches []Range
+		for _, tok := range toks {
+			if _, ok := terms[tok.Term]; ok {
+				matches = append(matches, Range{Start: tok.Start, End: tok.End})
+			}
+		}
+		if len(matches) == 0 {
+			continue
+		}
+		hls = append(hls, snippet(fields[i].name, doc.texts[i], matches))
+	}
+	return hls
+}
+
+// snippet returns a Highlight of at most snippetLen runes of text,
+// starting shortly before the first match. Ranges are adjusted
+// to the snippet, and matches outside the snippet are dropped.
+func snippet(name, text string, matches []Range) Highlight {
+	runes := []rune(text)
+	if len(runes) <= snippetLen {
+		return Highlight{Field: name, Snippet: text, Matches: matches}
+	}
+
+	start := matches[0].Start - snippetLen/4
+	if start < 0 {
+		start = 0
+	}
+	end := start + snippetLen
+	if end > len(runes) {
+		end = len(runes)
+		start = end - snippetLen
+	}
+
+	hl := Highlight{Field: name}
+	prefix := ""
+	if start > 0 {
+		prefix = "…"
+	}
+	suffix := ""
+	if end < len(runes) {
+		suffix = "…"
+	}
+	hl.Snippet = prefix + string(runes[start:end]) + suffix
+	offset := len([]rune(prefix)) - start
+	for _, m := range matches {
+		if m.Start < start || m.End > end {
+			continue
+		}
+		hl.Matches = append(hl.Matches, Range{Start: m.Start + offset, End: m.End + offset})
+	}
+	return hl
+}
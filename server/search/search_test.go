@@ -0,0 +1,84 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package search
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+func TestSearch(t *testing.T) {
+	idx := NewIndex()
+	for _, bk := range []*library.Book{
+		{Isbn: "1", Title: "The Hobbit", Author: "J. R. R. Tolkien"},
+		{Isbn: "2", Title: "Tolkien: A Biography", Author: "Humphrey Carpenter"},
+		{Isbn: "3", Title: "Les Misérables", Author: "Victor Hugo", PublishingMethod: &library.Book_Publisher{
+			Publisher: &library.Publisher{Name: "Hobbit Press"},
+		}},
+		{Isbn: "4", Title: "Hobbies", Author: "Anonymous"},
+	} {
+		idx.Add(bk)
+	}
+
+	tests := []struct {
+		query string
+		limit int
+		want  []string
+	}{
+		{query: "", limit: 10, want: []string{}},
+		{query: "dune", limit: 10, want: []string{}},
+		// Title matches rank above author and publisher matches
+		{query: "tolkien", limit: 10, want: []string{"2", "1"}},
+		{query: "hobbit", limit: 10, want: []string{"1", "3"}},
+		{query: "hobbit", limit: 1, want: []string{"1"}},
+		// Query terms match longer terms they are a prefix of
+		{query: "hobb", limit: 10, want: []string{"4", "1", "3"}},
+		{query: "MISERABLES", limit: 10, want: []string{"3"}},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, r := range idx.Search(tt.query, tt.limit) {
+			got = append(got, r.Isbn)
+		}
+		if got == nil {
+			got = []string{}
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Search(%q, %d) = %q, want %q", tt.query, tt.limit, got, tt.want)
+		}
+	}
+}
+
+func TestSearchHighlights(t *testing.T) {
+	idx := NewIndex()
+	idx.Add(&library.Book{Isbn: "1", Title: "Émile, or On Education", Author: "Jean-Jacques Rousseau"})
+
+	results := idx.Search("emile", 10)
+	if len(results) != 1 {
+		t.Fatalf("Search returned %d results, want 1", len(results))
+	}
+	want := []Highlight{{
+		Field:   "title",
+		Snippet: "Émile, or On Education",
+		Matches: []Range{{Start: 0, End: 5}},
+	}}
+	if got := results[0].Highlights; !reflect.DeepEqual(got, want) {
+		t.Errorf("Highlights = %+v, want %+v", got, want)
+	}
+}
+
+func TestRemove(t *testing.T) {
+	idx := NewIndex()
+	idx.Add(&library.Book{Isbn: "1", Title: "The Hobbit"})
+	idx.Add(&library.Book{Isbn: "1", Title: "The Silmarillion"})
+	if got := idx.Search("hobbit", 10); len(got) != 0 {
+		t.Errorf("Search after replacing the Book = %v, want none", got)
+	}
+	idx.Remove("1")
+	if got := idx.Search("silmarillion", 10); len(got) != 0 {
+		t.Errorf("Search after Remove = %v, want none", got)
+	}
+}
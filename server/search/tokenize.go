@@ -0,0 +1,83 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package search
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Token is a normalized term found in a text.
+type Token struct {
+	// Term is the normalized form of the token.
+	Term string
+	// Start and End are the offsets, in runes, of
+	// the token in the original text.
+	Start, End int
+}
+
+// Tokenize splits text into normalized terms. Terms are runs of
+// letters and digits. They are normalized by decomposing them
+// (NFKD), removing diacritical marks and folding them to lower case,
+// so that "Émile" and "emile" produce the same term.
+func Tokenize(text string) []Token {
+	var (
+		toks  []Token
+		term  strings.Builder
+		start int
+		pos   int
+	)
+	flush := func() {
+		if term.Len() > 0 {
+			toks = append(toks, Token{Term: term.String(), Start: start, End: pos})
+			term.Reset()
+		}
+	}
+	for _, r := range text {
+		if unicode.Is(unicode.Mn, r) {
+			// Combining marks belong to the preceding rune
+			pos++
+			continue
+		}
+		folded := foldRune(r)
+		if folded == "" {
+			flush()
+		} else {
+			if term.Len() == 0 {
+				start = pos
+			}
+			term.WriteString(folded)
+		}
+		pos++
+	}
+	flush()
+	return toks
+}
+
+// Normalize returns the normalized terms of text, joined by spaces.
+func Normalize(text string) string {
+	toks := Tokenize(text)
+	terms := make([]string, len(toks))
+	for i, t := range toks {
+		terms[i] = t.Term
+	}
+	return strings.Join(terms, " ")
+}
+
+// foldRune returns the normalized form of r, or the empty
+// string if r separates terms.
+func foldRune(r rune) string {
+	var b strings.Builder
+	for _, d := range norm.NFKD.String(string(r)) {
+		switch {
+		case unicode.Is(unicode.Mn, d):
+			// Drop diacritical marks
+		case unicode.IsLetter(d) || unicode.IsDigit(d):
+			b.WriteRune(unicode.ToLower(d))
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,57 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package search
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		text string
+		want []Token
+	}{
+		{text: "", want: nil},
+		{text: "The Hobbit", want: []Token{
+			{Term: "the", Start: 0, End: 3},
+			{Term: "hobbit", Start: 4, End: 10},
+		}},
+		{text: "Émile, or On Education", want: []Token{
+			{Term: "emile", Start: 0, End: 5},
+			{Term: "or", Start: 7, End: 9},
+			{Term: "on", Start: 10, End: 12},
+			{Term: "education", Start: 13, End: 22},
+		}},
+		// A decomposed É takes two runes of the text
+		{text: "E\u0301mile", want: []Token{
+			{Term: "emile", Start: 0, End: 6},
+		}},
+		{text: "Catch-22", want: []Token{
+			{Term: "catch", Start: 0, End: 5},
+			{Term: "22", Start: 6, End: 8},
+		}},
+	}
+	for _, tt := range tests {
+		if got := Tokenize(tt.text); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Tokenize(%q) = %v, want %v", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{text: "", want: ""},
+		{text: "  Les Misérables!", want: "les miserables"},
+		{text: "ＡＢＣ", want: "abc"},
+	}
+	for _, tt := range tests {
+		if got := Normalize(tt.text); got != tt.want {
+			t.Errorf("Normalize(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+	"github.com/johanbrandhorst/grpcweb-example/server/search"
+)
+
+func (s *BookService) SearchBooks(ctx context.Context, req *library.SearchBooksRequest) (*library.SearchBooksResponse, error) {
+	if search.Normalize(req.GetQuery()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "The query must contain at least one word")
+	}
+	limit := int(req.GetLimit())
+	switch {
+	case limit < 0:
+		return nil, status.Error(codes.InvalidArgument, "The limit must not be negative")
+	case limit == 0:
+		limit = defaultPageSize
+	case limit > maxPageSize:
+		limit = maxPageSize
+	}
+
+	idx, err := s.searchIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &library.SearchBooksResponse{}
+	for _, res := range idx.Search(req.GetQuery(), limit) {
+		bk, err := s.store.GetBook(ctx, res.Isbn)
+		if status.Code(err) == codes.NotFound {
+			// Deleted since the search
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		s.localize(ctx, bk)
+		result := &library.SearchResult{
+			Book:  bk,
+			Score: res.Score,
+		}
+		for _, hl := range res.Highlights {
+			highlight := &library.Highlight{
+				Field:   hl.Field,
+				Snippet: hl.Snippet,
+			}
+			for _, m := range hl.Matches {
+				highlight.Matches = append(highlight.Matches, &library.TextRange{
+					Start: int32(m.Start),
+					End:   int32(m.End),
+				})
+			}
+			result.Highlights = append(result.Highlights, highlight)
+		}
+		resp.Results = append(resp.Results, result)
+	}
+
+	return resp, nil
+}
+
+// searchIndex returns the search index,
+// building it from the store on first use.
+func (s *BookService) searchIndex(ctx context.Context) (*search.Index, error) {
+	s.indexMu.Lock()
+	defer s.indexMu.Unlock()
+	if s.index != nil {
+		return s.index, nil
+	}
+
+	books, err := s.store.QueryBooks(ctx, func(*library.Book) bool { return true })
+	if err != nil {
+		return nil, err
+	}
+	idx := search.NewIndex()
+	for _, bk := range books {
+		idx.Add(bk)
+	}
+	s.index = idx
+
+	return idx, nil
+}
+
+// reindex updates the search index with the
+// current state of the Book with the ISBN provided.
+// It must be called after every write to the store.
+func (s *BookService) reindex(ctx context.Context, isbn string) {
+	s.indexMu.Lock()
+	defer s.indexMu.Unlock()
+	if s.index == nil {
+		// Will be built from the store on first use
+		return
+	}
+
+	bk, err := s.store.GetBook(ctx, isbn)
+	switch {
+	case err == nil:
+		s.index.Add(bk)
+	case status.Code(err) == codes.NotFound:
+		s.index.Remove(isbn)
+	default:
+		// Can't tell what state the book is in,
+		// rebuild the index on next use.
+		s.index = nil
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"sort"
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// searchTitles returns the titles of the Books found by
+// searching for query, sorted, as results with equal
+// scores are returned in ISBN order.
+func searchTitles(t *testing.T, s *BookService, query string) []string {
+	t.Helper()
+	resp, err := s.SearchBooks(context.Background(), &library.SearchBooksRequest{Query: query})
+	if err != nil {
+		t.Fatalf("SearchBooks(%q) returned error: %v", query, err)
+	}
+	var titles []string
+	for _, res := range resp.GetResults() {
+		titles = append(titles, res.GetBook().GetTitle())
+	}
+	sort.Strings(titles)
+	return titles
+}
+
+func TestSearchBooks(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestBookService()
+
+	if got, want := searchTitles(t, s, "orwell"), []string{"Animal Farm", "Nineteen Eighty-Four"}; !equalStrings(got, want) {
+		t.Errorf("search for orwell found %q, want %q", got, want)
+	}
+
+	// The index follows changes made to the library
+	_, err := s.UpdateBook(ctx, &library.UpdateBookRequest{
+		Book:       &library.Book{Isbn: "9780140008388", Title: "Animal Farm: A Fairy Story"},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"title"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateBook returned error: %v", err)
+	}
+	_, err = s.DeleteBook(ctx, &library.DeleteBookRequest{Isbn: "9780140009729"})
+	if err != nil {
+		t.Fatalf("DeleteBook returned error: %v", err)
+	}
+	if got, want := searchTitles(t, s, "orwell"), []string{"Animal Farm: A Fairy Story"}; !equalStrings(got, want) {
+		t.Errorf("search for orwell after changes found %q, want %q", got, want)
+	}
+	if got, want := searchTitles(t, s, "fairy"), []string{"Animal Farm: A Fairy Story"}; !equalStrings(got, want) {
+		t.Errorf("search for fairy found %q, want %q", got, want)
+	}
+
+	resp, err := s.SearchBooks(ctx, &library.SearchBooksRequest{Query: "alice"})
+	if err != nil {
+		t.Fatalf("SearchBooks returned error: %v", err)
+	}
+	if len(resp.GetResults()) != 2 {
+		t.Fatalf("search for alice found %d books, want 2", len(resp.GetResults()))
+	}
+	hl := resp.GetResults()[0].GetHighlights()
+	if len(hl) == 0 || hl[0].GetField() != "title" || len(hl[0].GetMatches()) != 1 {
+		t.Errorf("search for alice returned highlights %v, want one match in the title", hl)
+	}
+
+	for _, query := range []string{"", " !? "} {
+		_, err = s.SearchBooks(ctx, &library.SearchBooksRequest{Query: query})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("SearchBooks(%q) returned error %v, want InvalidArgument", query, err)
+		}
+	}
+}
@@ -5,105 +5,109 @@ package server
 
 import (
 	"io"
-	"strings"
 	"sync"
 	"time"
 
-	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/golang/protobuf/ptypes"
 	"golang.org/x/net/context"
-	"google.golang.org/grpc"
+	"golang.org/x/text/language"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/johanbrandhorst/grpcweb-example/server/blob"
+	"github.com/johanbrandhorst/grpcweb-example/server/isbn"
 	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+	"github.com/johanbrandhorst/grpcweb-example/server/recommend"
+	"github.com/johanbrandhorst/grpcweb-example/server/search"
 )
 
+const (
+	defaultPageSize = 10
+	maxPageSize     = 100
+)
+
+// BookService implements library.BookServiceServer.
 type BookService struct {
-	b broadcaster
+	store       BookStore
+	collections CollectionStore
+	members     MemberStore
+	authors     AuthorStore
+	works       WorkStore
+	subjects    SubjectStore
+	tags        TagStore
+	covers      blob.Store
+	loans       LoanStore
+	tokenKey    []byte
+	locale      language.Tag
+	b           broadcaster
+
+	bookmarkInterval time.Duration
+
+	authorsMu   sync.Mutex
+	worksMu     sync.Mutex
+	subjectsMu  sync.Mutex
+	originalsMu sync.Mutex
+
+	indexMu sync.Mutex
+	index   *search.Index
+
+	recsMu    sync.Mutex
+	recs      *recommend.Model
+	loansSeen int
 }
 
-var books = []*library.Book{
-	&library.Book{
-		Isbn:     60929871,
-		Title:    "Brave New World",
-		Author:   "Aldous Huxley",
-		BookType: library.BookType_HARDCOVER,
-		PublishingMethod: &library.Book_Publisher{
-			Publisher: &library.Publisher{
-				Name: "Chatto & Windus",
-			},
-		},
-		PublicationDate: &timestamp.Timestamp{
-			Seconds: time.Date(1932, time.January, 1, 0, 0, 0, 0, time.UTC).Unix(),
-		},
-	},
-	&library.Book{
-		Isbn:     140009728,
-		Title:    "Nineteen Eighty-Four",
-		Author:   "George Orwell",
-		BookType: library.BookType_PAPERBACK,
-		PublishingMethod: &library.Book_Publisher{
-			Publisher: &library.Publisher{
-				Name: "Secker & Warburg",
-			},
-		},
-		PublicationDate: &timestamp.Timestamp{
-			Seconds: time.Date(1949, time.June, 8, 0, 0, 0, 0, time.UTC).Unix(),
-		},
-	},
-	&library.Book{
-		Isbn:     9780140301694,
-		Title:    "Alice's Adventures in Wonderland",
-		Author:   "Lewis Carroll",
-		BookType: library.BookType_AUDIOBOOK,
-		PublishingMethod: &library.Book_Publisher{
-			Publisher: &library.Publisher{
-				Name: "Macmillan",
-			},
-		},
-		PublicationDate: &timestamp.Timestamp{
-			Seconds: time.Date(1865, time.November, 26, 0, 0, 0, 0, time.UTC).Unix(),
-		},
-	},
-	&library.Book{
-		Isbn:     140008381,
-		Title:    "Animal Farm",
-		Author:   "George Orwell",
-		BookType: library.BookType_HARDCOVER,
-		PublishingMethod: &library.Book_Publisher{
-			Publisher: &library.Publisher{
-				Name: "Secker & Warburg",
-			},
-		},
-		PublicationDate: &timestamp.Timestamp{
-			Seconds: time.Date(1945, time.August, 17, 0, 0, 0, 0, time.UTC).Unix(),
-		},
-	},
-	&library.Book{
-		Isbn:     1501107739,
-		Title:    "Still Alice",
-		Author:   "Lisa Genova",
-		BookType: library.BookType_PAPERBACK,
-		PublishingMethod: &library.Book_SelfPublished{
-			SelfPublished: true,
-		},
-		PublicationDate: &timestamp.Timestamp{
-			Seconds: time.Date(2007, time.January, 1, 0, 0, 0, 0, time.UTC).Unix(),
-		},
-	},
+// NewBookService returns a BookService backed by the BookStore provided.
+func NewBookService(store BookStore, opts ...Option) *BookService {
+	s := &BookService{
+		store:       store,
+		collections: &MemoryCollectionStore{},
+		members:     &MemoryMemberStore{},
+		authors:     &MemoryAuthorStore{},
+		works:       &MemoryWorkStore{},
+		subjects:    &MemorySubjectStore{},
+		covers:      blob.Dir("covers"),
+		locale:      language.English,
+
+		bookmarkInterval: defaultBookmarkInterval,
+	}
+	if tags, ok := store.(TagStore); ok {
+		s.tags = tags
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.tokenKey == nil {
+		s.tokenKey = newTokenKey()
+	}
+	return s
 }
 
 func (s *BookService) GetBook(ctx context.Context, bookQuery *library.GetBookRequest) (*library.Book, error) {
-	for _, bk := range books {
-		if bk.Isbn == bookQuery.Isbn {
-			return bk, nil
-		}
+	id, err := requestIsbn(bookQuery.GetIsbn(), bookQuery.GetLegacyIsbn())
+	if err != nil {
+		return nil, err
+	}
+	var bk *library.Book
+	if bookQuery.GetAsOf() != nil {
+		bk, err = s.getBookAsOf(ctx, id, bookQuery.GetAsOf())
+	} else {
+		bk, err = s.store.GetBook(ctx, id)
+	}
+	if err != nil {
+		return nil, err
 	}
+	s.localize(ctx, bk)
 
-	return nil, grpc.Errorf(codes.NotFound, "Book could not be found")
+	return bk, nil
 }
 
 func (s *BookService) QueryBooks(bookQuery *library.QueryBooksRequest, stream library.BookService_QueryBooksServer) error {
+	books, err := s.queryBooks(stream.Context(), bookQuery)
+	if err != nil {
+		return err
+	}
+	s.localize(stream.Context(), books...)
+
 	for _, book := range books {
 		select {
 		case <-stream.Context().Done():
@@ -111,29 +115,430 @@ func (s *BookService) QueryBooks(bookQuery *library.QueryBooksRequest, stream li
 		default:
 		}
 
-		if strings.HasPrefix(book.Author, bookQuery.AuthorPrefix) {
-			err := stream.Send(book)
-			if err != nil {
-				return err
-			}
+		err := stream.Send(book)
+		if err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
-func (s *BookService) MakeCollection(srv library.BookService_MakeCollectionServer) error {
-	collection := &library.Collection{}
-	for {
-		bk, err := srv.Recv()
-		if err == io.EOF {
-			return srv.SendAndClose(collection)
+// queryBooks returns the Books matching the filters of
+// the query, in the order requested.
+func (s *BookService) queryBooks(ctx context.Context, query *library.QueryBooksRequest) ([]*library.Book, error) {
+	match, t, err := s.queryMatch(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var books []*library.Book
+	if !t.IsZero() {
+		books, err = s.store.BooksAsOf(ctx, t, match)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		books, err = s.store.QueryBooks(ctx, match)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if query.GetOrderBy() != "" {
+		order, err := parseBookOrder(query.GetOrderBy(), s.locale)
+		if err != nil {
+			return nil, err
+		}
+		err = order.loadSortNames(ctx, s.authors)
+		if err != nil {
+			return nil, err
+		}
+		order.sort(books)
+	}
+	if query.GetCollapseEditions() {
+		books = collapseEditions(books)
+	}
+
+	return books, nil
+}
+
+// queryMatch returns a function reporting whether a Book matches
+// the filters of the query, and the as_of time of the query,
+// which is the zero time if it queries the current Books.
+func (s *BookService) queryMatch(ctx context.Context, query *library.QueryBooksRequest) (func(*library.Book) bool, time.Time, error) {
+	match, err := queryMatcher(query)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	if len(query.GetSubjectCodes()) > 0 {
+		// Subjects match the subjects under them,
+		// so they are matched against the taxonomy.
+		inSubjects, err := s.subjectMatcher(ctx, query.GetSubjectCodes())
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		queryMatch := match
+		match = func(bk *library.Book) bool {
+			return queryMatch(bk) && inSubjects(bk)
+		}
+	}
+
+	var t time.Time
+	if query.GetAsOf() != nil {
+		t, err = ptypes.Timestamp(query.GetAsOf())
+		if err != nil {
+			return nil, time.Time{}, status.Error(codes.InvalidArgument, "Invalid as_of time")
+		}
+	}
+	return match, t, nil
+}
+
+func (s *BookService) ListBooks(ctx context.Context, req *library.ListBooksRequest) (*library.ListBooksResponse, error) {
+	pageSize, err := parsePageSize(req.GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+	order, err := parseBookOrder(req.GetOrderBy(), s.locale)
+	if err != nil {
+		return nil, err
+	}
+	err = order.loadSortNames(ctx, s.authors)
+	if err != nil {
+		return nil, err
+	}
+	match, err := parseBookFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	var last *sortKey
+	if req.GetPageToken() != "" {
+		token, err := decodePageToken(s.tokenKey, req.GetPageToken())
+		if err != nil {
+			return nil, err
 		}
+		if token.Filter != req.GetFilter() || token.OrderBy != req.GetOrderBy() {
+			return nil, status.Error(codes.InvalidArgument, "The filter and order_by must not change between pages")
+		}
+		last = &token.Last
+	}
+
+	books, err := s.store.QueryBooks(ctx, func(bk *library.Book) bool {
+		return match(bk) && (last == nil || order.less(*last, order.key(bk)))
+	})
+	if err != nil {
+		return nil, err
+	}
+	order.sort(books)
+
+	resp := &library.ListBooksResponse{}
+	if len(books) > pageSize {
+		books = books[:pageSize]
+		resp.NextPageToken, err = encodePageToken(s.tokenKey, pageToken{
+			Filter:  req.GetFilter(),
+			OrderBy: req.GetOrderBy(),
+			Last:    order.key(books[len(books)-1]),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	s.localize(ctx, books...)
+	resp.Books = books
+
+	return resp, nil
+}
+
+// parsePageSize returns the page size to use for a
+// list request, applying the default and maximum sizes.
+func parsePageSize(size int32) (int, error) {
+	switch {
+	case size < 0:
+		return 0, status.Error(codes.InvalidArgument, "The page size must not be negative")
+	case size == 0:
+		return defaultPageSize, nil
+	case size > maxPageSize:
+		return maxPageSize, nil
+	}
+	return int(size), nil
+}
+
+func (s *BookService) CreateBook(ctx context.Context, req *library.CreateBookRequest) (*library.Book, error) {
+	ctx, err := requestActor(ctx, s.members)
+	if err != nil {
+		return nil, err
+	}
+	err = canonicalizeIsbn(req.GetBook())
+	if err != nil {
+		return nil, err
+	}
+	err = validateBook(req.GetBook())
+	if err != nil {
+		return nil, err
+	}
+	err = canonicalizeLanguages(req.GetBook())
+	if err != nil {
+		return nil, err
+	}
+	authors, err := s.bookAuthors(ctx, req.GetBook(), len(req.GetBook().GetAuthorIds()) == 0)
+	if err != nil {
+		return nil, err
+	}
+	setAuthors(req.GetBook(), authors)
+	if req.GetBook().GetWorkId() != "" {
+		err = s.checkWork(ctx, req.GetBook().GetWorkId())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(req.GetBook().GetSubjectCodes()) > 0 {
+		// Hold the lock until the Book is stored,
+		// so its subjects can't be deleted in between.
+		s.subjectsMu.Lock()
+		defer s.subjectsMu.Unlock()
+		err = s.checkSubjects(ctx, req.GetBook().GetSubjectCodes())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if req.GetBook().GetOriginalIsbn() != "" {
+		// Hold the lock until the Book is stored,
+		// so its original can't be deleted in between.
+		s.originalsMu.Lock()
+		defer s.originalsMu.Unlock()
+		err = s.checkOriginal(ctx, req.GetBook())
+		if err != nil {
+			return nil, err
+		}
+	}
+	req.GetBook().AvailableCopies = req.GetBook().GetCopies()
+	req.GetBook().AverageRating, req.GetBook().ReviewCount = 0, 0
+	req.GetBook().CoverUrl = ""
+	req.GetBook().Tags = nil
+	req.GetBook().Localized = nil
+
+	err = s.store.AddBook(ctx, req.GetBook())
+	if err != nil {
+		return nil, err
+	}
+	s.reindex(ctx, req.GetBook().GetIsbn())
+	s.localize(ctx, req.GetBook())
+
+	return req.GetBook(), nil
+}
+
+func (s *BookService) UpdateBook(ctx context.Context, req *library.UpdateBookRequest) (*library.Book, error) {
+	ctx, err := requestActor(ctx, s.members)
+	if err != nil {
+		return nil, err
+	}
+	err = canonicalizeIsbn(req.GetBook())
+	if err != nil {
+		return nil, err
+	}
+	mask := req.GetUpdateMask()
+	err = validateBookMask(mask)
+	if err != nil {
+		return nil, err
+	}
+	updateAuthors := updatesField(mask, "author_ids") || updatesField(mask, "author")
+	var authors []*library.Author
+	if updateAuthors {
+		// The deprecated author names are used unless author IDs are provided
+		byName := updatesField(mask, "author") &&
+			(!updatesField(mask, "author_ids") || len(req.GetBook().GetAuthorIds()) == 0)
+		authors, err = s.bookAuthors(ctx, req.GetBook(), byName)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if updatesField(mask, "work_id") && req.GetBook().GetWorkId() != "" {
+		err = s.checkWork(ctx, req.GetBook().GetWorkId())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if updatesField(mask, "subject_codes") {
+		// Hold the lock until the Book is stored,
+		// so its subjects can't be deleted in between.
+		s.subjectsMu.Lock()
+		defer s.subjectsMu.Unlock()
+		err = s.checkSubjects(ctx, req.GetBook().GetSubjectCodes())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if updatesField(mask, "original_isbn") && req.GetBook().GetOriginalIsbn() != "" {
+		// Hold the lock until the Book is stored,
+		// so its original can't be deleted in between.
+		s.originalsMu.Lock()
+		defer s.originalsMu.Unlock()
+		err = s.checkOriginal(ctx, req.GetBook())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// update applies the request to bk, a Book
+	// with the number of barcoded Copies provided.
+	update := func(bk *library.Book, barcoded int) error {
+		err := checkEtag(bk, req.GetBook().GetEtag())
+		if err != nil {
+			return err
+		}
+		onLoan := bk.GetCopies() - bk.GetAvailableCopies()
+		applyBookMask(bk, req.GetBook(), mask)
+		if updateAuthors {
+			setAuthors(bk, authors)
+		}
+		bk.AvailableCopies = bk.GetCopies() - onLoan
+		if bk.GetAvailableCopies() < 0 {
+			return status.Errorf(codes.FailedPrecondition, "%d copies of the book are on loan", onLoan)
+		}
+		if int(bk.GetCopies()) < barcoded {
+			return status.Errorf(codes.FailedPrecondition, "The book has %d copies with a barcode", barcoded)
+		}
+		err = validateBook(bk)
 		if err != nil {
 			return err
 		}
+		return canonicalizeLanguages(bk)
+	}
+	var bk *library.Book
+	if updatesField(mask, "copies") && s.loans != nil {
+		// Read the Copies in the same transaction,
+		// so none can be added in between.
+		var l *Lending
+		l, err = s.loans.UpdateLending(ctx, req.GetBook().GetIsbn(), func(l *Lending) error {
+			return update(l.Book, len(l.Copies))
+		})
+		if l != nil {
+			bk = l.Book
+		}
+	} else {
+		bk, err = s.store.UpdateBook(ctx, req.GetBook().GetIsbn(), func(bk *library.Book) error {
+			return update(bk, 0)
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	s.reindex(ctx, bk.GetIsbn())
+	s.localize(ctx, bk)
+
+	return bk, nil
+}
 
-		collection.Books = append(collection.Books, bk)
+func (s *BookService) DeleteBook(ctx context.Context, req *library.DeleteBookRequest) (*library.Book, error) {
+	ctx, err := requestActor(ctx, s.members)
+	if err != nil {
+		return nil, err
+	}
+	id, err := requestIsbn(req.GetIsbn(), req.GetLegacyIsbn())
+	if err != nil {
+		return nil, err
+	}
+
+	// Hold the lock until the Book is deleted, so
+	// no translation of it can be added in between.
+	s.originalsMu.Lock()
+	defer s.originalsMu.Unlock()
+	translations, err := s.store.QueryBooks(ctx, func(bk *library.Book) bool {
+		return bk.GetOriginalIsbn() == id
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(translations) > 0 {
+		return nil, status.Error(codes.FailedPrecondition, "Translations of the book are in the library")
+	}
+
+	bk, err := s.store.DeleteBook(ctx, id, func(bk *library.Book) error {
+		err := checkEtag(bk, req.GetEtag())
+		if err != nil {
+			return err
+		}
+		if bk.GetAvailableCopies() < bk.GetCopies() {
+			return status.Error(codes.FailedPrecondition, "Copies of the book are on loan")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.reindex(ctx, bk.GetIsbn())
+	s.localize(ctx, bk)
+
+	return bk, nil
+}
+
+// checkEtag returns an Aborted error if etag is
+// set and is not the etag of the stored Book bk.
+func checkEtag(bk *library.Book, etag string) error {
+	if etag != "" && etag != bk.GetEtag() {
+		return status.Error(codes.Aborted, "The book has been changed since it was read")
+	}
+	return nil
+}
+
+// validateBook returns an InvalidArgument error if
+// bk is missing any fields required of books in the library.
+func validateBook(bk *library.Book) error {
+	switch {
+	case bk == nil:
+		return status.Error(codes.InvalidArgument, "A book must be provided")
+	case bk.GetIsbn() == "":
+		return status.Error(codes.InvalidArgument, "The ISBN must not be empty")
+	case bk.GetTitle() == "":
+		return status.Error(codes.InvalidArgument, "The title must not be empty")
+	case bk.GetCopies() < 0:
+		return status.Error(codes.InvalidArgument, "The number of copies must not be negative")
+	}
+	return nil
+}
+
+// canonicalizeIsbn validates the ISBN of bk, and sets all forms
+// of the ISBN from the canonical ISBN-13. Either the ISBN or
+// the legacy ISBN must be set. If both are, they must match.
+func canonicalizeIsbn(bk *library.Book) error {
+	if bk == nil {
+		return status.Error(codes.InvalidArgument, "A book must be provided")
+	}
+	canonical, err := requestIsbn(bk.GetIsbn(), bk.GetLegacyIsbn())
+	if err != nil {
+		return err
+	}
+	if bk.GetIsbn() != "" && bk.GetLegacyIsbn() != 0 {
+		legacy, err := isbn.FromLegacy(bk.GetLegacyIsbn())
+		if err != nil || legacy != canonical {
+			return status.Error(codes.InvalidArgument, "The ISBN and legacy ISBN don't match")
+		}
+	}
+
+	bk.Isbn = canonical
+	if bk.GetLegacyIsbn() == 0 {
+		bk.LegacyIsbn = isbn.Legacy(canonical)
 	}
+	bk.Isbn10, _ = isbn.To10(canonical)
+	return nil
+}
+
+// requestIsbn returns the canonical ISBN-13 of the ISBN in a request,
+// preferring the string ISBN over the legacy numeric one.
+func requestIsbn(s string, legacy int64) (string, error) {
+	var canonical string
+	var err error
+	switch {
+	case s != "":
+		canonical, err = isbn.Parse(s)
+	case legacy != 0:
+		canonical, err = isbn.FromLegacy(legacy)
+	default:
+		return "", status.Error(codes.InvalidArgument, "An ISBN must be provided")
+	}
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "Invalid ISBN: %v", err)
+	}
+	return canonical, nil
 }
 
 type broadcaster struct {
@@ -141,25 +546,25 @@ type broadcaster struct {
 	listeners  map[string]chan<- string
 }
 
-func (b *broadcaster) Add(name string, listener chan<- string) error {
+func (b *broadcaster) Add(id string, listener chan<- string) error {
 	b.listenerMu.Lock()
 	defer b.listenerMu.Unlock()
 	if b.listeners == nil {
 		b.listeners = map[string]chan<- string{}
 	}
-	if _, ok := b.listeners[name]; ok {
-		return status.Errorf(codes.AlreadyExists, "The name %q is already in use by someone", name)
+	if _, ok := b.listeners[id]; ok {
+		return status.Errorf(codes.AlreadyExists, "The member %s is already in the chat", id)
 	}
-	b.listeners[name] = listener
+	b.listeners[id] = listener
 	return nil
 }
 
-func (b *broadcaster) Remove(name string) {
+func (b *broadcaster) Remove(id string) {
 	b.listenerMu.Lock()
 	defer b.listenerMu.Unlock()
-	if c, ok := b.listeners[name]; ok {
+	if c, ok := b.listeners[id]; ok {
 		close(c)
-		delete(b.listeners, name)
+		delete(b.listeners, id)
 	}
 }
 
@@ -176,7 +581,7 @@ func (b *broadcaster) Broadcast(ctx context.Context, msg string) {
 }
 
 func (s *BookService) BookChat(srv library.BookService_BookChatServer) error {
-	// Listen for initial message with name
+	// Listen for initial message with the member ID
 	msg, err := srv.Recv()
 	if err == io.EOF {
 		// Uhh... if you insist!
@@ -185,21 +590,28 @@ func (s *BookService) BookChat(srv library.BookService_BookChatServer) error {
 	if err != nil {
 		return err
 	}
-	name := msg.GetName()
-	if name == "" {
-		return status.Error(codes.FailedPrecondition, "first message should be the name of the user")
+	if msg.GetMemberId() == "" {
+		return status.Error(codes.FailedPrecondition, "first message should be the ID of a member")
+	}
+	member, err := s.members.GetMember(srv.Context(), msg.GetMemberId())
+	if err != nil {
+		return err
+	}
+	if member.GetState() == library.Member_SUSPENDED {
+		return status.Error(codes.PermissionDenied, "Suspended members can't join the chat")
 	}
+	name := member.GetName()
 
 	// Send join message before user joins
 	s.b.Broadcast(srv.Context(), name+" has joined the chat")
 
 	listener := make(chan string)
-	err = s.b.Add(name, listener)
+	err = s.b.Add(member.GetId(), listener)
 	if err != nil {
 		return err
 	}
 	defer func() {
-		s.b.Remove(name)
+		s.b.Remove(member.GetId())
 		s.b.Broadcast(context.Background(), name+" has left the chat")
 	}()
 
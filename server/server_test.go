@@ -0,0 +1,366 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// newTestBookService returns a BookService backed
+// by a MemoryBookStore seeded with the Fixtures.
+func newTestBookService(opts ...Option) (*BookService, *MemoryBookStore) {
+	store := NewMemoryBookStore(Fixtures()...)
+	return NewBookService(store, opts...), store
+}
+
+// testServerStream is the grpc.ServerStream of a
+// streaming call made directly to a service in a test.
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s testServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// bookStream collects the Books sent by a server streaming method.
+type bookStream struct {
+	testServerStream
+	books []*library.Book
+}
+
+func (s *bookStream) Send(bk *library.Book) error {
+	s.books = append(s.books, bk)
+	return nil
+}
+
+// bookTitles returns the titles of books.
+func bookTitles(books []*library.Book) []string {
+	titles := make([]string, 0, len(books))
+	for _, bk := range books {
+		titles = append(titles, bk.GetTitle())
+	}
+	return titles
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestListBooksPages(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestBookService()
+
+	req := &library.ListBooksRequest{PageSize: 2, OrderBy: "title"}
+	first, err := s.ListBooks(ctx, req)
+	if err != nil {
+		t.Fatalf("ListBooks returned error: %v", err)
+	}
+	want := []string{"Alice's Adventures in Wonderland", "Animal Farm"}
+	if got := bookTitles(first.GetBooks()); !equalStrings(got, want) {
+		t.Fatalf("first page is %q, want %q", got, want)
+	}
+	if first.GetNextPageToken() == "" {
+		t.Fatal("first page has no next page token")
+	}
+
+	// A book added before the position of the token is not
+	// returned, and doesn't shift the books after it.
+	_, err = s.CreateBook(ctx, &library.CreateBookRequest{Book: &library.Book{
+		Isbn:  "9780306406157",
+		Title: "A Book Added Between Pages",
+	}})
+	if err != nil {
+		t.Fatalf("CreateBook returned error: %v", err)
+	}
+
+	var got []string
+	token := first.GetNextPageToken()
+	for token != "" {
+		req.PageToken = token
+		resp, err := s.ListBooks(ctx, req)
+		if err != nil {
+			t.Fatalf("ListBooks returned error: %v", err)
+		}
+		got = append(got, bookTitles(resp.GetBooks())...)
+		token = resp.GetNextPageToken()
+	}
+	want = []string{"Brave New World", "Nineteen Eighty-Four", "Still Alice"}
+	if !equalStrings(got, want) {
+		t.Errorf("remaining pages are %q, want %q", got, want)
+	}
+}
+
+func TestListBooksChangedRequest(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestBookService()
+	resp, err := s.ListBooks(ctx, &library.ListBooksRequest{PageSize: 1, Filter: `author = "George Orwell"`})
+	if err != nil {
+		t.Fatalf("ListBooks returned error: %v", err)
+	}
+	if len(resp.GetBooks()) != 1 || resp.GetNextPageToken() == "" {
+		t.Fatalf("ListBooks returned %d books and token %q, want 1 book and a token", len(resp.GetBooks()), resp.GetNextPageToken())
+	}
+
+	_, err = s.ListBooks(ctx, &library.ListBooksRequest{PageSize: 1, PageToken: resp.GetNextPageToken()})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ListBooks with a changed filter returned error %v, want InvalidArgument", err)
+	}
+	_, err = s.ListBooks(ctx, &library.ListBooksRequest{
+		PageSize:  1,
+		Filter:    `author = "George Orwell"`,
+		OrderBy:   "title",
+		PageToken: resp.GetNextPageToken(),
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ListBooks with a changed order returned error %v, want InvalidArgument", err)
+	}
+	_, err = s.ListBooks(ctx, &library.ListBooksRequest{PageSize: -1})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ListBooks with a negative page size returned error %v, want InvalidArgument", err)
+	}
+}
+
+func TestGetBookIsbnForms(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestBookService()
+	for _, req := range []*library.GetBookRequest{
+		{Isbn: "9780140009729"},
+		{Isbn: "978-0-14-000972-9"},
+		{Isbn: "0140009728"},
+		{Isbn: "0-14-000972-8"},
+		{LegacyIsbn: 140009728},
+	} {
+		bk, err := s.GetBook(ctx, req)
+		if err != nil {
+			t.Errorf("GetBook(%v) returned error: %v", req, err)
+			continue
+		}
+		if bk.GetTitle() != "Nineteen Eighty-Four" {
+			t.Errorf("GetBook(%v) returned %q, want Nineteen Eighty-Four", req, bk.GetTitle())
+		}
+	}
+
+	_, err := s.GetBook(ctx, &library.GetBookRequest{Isbn: "0140009729"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("GetBook with a bad check digit returned error %v, want InvalidArgument", err)
+	}
+	_, err = s.GetBook(ctx, &library.GetBookRequest{})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("GetBook without an ISBN returned error %v, want InvalidArgument", err)
+	}
+}
+
+func TestCreateBookIsbnForms(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestBookService()
+
+	bk, err := s.CreateBook(ctx, &library.CreateBookRequest{Book: &library.Book{
+		Isbn:  "0-306-40615-2",
+		Title: "Parsing Techniques",
+	}})
+	if err != nil {
+		t.Fatalf("CreateBook returned error: %v", err)
+	}
+	if bk.GetIsbn() != "9780306406157" || bk.GetIsbn10() != "0306406152" || bk.GetLegacyIsbn() != 306406152 {
+		t.Errorf("CreateBook stored ISBNs %q, %q and %d, want 9780306406157, 0306406152 and 306406152",
+			bk.GetIsbn(), bk.GetIsbn10(), bk.GetLegacyIsbn())
+	}
+
+	// ISBNs that can only be written as ISBN-13s have no ISBN-10
+	bk, err = s.CreateBook(ctx, &library.CreateBookRequest{Book: &library.Book{
+		LegacyIsbn: 9791234567896,
+		Title:      "A Book With a 979 Prefix",
+	}})
+	if err != nil {
+		t.Fatalf("CreateBook returned error: %v", err)
+	}
+	if bk.GetIsbn() != "9791234567896" || bk.GetIsbn10() != "" {
+		t.Errorf("CreateBook stored ISBNs %q and %q, want 9791234567896 and none", bk.GetIsbn(), bk.GetIsbn10())
+	}
+
+	_, err = s.CreateBook(ctx, &library.CreateBookRequest{Book: &library.Book{
+		Isbn:       "9780804429573",
+		LegacyIsbn: 306406152,
+		Title:      "Mismatched ISBNs",
+	}})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("CreateBook with mismatched ISBNs returned error %v, want InvalidArgument", err)
+	}
+	_, err = s.CreateBook(ctx, &library.CreateBookRequest{Book: &library.Book{
+		Isbn:  "0-14-000972-8",
+		Title: "Nineteen Eighty-Four",
+	}})
+	if status.Code(err) != codes.AlreadyExists {
+		t.Errorf("CreateBook with the ISBN-10 of a stored book returned error %v, want AlreadyExists", err)
+	}
+}
+
+func TestUpdateBookEtag(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestBookService()
+	read, err := s.GetBook(ctx, &library.GetBookRequest{Isbn: "9780140009729"})
+	if err != nil {
+		t.Fatalf("GetBook returned error: %v", err)
+	}
+	if read.GetEtag() == "" {
+		t.Fatal("GetBook returned a book without an etag")
+	}
+
+	updated, err := s.UpdateBook(ctx, &library.UpdateBookRequest{
+		Book:       &library.Book{Isbn: read.GetIsbn(), Title: "1984", Etag: read.GetEtag()},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"title"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateBook returned error: %v", err)
+	}
+	if updated.GetEtag() == read.GetEtag() {
+		t.Errorf("UpdateBook kept the etag %q", read.GetEtag())
+	}
+
+	// Writes with the etag that was read first are rejected
+	_, err = s.UpdateBook(ctx, &library.UpdateBookRequest{
+		Book:       &library.Book{Isbn: read.GetIsbn(), Title: "Nineteen Eighty-Four", Etag: read.GetEtag()},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"title"}},
+	})
+	if status.Code(err) != codes.Aborted {
+		t.Errorf("UpdateBook with an old etag returned error %v, want Aborted", err)
+	}
+	_, err = s.DeleteBook(ctx, &library.DeleteBookRequest{Isbn: read.GetIsbn(), Etag: read.GetEtag()})
+	if status.Code(err) != codes.Aborted {
+		t.Errorf("DeleteBook with an old etag returned error %v, want Aborted", err)
+	}
+	got, err := s.GetBook(ctx, &library.GetBookRequest{Isbn: read.GetIsbn()})
+	if err != nil {
+		t.Fatalf("GetBook returned error: %v", err)
+	}
+	if got.GetTitle() != "1984" || got.GetEtag() != updated.GetEtag() {
+		t.Errorf("GetBook returned title %q and etag %q, want 1984 and %q", got.GetTitle(), got.GetEtag(), updated.GetEtag())
+	}
+
+	// Writes without an etag, or with the current one, succeed
+	_, err = s.UpdateBook(ctx, &library.UpdateBookRequest{
+		Book:       &library.Book{Isbn: read.GetIsbn(), Title: "Nineteen Eighty-Four"},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"title"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateBook without an etag returned error: %v", err)
+	}
+	current, err := s.GetBook(ctx, &library.GetBookRequest{Isbn: read.GetIsbn()})
+	if err != nil {
+		t.Fatalf("GetBook returned error: %v", err)
+	}
+	_, err = s.DeleteBook(ctx, &library.DeleteBookRequest{Isbn: read.GetIsbn(), Etag: current.GetEtag()})
+	if err != nil {
+		t.Errorf("DeleteBook with the current etag returned error: %v", err)
+	}
+}
+
+func TestUpdateBookUser(t *testing.T) {
+	members := &MemoryMemberStore{}
+	s, _ := newTestBookService(WithMemberStore(members))
+	addMember(t, members, "alice", 5)
+	update := func(user, title string) error {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("user", user))
+		_, err := s.UpdateBook(ctx, &library.UpdateBookRequest{
+			Book:       &library.Book{Isbn: "9780140009729", Title: title},
+			UpdateMask: &field_mask.FieldMask{Paths: []string{"title"}},
+		})
+		return err
+	}
+
+	if err := update("mallory", "1984"); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("UpdateBook by a user who is not a member returned error %v, want Unauthenticated", err)
+	}
+	if err := update("alice", "1984"); err != nil {
+		t.Fatalf("UpdateBook returned error: %v", err)
+	}
+	resp, err := s.ListBookRevisions(context.Background(), &library.ListBookRevisionsRequest{Isbn: "9780140009729"})
+	if err != nil {
+		t.Fatalf("ListBookRevisions returned error: %v", err)
+	}
+	if revs := resp.GetRevisions(); len(revs) == 0 || revs[0].GetBook().GetTitle() != "1984" || revs[0].GetUser() != "alice" {
+		t.Errorf("ListBookRevisions returned %v, want the retitling by alice first", revs)
+	}
+}
+
+func TestListBooksOrderByAuthor(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryBookStore()
+	s := NewBookService(store)
+	var ids []string
+	for _, a := range []*library.Author{
+		{Name: "George Orwell"},
+		{Name: "Aldous Huxley"},
+		{Name: "Ann Zed", SortName: "Zed, Ann"},
+	} {
+		created, err := s.CreateAuthor(ctx, &library.CreateAuthorRequest{Author: a})
+		if err != nil {
+			t.Fatalf("CreateAuthor returned error: %v", err)
+		}
+		ids = append(ids, created.GetId())
+	}
+	for i, bk := range []*library.Book{
+		{Title: "Animal Farm", AuthorIds: []string{ids[0]}},
+		{Title: "Brave New World", AuthorIds: []string{ids[1]}},
+		{Title: "Collected", AuthorIds: []string{ids[2], ids[1]}},
+	} {
+		bk.Isbn = testIsbn(i)
+		_, err := s.CreateBook(ctx, &library.CreateBookRequest{Book: bk})
+		if err != nil {
+			t.Fatalf("CreateBook returned error: %v", err)
+		}
+	}
+	// Books without linked authors are ordered by their author field
+	err := store.AddBook(ctx, &library.Book{Isbn: testIsbn(3), Title: "Frankenstein", Author: "Mary Shelley"})
+	if err != nil {
+		t.Fatalf("AddBook returned error: %v", err)
+	}
+
+	want := []string{"Brave New World", "Frankenstein", "Animal Farm", "Collected"}
+	var got []string
+	req := &library.ListBooksRequest{OrderBy: "author", PageSize: 3}
+	for {
+		resp, err := s.ListBooks(ctx, req)
+		if err != nil {
+			t.Fatalf("ListBooks returned error: %v", err)
+		}
+		got = append(got, bookTitles(resp.GetBooks())...)
+		if resp.GetNextPageToken() == "" {
+			break
+		}
+		req.PageToken = resp.GetNextPageToken()
+	}
+	if !equalStrings(got, want) {
+		t.Errorf("ListBooks ordered by author returned %q, want %q", got, want)
+	}
+
+	stream := &bookStream{testServerStream: testServerStream{ctx: ctx}}
+	err = s.QueryBooks(&library.QueryBooksRequest{OrderBy: "author desc"}, stream)
+	if err != nil {
+		t.Fatalf("QueryBooks returned error: %v", err)
+	}
+	want = []string{"Collected", "Animal Farm", "Frankenstein", "Brave New World"}
+	if got := bookTitles(stream.books); !equalStrings(got, want) {
+		t.Errorf("QueryBooks ordered by author desc returned %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,464 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// BookStore is the storage backend used by the BookService.
+// Books are identified by their canonical ISBN-13.
+// Implementations must be safe for concurrent use.
+// Errors returned should be gRPC status errors, as they
+// are passed on to the client unchanged.
+type BookStore interface {
+	// GetBook returns the Book with the ISBN provided.
+	// If no such Book exists, it returns a NotFound error.
+	GetBook(ctx context.Context, isbn string) (*library.Book, error)
+	// QueryBooks returns all Books for which match returns true,
+	// in the order they were first added to the store.
+	QueryBooks(ctx context.Context, match func(*library.Book) bool) ([]*library.Book, error)
+	// AddBook stores the Book provided and sets its Etag to that
+	// of the stored version. If a Book with the same ISBN
+	// already exists, it returns an AlreadyExists error.
+	AddBook(ctx context.Context, book *library.Book) error
+	// UpdateBook calls update with the Book with the ISBN provided
+	// and stores the result with a new Etag, atomically with respect
+	// to other writes. Changes to only the Copies, AvailableCopies,
+	// AverageRating and ReviewCount are stored with the same Etag, and
+	// are not recorded as a change. If update returns an error, the Book
+	// is left unchanged and the error is returned. If no such Book exists,
+	// it returns a NotFound error.
+	UpdateBook(ctx context.Context, isbn string, update func(*library.Book) error) (*library.Book, error)
+	// PutBook stores the Book provided, replacing any existing Book
+	// with the same ISBN, and sets its Etag to that of the stored version.
+	PutBook(ctx context.Context, book *library.Book) error
+	// DeleteBook calls check, if it is not nil, with the Book with the
+	// ISBN provided, and unless check returns an error, soft deletes the
+	// Book and returns it with its DeleteTime set, atomically with respect
+	// to other writes. Deleted Books are not returned by GetBook and
+	// QueryBooks, and can't be added again until they are restored.
+	// If no such Book exists, it returns a NotFound error.
+	DeleteBook(ctx context.Context, isbn string, check func(*library.Book) error) (*library.Book, error)
+	// RestoreBook restores the deleted Book with the ISBN provided
+	// and returns it. If no such deleted Book exists,
+	// it returns a NotFound error.
+	RestoreBook(ctx context.Context, isbn string) (*library.Book, error)
+	// BookHistory returns every change made to the Book with the ISBN
+	// provided, oldest first. The history of a Book is never compacted.
+	// If the store has never held such a Book, it returns a NotFound error.
+	BookHistory(ctx context.Context, isbn string) ([]BookChange, error)
+	// BooksAsOf returns the Books in the store at time t for which
+	// match returns true, in the order they were first added to the store.
+	BooksAsOf(ctx context.Context, t time.Time, match func(*library.Book) bool) ([]*library.Book, error)
+	// ScanBooks returns up to limit of the Books for which match returns
+	// true, in the order they were first added to the store, starting at
+	// the cursor provided, and the cursor to continue from. Scans start
+	// at cursor 0, and are complete when the cursor returned is 0. If t is
+	// not the zero time, the Books in the store at time t are scanned,
+	// as by BooksAsOf. Unlike QueryBooks and BooksAsOf, it can read any
+	// number of Books one batch at a time.
+	ScanBooks(ctx context.Context, t time.Time, cursor, limit int, match func(*library.Book) bool) ([]*library.Book, int, error)
+	// Revision returns the current revision of the store,
+	// which is the Revision of the last change made.
+	Revision(ctx context.Context) (int64, error)
+	// Changes returns the changes made after the revision provided,
+	// oldest first, and a channel that is closed when the next change
+	// is made. If the changes are no longer retained, it returns a
+	// ResourceExhausted error.
+	Changes(ctx context.Context, after int64) ([]BookChange, <-chan struct{}, error)
+}
+
+// BookChange is a change made to the Books in a BookStore.
+type BookChange struct {
+	// Revision identifies the change. Revisions
+	// increase by one with each change to the store.
+	Revision int64
+	// Old is the Book before the change. It is nil if the Book was added.
+	Old *library.Book
+	// New is the Book after the change. It is nil if the Book was deleted.
+	New *library.Book
+	// Time is when the change was made.
+	Time time.Time
+	// Actor is the user who made the change, as
+	// recorded in the context with WithActor.
+	Actor string
+}
+
+// memoryStoreHistory is the number of changes retained
+// by a MemoryBookStore for Changes. The history of
+// each Book returned by BookHistory is kept forever.
+const memoryStoreHistory = 1000
+
+// MemoryBookStore is an in-memory BookStore.
+// The zero value is an empty store ready to use.
+type MemoryBookStore struct {
+	mu      sync.RWMutex
+	books   []*library.Book
+	index   map[string]int
+	deleted map[string]*library.Book
+
+	revision int64
+	changes  []BookChange
+	// changed is closed and replaced on every change.
+	changed chan struct{}
+
+	// history holds the changes to each Book, and historyOrder
+	// the ISBNs of the Books in the order they were first added.
+	history      map[string][]BookChange
+	historyOrder []string
+
+	loans     []*library.Loan
+	loanIndex map[string]int
+	holds     []*library.Hold
+	holdIndex map[string]int
+	copies    []*library.Copy
+	copyIndex map[string]int
+	// lendingChanged is closed and replaced on every
+	// change made with UpdateLending.
+	lendingChanged chan struct{}
+
+	reviews     []*library.Review
+	reviewIndex map[string]int
+
+	taggings []Tagging
+}
+
+// NewMemoryBookStore returns a MemoryBookStore
+// seeded with the books provided.
+func NewMemoryBookStore(books ...*library.Book) *MemoryBookStore {
+	s := &MemoryBookStore{}
+	for _, bk := range books {
+		// Can't fail for the in-memory store
+		_ = s.PutBook(context.Background(), bk)
+	}
+	return s
+}
+
+// GetBook implements BookStore.
+func (s *MemoryBookStore) GetBook(ctx context.Context, isbn string) (*library.Book, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	i, ok := s.index[isbn]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "Book could not be found")
+	}
+	return cloneBook(s.books[i]), nil
+}
+
+// QueryBooks implements BookStore.
+func (s *MemoryBookStore) QueryBooks(ctx context.Context, match func(*library.Book) bool) ([]*library.Book, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var bks []*library.Book
+	for _, bk := range s.books {
+		if match(bk) {
+			bks = append(bks, cloneBook(bk))
+		}
+	}
+	return bks, nil
+}
+
+// AddBook implements BookStore.
+func (s *MemoryBookStore) AddBook(ctx context.Context, book *library.Book) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.index[book.GetIsbn()]; ok {
+		return status.Errorf(codes.AlreadyExists, "A book with ISBN %s already exists", book.GetIsbn())
+	}
+	if _, ok := s.deleted[book.GetIsbn()]; ok {
+		return status.Errorf(codes.AlreadyExists, "A deleted book with ISBN %s exists, restore it instead", book.GetIsbn())
+	}
+	s.put(ctx, book)
+	return nil
+}
+
+// UpdateBook implements BookStore.
+func (s *MemoryBookStore) UpdateBook(ctx context.Context, isbn string, update func(*library.Book) error) (*library.Book, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	i, ok := s.index[isbn]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "Book could not be found")
+	}
+	bk := cloneBook(s.books[i])
+	err := update(bk)
+	if err != nil {
+		return nil, err
+	}
+	if bk.GetIsbn() != isbn {
+		return nil, status.Error(codes.InvalidArgument, "The ISBN of a book can't be changed")
+	}
+	s.update(ctx, i, bk)
+	return bk, nil
+}
+
+// PutBook implements BookStore.
+func (s *MemoryBookStore) PutBook(ctx context.Context, book *library.Book) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.put(ctx, book)
+	return nil
+}
+
+// put stores a copy of book and sets its Etag,
+// replacing any deleted Book with the same ISBN.
+// The caller must hold s.mu.
+func (s *MemoryBookStore) put(ctx context.Context, book *library.Book) {
+	if s.index == nil {
+		s.index = map[string]int{}
+	}
+	if i, ok := s.index[book.GetIsbn()]; ok {
+		s.record(ctx, s.books[i], book)
+		s.books[i] = cloneBook(book)
+		return
+	}
+	delete(s.deleted, book.GetIsbn())
+	s.record(ctx, nil, book)
+	s.index[book.GetIsbn()] = len(s.books)
+	s.books = append(s.books, cloneBook(book))
+}
+
+// update replaces the Book at index i with a copy of bk. Changes
+// to the unrecorded fields alone are stored without a new revision,
+// and bk keeps the Etag of the stored Book. The caller must hold s.mu.
+func (s *MemoryBookStore) update(ctx context.Context, i int, bk *library.Book) {
+	if proto.Equal(recordedFields(s.books[i]), recordedFields(bk)) {
+		bk.Etag = s.books[i].GetEtag()
+	} else {
+		s.record(ctx, s.books[i], bk)
+	}
+	s.books[i] = cloneBook(bk)
+}
+
+// recordedFields returns a copy of bk without its Etag and the
+// fields maintained by the lending and reviews of the Book, which
+// change too often to be part of its history. Changing only those
+// fields does not create a new revision or change the Etag.
+func recordedFields(bk *library.Book) *library.Book {
+	bk = cloneBook(bk)
+	bk.Etag = ""
+	bk.Copies, bk.AvailableCopies = 0, 0
+	bk.AverageRating, bk.ReviewCount = 0, 0
+	return bk
+}
+
+// DeleteBook implements BookStore.
+func (s *MemoryBookStore) DeleteBook(ctx context.Context, isbn string, check func(*library.Book) error) (*library.Book, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	i, ok := s.index[isbn]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "Book could not be found")
+	}
+	bk := s.books[i]
+	if check != nil {
+		err := check(cloneBook(bk))
+		if err != nil {
+			return nil, err
+		}
+	}
+	c := s.record(ctx, bk, nil)
+	s.books = append(s.books[:i], s.books[i+1:]...)
+	delete(s.index, isbn)
+	for j := i; j < len(s.books); j++ {
+		s.index[s.books[j].GetIsbn()] = j
+	}
+
+	if s.deleted == nil {
+		s.deleted = map[string]*library.Book{}
+	}
+	var err error
+	bk.DeleteTime, err = ptypes.TimestampProto(c.Time)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record delete time: %v", err)
+	}
+	s.deleted[isbn] = bk
+	return cloneBook(bk), nil
+}
+
+// RestoreBook implements BookStore. Restored Books are
+// ordered after all other Books in QueryBooks.
+func (s *MemoryBookStore) RestoreBook(ctx context.Context, isbn string) (*library.Book, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	bk, ok := s.deleted[isbn]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "Deleted book could not be found")
+	}
+	bk.DeleteTime = nil
+	s.put(ctx, bk)
+	return cloneBook(bk), nil
+}
+
+// BookHistory implements BookStore.
+func (s *MemoryBookStore) BookHistory(ctx context.Context, isbn string) ([]BookChange, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	history, ok := s.history[isbn]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "Book could not be found")
+	}
+	changes := make([]BookChange, len(history))
+	for i, c := range history {
+		changes[i] = cloneChange(c)
+	}
+	return changes, nil
+}
+
+// BooksAsOf implements BookStore.
+func (s *MemoryBookStore) BooksAsOf(ctx context.Context, t time.Time, match func(*library.Book) bool) ([]*library.Book, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var bks []*library.Book
+	for _, isbn := range s.historyOrder {
+		if bk := s.bookAsOf(isbn, t); bk != nil && match(bk) {
+			bks = append(bks, cloneBook(bk))
+		}
+	}
+	return bks, nil
+}
+
+// ScanBooks implements BookStore.
+func (s *MemoryBookStore) ScanBooks(ctx context.Context, t time.Time, cursor, limit int, match func(*library.Book) bool) ([]*library.Book, int, error) {
+	if cursor < 0 || limit <= 0 {
+		return nil, 0, status.Error(codes.InvalidArgument, "invalid cursor or limit")
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var bks []*library.Book
+	// The cursor is an index into historyOrder,
+	// which Books are only ever appended to.
+	for i := cursor; i < len(s.historyOrder); i++ {
+		if len(bks) == limit {
+			return bks, i, nil
+		}
+		isbn := s.historyOrder[i]
+		var bk *library.Book
+		if t.IsZero() {
+			if j, ok := s.index[isbn]; ok {
+				bk = s.books[j]
+			}
+		} else {
+			bk = s.bookAsOf(isbn, t)
+		}
+		if bk != nil && match(bk) {
+			bks = append(bks, cloneBook(bk))
+		}
+	}
+	return bks, 0, nil
+}
+
+// bookAsOf returns the Book with the ISBN provided as
+// it was at time t, or nil if it was not in the store
+// at that time. The caller must hold s.mu.
+func (s *MemoryBookStore) bookAsOf(isbn string, t time.Time) *library.Book {
+	history := s.history[isbn]
+	// Find the first change after t
+	i := sort.Search(len(history), func(i int) bool {
+		return history[i].Time.After(t)
+	})
+	if i == 0 {
+		return nil
+	}
+	return history[i-1].New
+}
+
+// Revision implements BookStore.
+func (s *MemoryBookStore) Revision(ctx context.Context) (int64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.revision, nil
+}
+
+// Changes implements BookStore. Only the last
+// 1000 changes are retained.
+func (s *MemoryBookStore) Changes(ctx context.Context, after int64) ([]BookChange, <-chan struct{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.changed == nil {
+		s.changed = make(chan struct{})
+	}
+	if after > s.revision || s.revision-after > int64(len(s.changes)) {
+		return nil, nil, status.Errorf(codes.ResourceExhausted, "Changes after revision %d are no longer available", after)
+	}
+	retained := s.changes[len(s.changes)-int(s.revision-after):]
+	changes := make([]BookChange, len(retained))
+	for i, c := range retained {
+		changes[i] = cloneChange(c)
+	}
+	return changes, s.changed, nil
+}
+
+// record appends a change to the histories, sets the
+// Etag of next to the new revision and wakes up any
+// watchers. The caller must hold s.mu.
+func (s *MemoryBookStore) record(ctx context.Context, prev, next *library.Book) BookChange {
+	s.revision++
+	c := BookChange{
+		Revision: s.revision,
+		Time:     time.Now(),
+		Actor:    ActorFromContext(ctx),
+	}
+	if next != nil {
+		next.Etag = strconv.FormatInt(s.revision, 10)
+	}
+	if prev != nil {
+		c.Old = cloneBook(prev)
+	}
+	if next != nil {
+		c.New = cloneBook(next)
+	}
+	if len(s.changes) == memoryStoreHistory {
+		copy(s.changes, s.changes[1:])
+		s.changes = s.changes[:len(s.changes)-1]
+	}
+	s.changes = append(s.changes, c)
+	if s.changed != nil {
+		close(s.changed)
+		s.changed = nil
+	}
+
+	isbn := next.GetIsbn()
+	if next == nil {
+		isbn = prev.GetIsbn()
+	}
+	if s.history == nil {
+		s.history = map[string][]BookChange{}
+	}
+	if _, ok := s.history[isbn]; !ok {
+		s.historyOrder = append(s.historyOrder, isbn)
+	}
+	s.history[isbn] = append(s.history[isbn], c)
+
+	return c
+}
+
+// cloneChange returns a deep copy of c.
+func cloneChange(c BookChange) BookChange {
+	if c.Old != nil {
+		c.Old = cloneBook(c.Old)
+	}
+	if c.New != nil {
+		c.New = cloneBook(c.New)
+	}
+	return c
+}
+
+// cloneBook returns a deep copy of bk, so that callers
+// can't modify the contents of the store.
+func cloneBook(bk *library.Book) *library.Book {
+	return proto.Clone(bk).(*library.Book)
+}
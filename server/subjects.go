@@ -0,0 +1,288 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"sort"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// maxSubjectCodeLength is the maximum length of the code of a Subject.
+const maxSubjectCodeLength = 16
+
+func (s *BookService) CreateSubject(ctx context.Context, req *library.CreateSubjectRequest) (*library.Subject, error) {
+	if req.GetSubject() == nil {
+		return nil, status.Error(codes.InvalidArgument, "A subject must be provided")
+	}
+	sub := &library.Subject{
+		Code:       req.GetSubject().GetCode(),
+		Name:       req.GetSubject().GetName(),
+		ParentCode: req.GetSubject().GetParentCode(),
+	}
+
+	// Serialize writes, so the taxonomy stays a tree
+	s.subjectsMu.Lock()
+	defer s.subjectsMu.Unlock()
+	err := s.validateSubject(ctx, sub)
+	if err != nil {
+		return nil, err
+	}
+	err = s.subjects.AddSubject(ctx, sub)
+	if err != nil {
+		return nil, err
+	}
+
+	return sub, nil
+}
+
+func (s *BookService) GetSubject(ctx context.Context, req *library.GetSubjectRequest) (*library.Subject, error) {
+	return s.subjects.GetSubject(ctx, req.GetCode())
+}
+
+func (s *BookService) UpdateSubject(ctx context.Context, req *library.UpdateSubjectRequest) (*library.Subject, error) {
+	mask := req.GetUpdateMask()
+	for _, path := range mask.GetPaths() {
+		switch path {
+		case "name", "parent_code":
+		case "code":
+			return nil, status.Error(codes.InvalidArgument, "The code of a subject can't be changed")
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "Unknown field %q in update mask", path)
+		}
+	}
+
+	src := req.GetSubject()
+	// Validation reads the store, so it is done before the update.
+	// Subjects are only written while holding s.subjectsMu, so the
+	// Subject can't change in between.
+	s.subjectsMu.Lock()
+	defer s.subjectsMu.Unlock()
+	sub, err := s.subjects.GetSubject(ctx, src.GetCode())
+	if err != nil {
+		return nil, err
+	}
+	if updatesField(mask, "name") {
+		sub.Name = src.GetName()
+	}
+	if updatesField(mask, "parent_code") {
+		sub.ParentCode = src.GetParentCode()
+	}
+	err = s.validateSubject(ctx, sub)
+	if err != nil {
+		return nil, err
+	}
+	return s.subjects.UpdateSubject(ctx, sub.GetCode(), func(stored *library.Subject) error {
+		*stored = *sub
+		return nil
+	})
+}
+
+func (s *BookService) DeleteSubject(ctx context.Context, req *library.DeleteSubjectRequest) (*library.Subject, error) {
+	s.subjectsMu.Lock()
+	defer s.subjectsMu.Unlock()
+	_, err := s.subjects.GetSubject(ctx, req.GetCode())
+	if err != nil {
+		return nil, err
+	}
+	children, err := s.subjects.QuerySubjects(ctx, func(sub *library.Subject) bool {
+		return sub.GetParentCode() == req.GetCode()
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(children) > 0 {
+		return nil, status.Error(codes.FailedPrecondition, "Other subjects are under the subject")
+	}
+	books, err := s.store.QueryBooks(ctx, func(bk *library.Book) bool {
+		return hasSubject(bk, req.GetCode())
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(books) > 0 {
+		return nil, status.Error(codes.FailedPrecondition, "Books still have the subject")
+	}
+
+	return s.subjects.DeleteSubject(ctx, req.GetCode())
+}
+
+func (s *BookService) BrowseSubjects(ctx context.Context, req *library.BrowseSubjectsRequest) (*library.BrowseSubjectsResponse, error) {
+	if req.GetParentCode() != "" {
+		_, err := s.subjects.GetSubject(ctx, req.GetParentCode())
+		if err != nil {
+			return nil, err
+		}
+	}
+	tree, err := s.loadSubjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &library.BrowseSubjectsResponse{}
+	counts := map[string]*library.SubjectCount{}
+	for _, sub := range tree {
+		if sub.GetParentCode() == req.GetParentCode() {
+			sc := &library.SubjectCount{Subject: sub}
+			counts[sub.GetCode()] = sc
+			resp.Subjects = append(resp.Subjects, sc)
+		}
+	}
+	for _, sub := range tree {
+		if sc, ok := counts[sub.GetParentCode()]; ok {
+			sc.SubjectCount++
+		}
+	}
+	sort.Slice(resp.Subjects, func(i, j int) bool {
+		return resp.Subjects[i].GetSubject().GetCode() < resp.Subjects[j].GetSubject().GetCode()
+	})
+
+	// Count each Book once per subject it is under,
+	// however many of its subjects are under it.
+	_, err = s.store.QueryBooks(ctx, func(bk *library.Book) bool {
+		counted := map[string]bool{}
+		for _, code := range bk.GetSubjectCodes() {
+			for _, c := range tree.lineage(code) {
+				if sc, ok := counts[c]; ok && !counted[c] {
+					sc.BookCount++
+					counted[c] = true
+				}
+			}
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// subjectTree is the subject taxonomy, by code.
+type subjectTree map[string]*library.Subject
+
+// loadSubjects returns the current subject taxonomy.
+func (s *BookService) loadSubjects(ctx context.Context) (subjectTree, error) {
+	subjects, err := s.subjects.QuerySubjects(ctx, func(*library.Subject) bool { return true })
+	if err != nil {
+		return nil, err
+	}
+	tree := make(subjectTree, len(subjects))
+	for _, sub := range subjects {
+		tree[sub.GetCode()] = sub
+	}
+	return tree, nil
+}
+
+// lineage returns the code provided followed by the codes
+// of the subjects it is under, up to the top level subject.
+func (t subjectTree) lineage(code string) []string {
+	var lineage []string
+	// The taxonomy is a tree, but guard against cycles
+	// in case the store was modified directly.
+	for code != "" && len(lineage) <= len(t) {
+		lineage = append(lineage, code)
+		code = t[code].GetParentCode()
+	}
+	return lineage
+}
+
+// subjectMatcher returns a predicate matching the Books with any of
+// the subjects provided, or any subject under them. It returns an
+// InvalidArgument error if any of the subjects don't exist.
+func (s *BookService) subjectMatcher(ctx context.Context, subjectCodes []string) (func(*library.Book) bool, error) {
+	tree, err := s.loadSubjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	want := map[string]bool{}
+	for _, code := range subjectCodes {
+		if _, ok := tree[code]; !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "Unknown subject %q", code)
+		}
+		want[code] = true
+	}
+	return func(bk *library.Book) bool {
+		for _, code := range bk.GetSubjectCodes() {
+			for _, c := range tree.lineage(code) {
+				if want[c] {
+					return true
+				}
+			}
+		}
+		return false
+	}, nil
+}
+
+// checkSubjects returns an InvalidArgument error if no Subject
+// exists with any of the codes provided, or a code is repeated.
+// The caller must hold s.subjectsMu until the Book with the
+// subjects is stored, so that they can't be deleted in between.
+func (s *BookService) checkSubjects(ctx context.Context, subjectCodes []string) error {
+	seen := map[string]bool{}
+	for _, code := range subjectCodes {
+		if seen[code] {
+			return status.Errorf(codes.InvalidArgument, "The subject %q is repeated", code)
+		}
+		seen[code] = true
+		_, err := s.subjects.GetSubject(ctx, code)
+		if status.Code(err) == codes.NotFound {
+			return status.Errorf(codes.InvalidArgument, "Unknown subject %q", code)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateSubject returns an InvalidArgument error if sub is
+// invalid, or its parent doesn't exist or is under sub. The
+// caller must hold s.subjectsMu, so that the taxonomy stays a tree.
+func (s *BookService) validateSubject(ctx context.Context, sub *library.Subject) error {
+	switch {
+	case sub.GetCode() == "":
+		return status.Error(codes.InvalidArgument, "The code must not be empty")
+	case len(sub.GetCode()) > maxSubjectCodeLength:
+		return status.Errorf(codes.InvalidArgument, "The code must be at most %d characters", maxSubjectCodeLength)
+	case sub.GetName() == "":
+		return status.Error(codes.InvalidArgument, "The name must not be empty")
+	}
+	for _, r := range sub.GetCode() {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return status.Error(codes.InvalidArgument, "The code may only contain uppercase letters and digits")
+		}
+	}
+	if sub.GetParentCode() == "" {
+		return nil
+	}
+
+	tree, err := s.loadSubjects(ctx)
+	if err != nil {
+		return err
+	}
+	if _, ok := tree[sub.GetParentCode()]; !ok {
+		return status.Errorf(codes.InvalidArgument, "Unknown subject %q", sub.GetParentCode())
+	}
+	for _, code := range tree.lineage(sub.GetParentCode()) {
+		if code == sub.GetCode() {
+			return status.Error(codes.InvalidArgument, "A subject can't be under itself")
+		}
+	}
+	return nil
+}
+
+// hasSubject reports whether bk has the subject with the code provided.
+func hasSubject(bk *library.Book, code string) bool {
+	for _, c := range bk.GetSubjectCodes() {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
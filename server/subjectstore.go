@@ -0,0 +1,133 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// SubjectStore is the storage backend for the subject taxonomy.
+// Subjects are identified by their code.
+// Implementations must be safe for concurrent use.
+// Errors returned should be gRPC status errors, as they
+// are passed on to the client unchanged.
+type SubjectStore interface {
+	// GetSubject returns the Subject with the code provided.
+	// If no such Subject exists, it returns a NotFound error.
+	GetSubject(ctx context.Context, code string) (*library.Subject, error)
+	// QuerySubjects returns all Subjects for which match
+	// returns true, in the order they were added to the store.
+	QuerySubjects(ctx context.Context, match func(*library.Subject) bool) ([]*library.Subject, error)
+	// AddSubject stores the Subject provided. If a Subject with the
+	// same code already exists, it returns an AlreadyExists error.
+	AddSubject(ctx context.Context, subject *library.Subject) error
+	// UpdateSubject calls update with the Subject with the code provided
+	// and stores the result, atomically with respect to other writes.
+	// If update returns an error, the Subject is left unchanged and the
+	// error is returned. If no such Subject exists, it returns a NotFound error.
+	UpdateSubject(ctx context.Context, code string, update func(*library.Subject) error) (*library.Subject, error)
+	// DeleteSubject removes the Subject with the code provided and
+	// returns it. If no such Subject exists, it returns a NotFound error.
+	DeleteSubject(ctx context.Context, code string) (*library.Subject, error)
+}
+
+// MemorySubjectStore is an in-memory SubjectStore.
+// The zero value is an empty store ready to use.
+type MemorySubjectStore struct {
+	mu       sync.RWMutex
+	subjects []*library.Subject
+}
+
+// GetSubject implements SubjectStore.
+func (s *MemorySubjectStore) GetSubject(ctx context.Context, code string) (*library.Subject, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	i := s.find(code)
+	if i < 0 {
+		return nil, status.Error(codes.NotFound, "Subject could not be found")
+	}
+	return cloneSubject(s.subjects[i]), nil
+}
+
+// QuerySubjects implements SubjectStore.
+func (s *MemorySubjectStore) QuerySubjects(ctx context.Context, match func(*library.Subject) bool) ([]*library.Subject, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var subjects []*library.Subject
+	for _, sub := range s.subjects {
+		if match(sub) {
+			subjects = append(subjects, cloneSubject(sub))
+		}
+	}
+	return subjects, nil
+}
+
+// AddSubject implements SubjectStore.
+func (s *MemorySubjectStore) AddSubject(ctx context.Context, subject *library.Subject) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.find(subject.GetCode()) >= 0 {
+		return status.Errorf(codes.AlreadyExists, "A subject with code %s already exists", subject.GetCode())
+	}
+	s.subjects = append(s.subjects, cloneSubject(subject))
+	return nil
+}
+
+// UpdateSubject implements SubjectStore.
+func (s *MemorySubjectStore) UpdateSubject(ctx context.Context, code string, update func(*library.Subject) error) (*library.Subject, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	i := s.find(code)
+	if i < 0 {
+		return nil, status.Error(codes.NotFound, "Subject could not be found")
+	}
+	sub := cloneSubject(s.subjects[i])
+	err := update(sub)
+	if err != nil {
+		return nil, err
+	}
+	if sub.GetCode() != code {
+		return nil, status.Error(codes.InvalidArgument, "The code of a subject can't be changed")
+	}
+	s.subjects[i] = cloneSubject(sub)
+	return sub, nil
+}
+
+// DeleteSubject implements SubjectStore.
+func (s *MemorySubjectStore) DeleteSubject(ctx context.Context, code string) (*library.Subject, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	i := s.find(code)
+	if i < 0 {
+		return nil, status.Error(codes.NotFound, "Subject could not be found")
+	}
+	sub := s.subjects[i]
+	s.subjects = append(s.subjects[:i], s.subjects[i+1:]...)
+	return sub, nil
+}
+
+// find returns the index of the Subject with the code
+// provided, or -1 if there is no such Subject.
+// The caller must hold s.mu.
+func (s *MemorySubjectStore) find(code string) int {
+	for i, sub := range s.subjects {
+		if sub.GetCode() == code {
+			return i
+		}
+	}
+	return -1
+}
+
+// cloneSubject returns a deep copy of sub, so that
+// callers can't modify the contents of the store.
+func cloneSubject(sub *library.Subject) *library.Subject {
+	return proto.Clone(sub).(*library.Subject)
+}
@@ -0,0 +1,208 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// maxTagLength is the maximum length of a tag, in characters.
+const maxTagLength = 32
+
+func (s *BookService) TagBook(ctx context.Context, req *library.TagBookRequest) (*library.Book, error) {
+	ctx, err := requestActor(ctx, s.members)
+	if err != nil {
+		return nil, err
+	}
+	if s.tags == nil {
+		return nil, status.Error(codes.Unimplemented, "Tags are not stored by this server")
+	}
+	id, err := requestIsbn(req.GetIsbn(), 0)
+	if err != nil {
+		return nil, err
+	}
+	tag, err := normalizeTag(req.GetTag())
+	if err != nil {
+		return nil, err
+	}
+
+	tagging := Tagging{Isbn: id, Member: req.GetMember(), Tag: tag}
+	var bt *BookTags
+	err = useActiveMember(ctx, s.members, req.GetMember(), func(*library.Member) error {
+		var err error
+		bt, err = s.tags.UpdateTags(ctx, id, func(bt *BookTags) error {
+			for _, t := range bt.Taggings {
+				if t == tagging {
+					return status.Error(codes.AlreadyExists, "The member has already applied the tag to the book")
+				}
+			}
+			bt.Taggings = append(bt.Taggings, tagging)
+			setTags(bt)
+			return nil
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.reindex(ctx, id)
+	s.localize(ctx, bt.Book)
+
+	return bt.Book, nil
+}
+
+func (s *BookService) UntagBook(ctx context.Context, req *library.UntagBookRequest) (*library.Book, error) {
+	ctx, err := requestActor(ctx, s.members)
+	if err != nil {
+		return nil, err
+	}
+	if s.tags == nil {
+		return nil, status.Error(codes.Unimplemented, "Tags are not stored by this server")
+	}
+	id, err := requestIsbn(req.GetIsbn(), 0)
+	if err != nil {
+		return nil, err
+	}
+	tag, err := normalizeTag(req.GetTag())
+	if err != nil {
+		return nil, err
+	}
+	_, err = activeMember(ctx, s.members, req.GetMember())
+	if err != nil {
+		return nil, err
+	}
+
+	tagging := Tagging{Isbn: id, Member: req.GetMember(), Tag: tag}
+	bt, err := s.tags.UpdateTags(ctx, id, func(bt *BookTags) error {
+		for i, t := range bt.Taggings {
+			if t == tagging {
+				bt.Taggings = append(bt.Taggings[:i], bt.Taggings[i+1:]...)
+				setTags(bt)
+				return nil
+			}
+		}
+		return status.Error(codes.NotFound, "The member has not applied the tag to the book")
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.reindex(ctx, id)
+	s.localize(ctx, bt.Book)
+
+	return bt.Book, nil
+}
+
+func (s *BookService) ListTags(ctx context.Context, req *library.ListTagsRequest) (*library.ListTagsResponse, error) {
+	if s.tags == nil {
+		return nil, status.Error(codes.Unimplemented, "Tags are not stored by this server")
+	}
+	taggings, err := s.tags.QueryTaggings(ctx, func(t Tagging) bool {
+		return req.GetMember() == "" || t.Member == req.GetMember()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Count the Books tagged with each tag, not the Taggings.
+	// Taggings of deleted Books are kept in case they are
+	// restored, but not counted.
+	deleted := map[string]bool{}
+	for _, t := range taggings {
+		if _, ok := deleted[t.Isbn]; ok {
+			continue
+		}
+		_, err := s.store.GetBook(ctx, t.Isbn)
+		switch status.Code(err) {
+		case codes.OK:
+			deleted[t.Isbn] = false
+		case codes.NotFound:
+			deleted[t.Isbn] = true
+		default:
+			return nil, err
+		}
+	}
+	books := map[string]map[string]bool{}
+	for _, t := range taggings {
+		if deleted[t.Isbn] {
+			continue
+		}
+		if books[t.Tag] == nil {
+			books[t.Tag] = map[string]bool{}
+		}
+		books[t.Tag][t.Isbn] = true
+	}
+	resp := &library.ListTagsResponse{}
+	for tag, isbns := range books {
+		resp.Tags = append(resp.Tags, &library.TagCount{
+			Tag:   tag,
+			Count: int32(len(isbns)),
+		})
+	}
+	sortTagCounts(resp.Tags)
+
+	return resp, nil
+}
+
+// setTags counts the tags of bt on its Book.
+func setTags(bt *BookTags) {
+	counts := map[string]int32{}
+	for _, t := range bt.Taggings {
+		counts[t.Tag]++
+	}
+	bt.Book.Tags = nil
+	for tag, n := range counts {
+		bt.Book.Tags = append(bt.Book.Tags, &library.TagCount{
+			Tag:   tag,
+			Count: n,
+		})
+	}
+	sortTagCounts(bt.Book.Tags)
+}
+
+// sortTagCounts sorts tags by descending count, then by tag.
+func sortTagCounts(tags []*library.TagCount) {
+	sort.Slice(tags, func(i, j int) bool {
+		if tags[i].GetCount() != tags[j].GetCount() {
+			return tags[i].GetCount() > tags[j].GetCount()
+		}
+		return tags[i].GetTag() < tags[j].GetTag()
+	})
+}
+
+// normalizeTag returns tag in lowercase, with spaces collapsed, or
+// an InvalidArgument error if it can't be applied to a Book.
+func normalizeTag(tag string) (string, error) {
+	tag = strings.ToLower(strings.Join(strings.Fields(tag), " "))
+	switch {
+	case tag == "":
+		return "", status.Error(codes.InvalidArgument, "The tag must not be empty")
+	case utf8.RuneCountInString(tag) > maxTagLength:
+		return "", status.Errorf(codes.InvalidArgument, "The tag must be at most %d characters", maxTagLength)
+	}
+	for _, r := range tag {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != ' ' && r != '-' {
+			return "", status.Error(codes.InvalidArgument, "The tag may only contain letters, digits, spaces and dashes")
+		}
+	}
+	return tag, nil
+}
+
+// hasTag reports whether bk has been tagged with tag.
+func hasTag(bk *library.Book, tag string) bool {
+	for _, t := range bk.GetTags() {
+		if t.GetTag() == tag {
+			return true
+		}
+	}
+	return false
+}
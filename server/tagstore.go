@@ -0,0 +1,119 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// Tagging is a tag applied to a Book by a member of the library.
+type Tagging struct {
+	// Isbn is the ISBN-13 of the Book tagged.
+	Isbn string
+	// Member is the ID of the member who applied the tag.
+	Member string
+	// Tag is the tag applied.
+	Tag string
+}
+
+// BookTags is a Book and its Taggings, oldest first.
+type BookTags struct {
+	Book     *library.Book
+	Taggings []Tagging
+}
+
+// TagStore is the storage backend for the tags applied to Books with
+// TagBook. The tags of a Book are counted on the Book, so a TagStore
+// stores Taggings together with the Books of a BookStore, and updates
+// a Book and its Taggings in one transaction. Changes to Books made by
+// a TagStore are recorded like any other change to the BookStore.
+// Implementations must be safe for concurrent use.
+// Errors returned should be gRPC status errors, as they
+// are passed on to the client unchanged.
+type TagStore interface {
+	// QueryTaggings returns all Taggings for which match returns
+	// true, in the order they were added to the store.
+	QueryTaggings(ctx context.Context, match func(Tagging) bool) ([]Tagging, error)
+	// UpdateTags calls update with the Book with the ISBN provided and
+	// its Taggings, and stores the result, atomically with respect to
+	// other writes. Taggings may be added and removed, but their ISBNs
+	// can't be changed. If update returns an error, nothing is changed
+	// and the error is returned. If no such Book exists, it returns a
+	// NotFound error.
+	UpdateTags(ctx context.Context, isbn string, update func(*BookTags) error) (*BookTags, error)
+}
+
+// QueryTaggings implements TagStore.
+func (s *MemoryBookStore) QueryTaggings(ctx context.Context, match func(Tagging) bool) ([]Tagging, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var taggings []Tagging
+	for _, t := range s.taggings {
+		if match(t) {
+			taggings = append(taggings, t)
+		}
+	}
+	return taggings, nil
+}
+
+// UpdateTags implements TagStore.
+func (s *MemoryBookStore) UpdateTags(ctx context.Context, isbn string, update func(*BookTags) error) (*BookTags, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	i, ok := s.index[isbn]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "Book could not be found")
+	}
+
+	bt := &BookTags{Book: cloneBook(s.books[i])}
+	for _, t := range s.taggings {
+		if t.Isbn == isbn {
+			bt.Taggings = append(bt.Taggings, t)
+		}
+	}
+
+	err := update(bt)
+	if err != nil {
+		return nil, err
+	}
+	if bt.Book.GetIsbn() != isbn {
+		return nil, status.Error(codes.InvalidArgument, "The ISBN of a book can't be changed")
+	}
+	for _, t := range bt.Taggings {
+		if t.Isbn != isbn {
+			return nil, status.Error(codes.InvalidArgument, "The ISBN of a tagging can't be changed")
+		}
+	}
+
+	if !proto.Equal(bt.Book, s.books[i]) {
+		s.record(ctx, s.books[i], bt.Book)
+		s.books[i] = cloneBook(bt.Book)
+	}
+	// Replace the Taggings of the Book, keeping the order of the others
+	updated := map[Tagging]bool{}
+	for _, t := range bt.Taggings {
+		updated[t] = true
+	}
+	taggings := s.taggings[:0]
+	for _, t := range s.taggings {
+		if t.Isbn != isbn || updated[t] {
+			taggings = append(taggings, t)
+			delete(updated, t)
+		}
+	}
+	for _, t := range bt.Taggings {
+		if updated[t] {
+			taggings = append(taggings, t)
+			delete(updated, t)
+		}
+	}
+	s.taggings = taggings
+
+	return bt, nil
+}
@@ -0,0 +1,140 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// defaultBookmarkInterval is how often watches whose
+// revision has moved on are sent a BOOKMARK event.
+const defaultBookmarkInterval = time.Minute
+
+// resumeToken is the content of the resume tokens handed out by WatchBooks.
+type resumeToken struct {
+	// Filter is the filter of the watch that created the token.
+	// It may not change when resuming.
+	Filter string `json:"f,omitempty"`
+	// Revision is the revision of the last event sent.
+	Revision int64 `json:"r"`
+}
+
+func (resumeToken) kind() string { return "resume" }
+
+func (s *BookService) WatchBooks(req *library.WatchBooksRequest, stream library.BookService_WatchBooksServer) error {
+	ctx := stream.Context()
+	match, err := parseBookFilter(req.GetFilter())
+	if err != nil {
+		return err
+	}
+
+	var rev int64
+	if req.GetResumeToken() != "" {
+		var t resumeToken
+		if !decodeToken(s.tokenKey, req.GetResumeToken(), &t) {
+			return status.Error(codes.InvalidArgument, "Invalid resume token")
+		}
+		if t.Filter != req.GetFilter() {
+			return status.Error(codes.InvalidArgument, "The filter must not change when resuming a watch")
+		}
+		rev = t.Revision
+	} else {
+		rev, err = s.store.Revision(ctx)
+		if err != nil {
+			return err
+		}
+		err = s.sendBookEvent(stream, req.GetFilter(), &library.BookEvent{
+			Type:     library.BookEvent_BOOKMARK,
+			Revision: rev,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Changes not matching the filter are not sent, so bookmark
+	// the revision reached now and then. Resume tokens then
+	// don't fall behind the changes retained by the store.
+	sent := rev
+	bookmarks := time.NewTicker(s.bookmarkInterval)
+	defer bookmarks.Stop()
+	for {
+		changes, changed, err := s.store.Changes(ctx, rev)
+		if err != nil {
+			return err
+		}
+		for _, c := range changes {
+			rev = c.Revision
+			ev := bookEvent(c, match)
+			if ev == nil {
+				continue
+			}
+			s.localize(ctx, ev.Book)
+			err = s.sendBookEvent(stream, req.GetFilter(), ev)
+			if err != nil {
+				return err
+			}
+			sent = rev
+		}
+
+		select {
+		case <-changed:
+		case <-bookmarks.C:
+			if rev == sent {
+				continue
+			}
+			err = s.sendBookEvent(stream, req.GetFilter(), &library.BookEvent{
+				Type:     library.BookEvent_BOOKMARK,
+				Revision: rev,
+			})
+			if err != nil {
+				return err
+			}
+			sent = rev
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// sendBookEvent sets the resume token of ev and sends it.
+func (s *BookService) sendBookEvent(stream library.BookService_WatchBooksServer, filter string, ev *library.BookEvent) error {
+	var err error
+	ev.ResumeToken, err = encodeToken(s.tokenKey, resumeToken{
+		Filter:   filter,
+		Revision: ev.GetRevision(),
+	})
+	if err != nil {
+		return err
+	}
+	return stream.Send(ev)
+}
+
+// bookEvent returns the event describing c to a watch
+// of the Books matching match, or nil if the change
+// is not visible to the watch.
+func bookEvent(c BookChange, match func(*library.Book) bool) *library.BookEvent {
+	oldMatch := c.Old != nil && match(c.Old)
+	newMatch := c.New != nil && match(c.New)
+	ev := &library.BookEvent{Revision: c.Revision}
+	switch {
+	case oldMatch && newMatch:
+		ev.Type = library.BookEvent_UPDATED
+		ev.Book = c.New
+	case newMatch:
+		ev.Type = library.BookEvent_ADDED
+		ev.Book = c.New
+	case oldMatch:
+		ev.Type = library.BookEvent_DELETED
+		ev.Book = c.Old
+	default:
+		return nil
+	}
+	return ev
+}
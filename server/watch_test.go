@@ -0,0 +1,202 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// watchStream passes the events sent by WatchBooks to a channel.
+type watchStream struct {
+	testServerStream
+	events chan *library.BookEvent
+}
+
+func (s *watchStream) Send(ev *library.BookEvent) error {
+	select {
+	case s.events <- ev:
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
+
+// watch is a WatchBooks call running in the background.
+type watch struct {
+	stream *watchStream
+	cancel func()
+	done   chan error
+}
+
+func startWatch(s *BookService, req *library.WatchBooksRequest) *watch {
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &watch{
+		stream: &watchStream{
+			testServerStream: testServerStream{ctx: ctx},
+			events:           make(chan *library.BookEvent),
+		},
+		cancel: cancel,
+		done:   make(chan error, 1),
+	}
+	go func() {
+		w.done <- s.WatchBooks(req, w.stream)
+	}()
+	return w
+}
+
+// next returns the next event sent by the watch.
+func (w *watch) next(t *testing.T) *library.BookEvent {
+	t.Helper()
+	select {
+	case ev := <-w.stream.events:
+		return ev
+	case err := <-w.done:
+		t.Fatalf("WatchBooks returned early with error: %v", err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for an event")
+	}
+	return nil
+}
+
+// stop cancels the watch and returns the error WatchBooks returned.
+func (w *watch) stop() error {
+	w.cancel()
+	return <-w.done
+}
+
+func retitle(t *testing.T, s *BookService, isbn, title string) {
+	t.Helper()
+	_, err := s.UpdateBook(context.Background(), &library.UpdateBookRequest{
+		Book:       &library.Book{Isbn: isbn, Title: title},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"title"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateBook returned error: %v", err)
+	}
+}
+
+func TestWatchBooks(t *testing.T) {
+	ctx := context.Background()
+	s, store := newTestBookService()
+	filter := `author = "George Orwell"`
+
+	w := startWatch(s, &library.WatchBooksRequest{Filter: filter})
+	ev := w.next(t)
+	rev, _ := store.Revision(ctx)
+	if ev.GetType() != library.BookEvent_BOOKMARK || ev.GetRevision() != rev || ev.GetBook() != nil {
+		t.Fatalf("first event is %v, want a bookmark at revision %d", ev, rev)
+	}
+
+	// Changes to other books are not sent
+	retitle(t, s, "9780060929879", "Brave New World Revisited")
+	retitle(t, s, "9780140008388", "Animal Farm: A Fairy Story")
+	ev = w.next(t)
+	if ev.GetType() != library.BookEvent_UPDATED || ev.GetBook().GetTitle() != "Animal Farm: A Fairy Story" {
+		t.Fatalf("got event %v, want Animal Farm updated", ev)
+	}
+	if ev.GetRevision() != rev+2 {
+		t.Errorf("update has revision %d, want %d", ev.GetRevision(), rev+2)
+	}
+	resume := ev.GetResumeToken()
+
+	_, err := s.CreateBook(ctx, &library.CreateBookRequest{Book: &library.Book{
+		Isbn:   "9780141036144",
+		Title:  "Homage to Catalonia",
+		Author: "George Orwell",
+	}})
+	if err != nil {
+		t.Fatalf("CreateBook returned error: %v", err)
+	}
+	_, err = s.UpdateBook(ctx, &library.UpdateBookRequest{
+		Book:       &library.Book{Isbn: "9780140009729", Author: "Eric Blair"},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"author"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateBook returned error: %v", err)
+	}
+	want := []struct {
+		typ   library.BookEvent_Type
+		title string
+	}{
+		{library.BookEvent_ADDED, "Homage to Catalonia"},
+		{library.BookEvent_DELETED, "Nineteen Eighty-Four"},
+	}
+	for _, want := range want {
+		ev = w.next(t)
+		if ev.GetType() != want.typ || ev.GetBook().GetTitle() != want.title {
+			t.Errorf("got event %v, want %v of %q", ev, want.typ, want.title)
+		}
+	}
+	if err := w.stop(); err != nil {
+		t.Errorf("WatchBooks returned error: %v", err)
+	}
+
+	// Resuming sends the events after the token again, without a bookmark
+	w = startWatch(s, &library.WatchBooksRequest{Filter: filter, ResumeToken: resume})
+	for _, want := range want {
+		ev = w.next(t)
+		if ev.GetType() != want.typ || ev.GetBook().GetTitle() != want.title {
+			t.Errorf("resumed watch sent event %v, want %v of %q", ev, want.typ, want.title)
+		}
+	}
+	w.stop()
+
+	for _, req := range []*library.WatchBooksRequest{
+		{Filter: `author = "Aldous Huxley"`, ResumeToken: resume},
+		{Filter: filter, ResumeToken: resume + "x"},
+		{Filter: "author ="},
+	} {
+		err = s.WatchBooks(req, &watchStream{testServerStream: testServerStream{ctx: ctx}})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("WatchBooks(%v) returned error %v, want InvalidArgument", req, err)
+		}
+	}
+}
+
+func TestWatchBooksBookmarks(t *testing.T) {
+	ctx := context.Background()
+	s, store := newTestBookService(WithBookmarkInterval(10 * time.Millisecond))
+	w := startWatch(s, &library.WatchBooksRequest{Filter: `author = "George Orwell"`})
+	defer w.stop()
+	start := w.next(t).GetRevision()
+
+	// Changes to other books move the revision on with a bookmark
+	retitle(t, s, "9780060929879", "Brave New World Revisited")
+	ev := w.next(t)
+	rev, _ := store.Revision(ctx)
+	if ev.GetType() != library.BookEvent_BOOKMARK || ev.GetRevision() != rev || rev == start {
+		t.Fatalf("got event %v, want a bookmark at revision %d", ev, rev)
+	}
+
+	// Without changes, no further bookmarks are sent
+	select {
+	case ev := <-w.stream.events:
+		t.Errorf("got event %v without changes, want none", ev)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestWatchBooksExpiredResumeToken(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestBookService()
+	w := startWatch(s, &library.WatchBooksRequest{})
+	resume := w.next(t).GetResumeToken()
+	w.stop()
+
+	for i := 0; i <= memoryStoreHistory; i++ {
+		retitle(t, s, "9780060929879", time.Duration(i).String())
+	}
+	err := s.WatchBooks(&library.WatchBooksRequest{ResumeToken: resume}, &watchStream{testServerStream: testServerStream{ctx: ctx}})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("WatchBooks with an expired resume token returned error %v, want ResourceExhausted", err)
+	}
+}
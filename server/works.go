@@ -0,0 +1,229 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"sort"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+func (s *BookService) CreateWork(ctx context.Context, req *library.CreateWorkRequest) (*library.Work, error) {
+	if req.GetWork() == nil {
+		return nil, status.Error(codes.InvalidArgument, "A work must be provided")
+	}
+	work := &library.Work{
+		Title:        req.GetWork().GetTitle(),
+		AuthorIds:    req.GetWork().GetAuthorIds(),
+		SeriesId:     req.GetWork().GetSeriesId(),
+		SeriesNumber: req.GetWork().GetSeriesNumber(),
+	}
+	var err error
+	work.Id, err = newID("work")
+	if err != nil {
+		return nil, err
+	}
+
+	// Serialize writes, so no two works get the same series number
+	s.worksMu.Lock()
+	defer s.worksMu.Unlock()
+	err = s.validateWork(ctx, work)
+	if err != nil {
+		return nil, err
+	}
+	err = s.works.AddWork(ctx, work)
+	if err != nil {
+		return nil, err
+	}
+
+	return work, nil
+}
+
+func (s *BookService) GetWork(ctx context.Context, req *library.GetWorkRequest) (*library.Work, error) {
+	return s.works.GetWork(ctx, req.GetId())
+}
+
+func (s *BookService) UpdateWork(ctx context.Context, req *library.UpdateWorkRequest) (*library.Work, error) {
+	mask := req.GetUpdateMask()
+	for _, path := range mask.GetPaths() {
+		switch path {
+		case "title", "author_ids", "series_id", "series_number":
+		case "id":
+			return nil, status.Error(codes.InvalidArgument, "The id of a work can't be changed")
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "Unknown field %q in update mask", path)
+		}
+	}
+
+	src := req.GetWork()
+	// Validation reads the store, so it is done before the update.
+	// Works are only written while holding s.worksMu, so the Work
+	// can't change in between.
+	s.worksMu.Lock()
+	defer s.worksMu.Unlock()
+	w, err := s.works.GetWork(ctx, src.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if updatesField(mask, "title") {
+		w.Title = src.GetTitle()
+	}
+	if updatesField(mask, "author_ids") {
+		w.AuthorIds = src.GetAuthorIds()
+	}
+	if updatesField(mask, "series_id") {
+		w.SeriesId = src.GetSeriesId()
+	}
+	if updatesField(mask, "series_number") {
+		w.SeriesNumber = src.GetSeriesNumber()
+	}
+	err = s.validateWork(ctx, w)
+	if err != nil {
+		return nil, err
+	}
+	return s.works.UpdateWork(ctx, w.GetId(), func(stored *library.Work) error {
+		*stored = *w
+		return nil
+	})
+}
+
+func (s *BookService) ListEditions(ctx context.Context, req *library.ListEditionsRequest) (*library.ListEditionsResponse, error) {
+	_, err := s.works.GetWork(ctx, req.GetWorkId())
+	if err != nil {
+		return nil, err
+	}
+
+	books, err := s.store.QueryBooks(ctx, func(bk *library.Book) bool {
+		return bk.GetWorkId() == req.GetWorkId()
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(books, func(i, j int) bool {
+		if books[i].GetBookType() != books[j].GetBookType() {
+			return books[i].GetBookType() < books[j].GetBookType()
+		}
+		return keyOfPublication(books[i]).less(keyOfPublication(books[j]))
+	})
+
+	s.localize(ctx, books...)
+
+	return &library.ListEditionsResponse{Books: books}, nil
+}
+
+func (s *BookService) CreateSeries(ctx context.Context, req *library.CreateSeriesRequest) (*library.Series, error) {
+	if req.GetSeries().GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "The name must not be empty")
+	}
+	series := &library.Series{
+		Name: req.GetSeries().GetName(),
+	}
+	var err error
+	series.Id, err = newID("series")
+	if err != nil {
+		return nil, err
+	}
+	err = s.works.AddSeries(ctx, series)
+	if err != nil {
+		return nil, err
+	}
+
+	return series, nil
+}
+
+func (s *BookService) GetSeries(ctx context.Context, req *library.GetSeriesRequest) (*library.Series, error) {
+	return s.works.GetSeries(ctx, req.GetId())
+}
+
+func (s *BookService) ListSeriesWorks(ctx context.Context, req *library.ListSeriesWorksRequest) (*library.ListSeriesWorksResponse, error) {
+	_, err := s.works.GetSeries(ctx, req.GetSeriesId())
+	if err != nil {
+		return nil, err
+	}
+
+	works, err := s.works.QueryWorks(ctx, func(w *library.Work) bool {
+		return w.GetSeriesId() == req.GetSeriesId()
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(works, func(i, j int) bool {
+		return works[i].GetSeriesNumber() < works[j].GetSeriesNumber()
+	})
+
+	return &library.ListSeriesWorksResponse{Works: works}, nil
+}
+
+// validateWork returns an InvalidArgument error if w is invalid, or
+// refers to Authors or a Series that don't exist. The caller must
+// hold s.worksMu, so that the series number stays unique.
+func (s *BookService) validateWork(ctx context.Context, w *library.Work) error {
+	switch {
+	case w.GetTitle() == "":
+		return status.Error(codes.InvalidArgument, "The title must not be empty")
+	case w.GetSeriesId() == "" && w.GetSeriesNumber() != 0:
+		return status.Error(codes.InvalidArgument, "The series number must not be set without a series")
+	case w.GetSeriesId() != "" && w.GetSeriesNumber() < 1:
+		return status.Error(codes.InvalidArgument, "The series number must be at least 1")
+	}
+	_, err := s.bookAuthors(ctx, &library.Book{AuthorIds: w.GetAuthorIds()}, false)
+	if err != nil {
+		return err
+	}
+	if w.GetSeriesId() == "" {
+		return nil
+	}
+
+	_, err = s.works.GetSeries(ctx, w.GetSeriesId())
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.NotFound:
+		return status.Errorf(codes.InvalidArgument, "Unknown series %q", w.GetSeriesId())
+	default:
+		return err
+	}
+	taken, err := s.works.QueryWorks(ctx, func(o *library.Work) bool {
+		return o.GetId() != w.GetId() &&
+			o.GetSeriesId() == w.GetSeriesId() &&
+			o.GetSeriesNumber() == w.GetSeriesNumber()
+	})
+	if err != nil {
+		return err
+	}
+	if len(taken) > 0 {
+		return status.Errorf(codes.InvalidArgument, "Number %d of the series is already taken", w.GetSeriesNumber())
+	}
+	return nil
+}
+
+// checkWork returns an InvalidArgument error if
+// no Work with the ID provided exists.
+func (s *BookService) checkWork(ctx context.Context, id string) error {
+	_, err := s.works.GetWork(ctx, id)
+	if status.Code(err) == codes.NotFound {
+		return status.Errorf(codes.InvalidArgument, "Unknown work %q", id)
+	}
+	return err
+}
+
+// collapseEditions returns books without any edition of
+// a Work but the first, keeping the order of the Books.
+func collapseEditions(books []*library.Book) []*library.Book {
+	seen := map[string]bool{}
+	collapsed := books[:0]
+	for _, bk := range books {
+		if bk.GetWorkId() != "" {
+			if seen[bk.GetWorkId()] {
+				continue
+			}
+			seen[bk.GetWorkId()] = true
+		}
+		collapsed = append(collapsed, bk)
+	}
+	return collapsed
+}
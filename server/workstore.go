@@ -0,0 +1,141 @@
+// Copyright 2017 Johan Brandhorst. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package server
+
+import (
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/johanbrandhorst/grpcweb-example/server/proto/library"
+)
+
+// WorkStore is the storage backend for the Works and Series of the library.
+// Implementations must be safe for concurrent use.
+// Errors returned should be gRPC status errors, as they
+// are passed on to the client unchanged.
+type WorkStore interface {
+	// GetWork returns the Work with the ID provided.
+	// If no such Work exists, it returns a NotFound error.
+	GetWork(ctx context.Context, id string) (*library.Work, error)
+	// QueryWorks returns all Works for which match returns
+	// true, in the order they were added to the store.
+	QueryWorks(ctx context.Context, match func(*library.Work) bool) ([]*library.Work, error)
+	// AddWork stores the Work provided. If a Work with the
+	// same ID already exists, it returns an AlreadyExists error.
+	AddWork(ctx context.Context, work *library.Work) error
+	// UpdateWork calls update with the Work with the ID provided
+	// and stores the result, atomically with respect to other writes.
+	// If update returns an error, the Work is left unchanged and the
+	// error is returned. If no such Work exists, it returns a NotFound error.
+	UpdateWork(ctx context.Context, id string, update func(*library.Work) error) (*library.Work, error)
+	// GetSeries returns the Series with the ID provided.
+	// If no such Series exists, it returns a NotFound error.
+	GetSeries(ctx context.Context, id string) (*library.Series, error)
+	// AddSeries stores the Series provided. If a Series with the
+	// same ID already exists, it returns an AlreadyExists error.
+	AddSeries(ctx context.Context, series *library.Series) error
+}
+
+// MemoryWorkStore is an in-memory WorkStore.
+// The zero value is an empty store ready to use.
+type MemoryWorkStore struct {
+	mu        sync.RWMutex
+	works     []*library.Work
+	workIndex map[string]int
+	series    map[string]*library.Series
+}
+
+// GetWork implements WorkStore.
+func (s *MemoryWorkStore) GetWork(ctx context.Context, id string) (*library.Work, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	i, ok := s.workIndex[id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "Work could not be found")
+	}
+	return cloneWork(s.works[i]), nil
+}
+
+// QueryWorks implements WorkStore.
+func (s *MemoryWorkStore) QueryWorks(ctx context.Context, match func(*library.Work) bool) ([]*library.Work, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var works []*library.Work
+	for _, w := range s.works {
+		if match(w) {
+			works = append(works, cloneWork(w))
+		}
+	}
+	return works, nil
+}
+
+// AddWork implements WorkStore.
+func (s *MemoryWorkStore) AddWork(ctx context.Context, work *library.Work) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.workIndex == nil {
+		s.workIndex = map[string]int{}
+	}
+	if _, ok := s.workIndex[work.GetId()]; ok {
+		return status.Errorf(codes.AlreadyExists, "A work with ID %s already exists", work.GetId())
+	}
+	s.workIndex[work.GetId()] = len(s.works)
+	s.works = append(s.works, cloneWork(work))
+	return nil
+}
+
+// UpdateWork implements WorkStore.
+func (s *MemoryWorkStore) UpdateWork(ctx context.Context, id string, update func(*library.Work) error) (*library.Work, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	i, ok := s.workIndex[id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "Work could not be found")
+	}
+	w := cloneWork(s.works[i])
+	err := update(w)
+	if err != nil {
+		return nil, err
+	}
+	if w.GetId() != id {
+		return nil, status.Error(codes.InvalidArgument, "The ID of a work can't be changed")
+	}
+	s.works[i] = cloneWork(w)
+	return w, nil
+}
+
+// GetSeries implements WorkStore.
+func (s *MemoryWorkStore) GetSeries(ctx context.Context, id string) (*library.Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	series, ok := s.series[id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "Series could not be found")
+	}
+	return proto.Clone(series).(*library.Series), nil
+}
+
+// AddSeries implements WorkStore.
+func (s *MemoryWorkStore) AddSeries(ctx context.Context, series *library.Series) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.series == nil {
+		s.series = map[string]*library.Series{}
+	}
+	if _, ok := s.series[series.GetId()]; ok {
+		return status.Errorf(codes.AlreadyExists, "A series with ID %s already exists", series.GetId())
+	}
+	s.series[series.GetId()] = proto.Clone(series).(*library.Series)
+	return nil
+}
+
+// cloneWork returns a deep copy of w, so that callers
+// can't modify the contents of the store.
+func cloneWork(w *library.Work) *library.Work {
+	return proto.Clone(w).(*library.Work)
+}